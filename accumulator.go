@@ -0,0 +1,89 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/minio/sha256-simd"
+)
+
+var (
+	// ErrAccumulatorLength means that the accumulator is not in sync with the list
+	ErrAccumulatorLength = fmt.Errorf("accumulator length does not match the list")
+)
+
+// ListAccumulator computes the root of an append-only list incrementally. It
+// only keeps the right-most branch of the merkle tree so that appending an
+// element and computing the new root of the list takes O(log n) hashes.
+// The root of the list cannot be updated from the previous root alone since
+// the root does not contain enough information to rebuild the branch.
+type ListAccumulator struct {
+	limit  uint64
+	depth  uint8
+	size   uint64
+	branch [][32]byte
+}
+
+// NewListAccumulator creates an accumulator for a list with a maximum
+// number of limit leaves
+func NewListAccumulator(limit uint64) *ListAccumulator {
+	depth := getDepth(limit)
+	return &ListAccumulator{
+		limit:  limit,
+		depth:  depth,
+		branch: make([][32]byte, depth+1),
+	}
+}
+
+// Len returns the number of leaves in the accumulator
+func (l *ListAccumulator) Len() uint64 {
+	return l.size
+}
+
+// Append appends a leaf to the list
+func (l *ListAccumulator) Append(leaf [32]byte) error {
+	if l.size >= l.limit {
+		return ErrListTooBig
+	}
+	l.size++
+
+	node := leaf
+	for h := uint8(0); h <= l.depth; h++ {
+		if (l.size>>h)&1 == 1 {
+			l.branch[h] = node
+			return nil
+		}
+		node = hashPair(l.branch[h], node)
+	}
+	return nil
+}
+
+// Root returns the root of the list with the length mixed in
+func (l *ListAccumulator) Root() [32]byte {
+	var node [32]byte
+	if l.size == uint64(1)<<l.depth {
+		// the tree is full
+		node = l.branch[l.depth]
+	} else {
+		size := l.size
+		for h := uint8(0); h < l.depth; h++ {
+			if size&1 == 1 {
+				node = hashPair(l.branch[h], node)
+			} else {
+				node = hashPair(node, zeroHashes[h])
+			}
+			size >>= 1
+		}
+	}
+
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:8], l.size)
+	return hashPair(node, length)
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	var tmp [64]byte
+	copy(tmp[:32], a[:])
+	copy(tmp[32:], b[:])
+	return sha256.Sum256(tmp[:])
+}
@@ -0,0 +1,42 @@
+package ssz
+
+import (
+	"testing"
+)
+
+func TestListAccumulator(t *testing.T) {
+	for _, limit := range []uint64{1, 2, 3, 5, 8, 16, 1024} {
+		acc := NewListAccumulator(limit)
+		leaves := [][32]byte{}
+
+		for i := uint64(0); i <= limit; i++ {
+			// the root must match a full recompute of the list
+			hh := NewHasher()
+			indx := hh.Index()
+			for _, leaf := range leaves {
+				hh.Append(leaf[:])
+			}
+			hh.MerkleizeWithMixin(indx, uint64(len(leaves)), limit)
+
+			expected, err := hh.HashRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if root := acc.Root(); root != expected {
+				t.Fatalf("limit %d, len %d: bad root", limit, len(leaves))
+			}
+
+			leaf := [32]byte{byte(i + 1)}
+			if i == limit {
+				if err := acc.Append(leaf); err != ErrListTooBig {
+					t.Fatalf("limit %d: expected list too big but found %v", limit, err)
+				}
+				break
+			}
+			if err := acc.Append(leaf); err != nil {
+				t.Fatal(err)
+			}
+			leaves = append(leaves, leaf)
+		}
+	}
+}
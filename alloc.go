@@ -0,0 +1,122 @@
+package ssz
+
+import (
+	"reflect"
+)
+
+// Allocator provides the memory of the slices and objects created by the
+// UnmarshalSSZArena methods, i.e. to allocate them in an arena and reduce
+// the pressure on the garbage collector.
+type Allocator interface {
+	// Bytes returns a byte slice of length and capacity n
+	Bytes(n int) []byte
+	// Slab returns the pointer to the slice (*[]T) from which the values
+	// of the type T are allocated. The slice is replaced once it is full.
+	Slab(typ reflect.Type) interface{}
+}
+
+// arenaSlabLen is the number of values of the same type allocated at once
+const arenaSlabLen = 64
+
+// AllocBytes returns a byte slice of length n from the allocator,
+// or from the heap if the allocator is nil
+func AllocBytes(alloc Allocator, n int) []byte {
+	if alloc == nil {
+		return make([]byte, n)
+	}
+	return alloc.Bytes(n)
+}
+
+// AllocNew returns a pointer to a new T from the allocator,
+// or from the heap if the allocator is nil
+func AllocNew[T any](alloc Allocator) *T {
+	if alloc == nil {
+		return new(T)
+	}
+	return &allocSlab[T](alloc, 1)[0]
+}
+
+// AllocSlice returns a slice of n elements from the allocator,
+// or from the heap if the allocator is nil
+func AllocSlice[T any](alloc Allocator, n int) []T {
+	if alloc == nil {
+		return make([]T, n)
+	}
+	return allocSlab[T](alloc, n)
+}
+
+// AllocExtend extends the slice to n elements. The slice is reused if it
+// has enough capacity, otherwise a new one is taken from the allocator.
+// The result is not nil even if it is empty.
+func AllocExtend[T any](alloc Allocator, b []T, n int) []T {
+	if b != nil && cap(b) >= n {
+		return b[:n]
+	}
+	return AllocSlice[T](alloc, n)
+}
+
+// allocSlab takes n zero values from the slab of the type
+func allocSlab[T any](alloc Allocator, n int) []T {
+	if n > arenaSlabLen/4 {
+		// large slices would waste most of the slab
+		return make([]T, n)
+	}
+	slab := alloc.Slab(reflect.TypeOf((*T)(nil)).Elem()).(*[]T)
+	if len(*slab)+n > cap(*slab) {
+		*slab = make([]T, 0, arenaSlabLen)
+	}
+	offset := len(*slab)
+	*slab = (*slab)[:offset+n]
+	// the capacity is limited so that appending does not overwrite the next values
+	return (*slab)[offset : offset+n : offset+n]
+}
+
+// UnmarshalWithAllocator unmarshals the object with the allocator if it
+// implements ArenaUnmarshaler, otherwise it uses UnmarshalSSZ
+func UnmarshalWithAllocator(obj Unmarshaler, buf []byte, alloc Allocator) error {
+	if u, ok := obj.(ArenaUnmarshaler); ok {
+		return u.UnmarshalSSZArena(buf, alloc)
+	}
+	return obj.UnmarshalSSZ(buf)
+}
+
+// Arena is an Allocator that takes the memory from large blocks that are
+// released together once none of the decoded objects is referenced.
+type Arena struct {
+	blockSize int
+	bytes     []byte
+	slabs     map[reflect.Type]interface{}
+}
+
+// NewArena creates an Arena that allocates the bytes in blocks of blockSize
+func NewArena(blockSize int) *Arena {
+	return &Arena{
+		blockSize: blockSize,
+		slabs:     map[reflect.Type]interface{}{},
+	}
+}
+
+// Bytes implements the Allocator interface
+func (a *Arena) Bytes(n int) []byte {
+	if n > a.blockSize/4 {
+		// large slices would waste most of the block
+		return make([]byte, n)
+	}
+	if len(a.bytes)+n > cap(a.bytes) {
+		a.bytes = make([]byte, 0, a.blockSize)
+	}
+	offset := len(a.bytes)
+	a.bytes = a.bytes[:offset+n]
+	// the capacity is limited so that appending does not overwrite the next slice
+	return a.bytes[offset : offset+n : offset+n]
+}
+
+// Slab implements the Allocator interface
+func (a *Arena) Slab(typ reflect.Type) interface{} {
+	slab, ok := a.slabs[typ]
+	if !ok {
+		slab = reflect.New(reflect.SliceOf(typ)).Interface()
+		a.slabs[typ] = slab
+	}
+	return slab
+}
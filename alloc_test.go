@@ -0,0 +1,82 @@
+package ssz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArenaBytes(t *testing.T) {
+	a := NewArena(64)
+
+	b1 := a.Bytes(8)
+	b2 := a.Bytes(8)
+	if len(b1) != 8 || cap(b1) != 8 {
+		t.Fatal("bad length")
+	}
+	// appending does not overwrite the next slice
+	b2[0] = 1
+	b1 = append(b1, 2)
+	if b2[0] != 1 {
+		t.Fatal("the slices overlap")
+	}
+
+	// large slices are not taken from the block
+	if b := a.Bytes(32); len(b) != 32 {
+		t.Fatal("bad length")
+	}
+	// a new block is used when the current one is full
+	for i := 0; i < 10; i++ {
+		if b := a.Bytes(16); len(b) != 16 {
+			t.Fatal("bad length")
+		}
+	}
+}
+
+func TestArenaNew(t *testing.T) {
+	type obj struct {
+		A uint64
+		B []byte
+	}
+	a := NewArena(64)
+
+	objs := map[*obj]bool{}
+	for i := 0; i < 2*arenaSlabLen; i++ {
+		o := AllocNew[obj](a)
+		if !reflect.DeepEqual(o, &obj{}) {
+			t.Fatal("expected zero value")
+		}
+		if objs[o] {
+			t.Fatal("repeated object")
+		}
+		objs[o] = true
+	}
+
+	s := AllocSlice[*obj](a, 3)
+	if len(s) != 3 || cap(s) != 3 {
+		t.Fatal("bad length")
+	}
+	if s := AllocSlice[uint64](a, arenaSlabLen); len(s) != arenaSlabLen {
+		t.Fatal("bad length")
+	}
+}
+
+func TestAllocNil(t *testing.T) {
+	if b := AllocBytes(nil, 4); len(b) != 4 {
+		t.Fatal("bad length")
+	}
+	if o := AllocNew[uint64](nil); o == nil || *o != 0 {
+		t.Fatal("expected zero value")
+	}
+	if s := AllocSlice[uint64](nil, 4); len(s) != 4 {
+		t.Fatal("bad length")
+	}
+
+	// the slice is reused if it has capacity
+	b := make([]uint64, 2, 8)
+	if s := AllocExtend(nil, b, 8); &s[0] != &b[0] || len(s) != 8 {
+		t.Fatal("expected the same slice")
+	}
+	if s := AllocExtend(nil, b, 9); len(s) != 9 {
+		t.Fatal("bad length")
+	}
+}
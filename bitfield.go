@@ -0,0 +1,57 @@
+package ssz
+
+import (
+	"math/bits"
+)
+
+// The bits of the bitlists and bitvectors are in little endian order, the
+// bit i is the bit i%8 of the byte i/8.
+
+// BitAt returns the bit at indx of the bytes
+func BitAt(b []byte, indx uint64) bool {
+	return b[indx/8]&(1<<(indx%8)) != 0
+}
+
+// SetBitAt sets the bit at indx of the bytes to val
+func SetBitAt(b []byte, indx uint64, val bool) {
+	if val {
+		b[indx/8] |= 1 << (indx % 8)
+	} else {
+		b[indx/8] &^= 1 << (indx % 8)
+	}
+}
+
+// CountBits returns the number of bits of the bytes that are set
+func CountBits(b []byte) uint64 {
+	count := 0
+	for _, c := range b {
+		count += bits.OnesCount8(c)
+	}
+	return uint64(count)
+}
+
+// NewBitlist returns a bitlist of length bits set to zero, which is
+// followed by its length bit
+func NewBitlist(length uint64) []byte {
+	b := make([]byte, length/8+1)
+	b[length/8] = 1 << (length % 8)
+	return b
+}
+
+// BitlistLen returns the number of bits of the bitlist, which is the position
+// of its length bit. A bitlist without the length bit has no bits.
+func BitlistLen(b []byte) uint64 {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return 0
+	}
+	return uint64(len(b)-1)*8 + uint64(bits.Len8(b[len(b)-1])) - 1
+}
+
+// BitlistCount returns the number of bits of the bitlist that are set, the
+// length bit is not counted
+func BitlistCount(b []byte) uint64 {
+	if BitlistLen(b) == 0 {
+		return 0
+	}
+	return CountBits(b) - 1
+}
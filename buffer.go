@@ -0,0 +1,93 @@
+package ssz
+
+import (
+	"sync"
+)
+
+// DefaultBufferPool is the pool of the buffers of the MarshalSSZPooled methods
+var DefaultBufferPool BufferPool
+
+// BufferPool pools the buffers of the pooled encodings. Unlike the buffers
+// returned by MarshalSSZ, which are owned by the caller and can be retained,
+// the buffers of the pool are borrowed and reused once they are released.
+type BufferPool struct {
+	pool sync.Pool
+}
+
+// bufferData is the memory of a pooled buffer, it outlives the Buffer handles
+// so that a released handle cannot reach the bytes of the next encoding
+type bufferData struct {
+	b []byte
+}
+
+// Get acquires an empty buffer with capacity for at least n bytes from the pool
+func (p *BufferPool) Get(n int) *Buffer {
+	data, ok := p.pool.Get().(*bufferData)
+	if !ok {
+		data = &bufferData{}
+	}
+	data.b = Grow(data.b[:0], n)
+	return &Buffer{data: data, pool: p}
+}
+
+// Buffer is a borrowed buffer of a BufferPool. Its bytes are only valid until
+// it is released and must not be retained, use Owned to keep a copy of them.
+type Buffer struct {
+	data *bufferData
+	pool *BufferPool
+}
+
+// Bytes returns the borrowed bytes of the buffer. It panics if the buffer
+// was released.
+func (b *Buffer) Bytes() []byte {
+	if b.data == nil {
+		panic("ssz: use of a released buffer")
+	}
+	return b.data.b
+}
+
+// Owned returns a copy of the bytes of the buffer that is owned by the caller
+// and can be retained after the buffer is released
+func (b *Buffer) Owned() []byte {
+	return append([]byte(nil), b.Bytes()...)
+}
+
+// Len returns the number of bytes of the buffer
+func (b *Buffer) Len() int {
+	return len(b.Bytes())
+}
+
+// Release returns the buffer to its pool. The bytes of the buffer must not be
+// used afterwards. It panics if the buffer was already released.
+func (b *Buffer) Release() {
+	if b.data == nil {
+		panic("ssz: buffer released twice")
+	}
+	data := b.data
+	b.data = nil
+	data.b = data.b[:0]
+	b.pool.pool.Put(data)
+}
+
+// MarshalSSZPooled marshals the object to a buffer of the DefaultBufferPool.
+// The buffer is borrowed and must be released once its bytes are not used.
+func MarshalSSZPooled(m Marshaler) (*Buffer, error) {
+	return MarshalSSZToPool(&DefaultBufferPool, m)
+}
+
+// MarshalSSZToPool marshals the object to a buffer of the pool
+func MarshalSSZToPool(pool *BufferPool, m Marshaler) (*Buffer, error) {
+	size := m.SizeSSZ()
+	if size < 0 {
+		// the size overflows an int on 32-bit platforms
+		return nil, ErrOffsetOverflow
+	}
+	buf := pool.Get(size)
+	dst, err := m.MarshalSSZTo(buf.data.b)
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.data.b = dst
+	return buf, nil
+}
@@ -0,0 +1,25 @@
+package ssz
+
+import (
+	"testing"
+)
+
+func TestBufferPool(t *testing.T) {
+	var pool BufferPool
+
+	buf := pool.Get(64)
+	if buf.Len() != 0 || cap(buf.Bytes()) < 64 {
+		t.Fatalf("expected an empty buffer of 64 bytes but found %d/%d", buf.Len(), cap(buf.Bytes()))
+	}
+	buf.Release()
+
+	// a released handle does not reach the memory of the next buffer
+	next := pool.Get(32)
+	defer next.Release()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected the released buffer to panic")
+		}
+	}()
+	buf.Bytes()
+}
@@ -0,0 +1,47 @@
+package ssz
+
+import (
+	"encoding/binary"
+)
+
+// Cipher encrypts the fields tagged with 'ssz-encrypt' in the MarshalSSZEncrypted
+// and UnmarshalSSZEncrypted methods (i.e. with an AEAD and a random nonce)
+type Cipher interface {
+	// Encrypt returns the ciphertext of the plaintext
+	Encrypt(plaintext []byte) ([]byte, error)
+	// Decrypt returns the plaintext of the ciphertext
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// EncryptField replaces the plaintext of a field encoded at dst[start+4:] with its
+// ciphertext and writes the length of the ciphertext in the 4 bytes at dst[start:]
+func EncryptField(dst []byte, start int, c Cipher) ([]byte, error) {
+	ciphertext, err := c.Encrypt(dst[start+bytesPerLengthOffset:])
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(ciphertext)) > MaxOffset {
+		return nil, ErrOffsetOverflow
+	}
+	// the ciphertext may be written over the plaintext
+	dst = append(dst[:start+bytesPerLengthOffset], ciphertext...)
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(ciphertext)))
+	return dst, nil
+}
+
+// DecryptField decrypts the field at the start of the buffer encoded with EncryptField
+// and returns its plaintext and the rest of the buffer
+func DecryptField(buf []byte, c Cipher) ([]byte, []byte, error) {
+	if len(buf) < bytesPerLengthOffset {
+		return nil, nil, ErrSize
+	}
+	size := ReadOffset(buf)
+	if buf = buf[bytesPerLengthOffset:]; size > uint64(len(buf)) {
+		return nil, nil, ErrOffset
+	}
+	plaintext, err := c.Decrypt(buf[:size])
+	if err != nil {
+		return nil, nil, err
+	}
+	return plaintext, buf[size:], nil
+}
@@ -0,0 +1,58 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// dotValueLen is the number of bytes of the values and hashes shown in the nodes
+const dotValueLen = 8
+
+// TreeToDOT renders the tree in Graphviz DOT format. Each node is labeled
+// with its generalized index and its value for the leaves or its hash for
+// the internal nodes. The values and hashes are truncated to be readable.
+func TreeToDOT(n *Node) string {
+	var b strings.Builder
+	b.WriteString("digraph tree {\n")
+	b.WriteString("\tnode [shape=box];\n")
+	if n != nil {
+		writeDOTNode(&b, n, 1)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// writeDOTNode writes the node and its subtree and returns the hash of the node
+func writeDOTNode(b *strings.Builder, n *Node, index int) []byte {
+	if n.left == nil && n.right == nil {
+		fmt.Fprintf(b, "\tn%d [label=\"%d\\n%s\", shape=ellipse];\n", index, index, dotValue(n.value))
+		return n.value
+	}
+
+	var left, right []byte
+	if n.left != nil {
+		left = writeDOTNode(b, n.left, 2*index)
+		fmt.Fprintf(b, "\tn%d -> n%d;\n", index, 2*index)
+	}
+	if n.right != nil {
+		right = writeDOTNode(b, n.right, 2*index+1)
+		fmt.Fprintf(b, "\tn%d -> n%d;\n", index, 2*index+1)
+	}
+	if n.left == nil || n.right == nil {
+		// the hash of an incomplete node is not defined
+		fmt.Fprintf(b, "\tn%d [label=\"%d\\nincomplete\"];\n", index, index)
+		return nil
+	}
+
+	hash := hashFn(append(append(make([]byte, 0, len(left)+len(right)), left...), right...))
+	fmt.Fprintf(b, "\tn%d [label=\"%d\\n%s\"];\n", index, index, dotValue(hash))
+	return hash
+}
+
+func dotValue(b []byte) string {
+	if len(b) > dotValueLen {
+		return hex.EncodeToString(b[:dotValueLen]) + "..."
+	}
+	return hex.EncodeToString(b)
+}
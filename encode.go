@@ -6,22 +6,82 @@ import (
 	"math/bits"
 )
 
-// MarshalSSZ marshals an object
+// MarshalSSZ marshals an object to a new buffer. The buffer is owned by the
+// caller, it is never reused by the library so it can be retained (i.e. cached)
+// without copying it. See MarshalSSZPooled for the borrowed buffers of a pool.
 func MarshalSSZ(m Marshaler) ([]byte, error) {
-	buf := make([]byte, m.SizeSSZ())
-	return m.MarshalSSZTo(buf[:0])
+	return MarshalSSZAppend(nil, m)
+}
+
+// MarshalSSZAppend appends the ssz encoding of the object to dst. The destination
+// is grown once to the size of the object so that the nested fields do not reallocate it.
+func MarshalSSZAppend(dst []byte, m Marshaler) ([]byte, error) {
+	size := m.SizeSSZ()
+	if size < 0 {
+		// the size overflows an int on 32-bit platforms
+		return nil, ErrOffsetOverflow
+	}
+	return m.MarshalSSZTo(Grow(dst, size))
+}
+
+// Grow returns dst with capacity to append n bytes without reallocating it
+func Grow(dst []byte, n int) []byte {
+	if cap(dst)-len(dst) >= n {
+		return dst
+	}
+	buf := make([]byte, len(dst), len(dst)+n)
+	copy(buf, dst)
+	return buf
+}
+
+// IsZero returns true if the object is zero. Objects that implement the
+// IsZeroSSZ method are checked directly, otherwise the object is zero if its
+// ssz encoding is empty or only contains zero bytes.
+func IsZero(obj interface{}) bool {
+	switch m := obj.(type) {
+	case interface{ IsZeroSSZ() bool }:
+		return m.IsZeroSSZ()
+
+	case interface {
+		MarshalSSZTo(dst []byte) ([]byte, error)
+	}:
+		buf, err := m.MarshalSSZTo(nil)
+		if err != nil {
+			return false
+		}
+		for _, b := range buf {
+			if b != 0 {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return false
+	}
 }
 
 // Errors
 
 var (
-	ErrOffset       = fmt.Errorf("incorrect offset")
-	ErrSize         = fmt.Errorf("incorrect size")
-	ErrBytesLength  = fmt.Errorf("bytes array does not have the correct length")
-	ErrVectorLength = fmt.Errorf("vector does not have the correct length")
-	ErrListTooBig   = fmt.Errorf("list length is higher than max value")
-	ErrEmptyBitlist = fmt.Errorf("bitlist is empty")
+	ErrOffset                = fmt.Errorf("incorrect offset")
+	ErrSize                  = fmt.Errorf("incorrect size")
+	ErrBytesLength           = fmt.Errorf("bytes array does not have the correct length")
+	ErrVectorLength          = fmt.Errorf("vector does not have the correct length")
+	ErrListTooBig            = fmt.Errorf("list length is higher than max value")
+	ErrListTooSmall          = fmt.Errorf("list length is lower than min value")
+	ErrEmptyBitlist          = fmt.Errorf("bitlist is empty")
+	ErrConcreteType          = fmt.Errorf("interface does not hold the expected concrete type")
+	ErrOffsetOverflow        = fmt.Errorf("offset overflows the maximum size")
+	ErrUnknownField          = fmt.Errorf("unknown field")
+	ErrInvalidBitvector      = fmt.Errorf("bitvector has non-zero padding bits")
+	ErrInvalidOptional       = fmt.Errorf("optional value does not start with the presence byte")
 	ErrInvalidVariableOffset = fmt.Errorf("invalid ssz encoding. first variable element offset indexes into fixed value data")
+	ErrRootMismatch          = fmt.Errorf("hash tree root does not match the expected root")
+	ErrReservedBytes         = fmt.Errorf("reserved bytes are not zero")
+	ErrUnionSelector         = fmt.Errorf("union selector does not match any of its options")
+	ErrUnionNil              = fmt.Errorf("union holds a nil option")
+	ErrLengthField           = fmt.Errorf("length field does not match the length of the framed bytes")
 )
 
 // ---- Unmarshal functions ----
@@ -96,6 +156,37 @@ func MarshalBool(dst []byte, b bool) []byte {
 	return dst
 }
 
+// ---- Put functions ----
+
+// PutUint64 writes a little endian uint64 in the first 8 bytes of dst
+func PutUint64(dst []byte, i uint64) {
+	binary.LittleEndian.PutUint64(dst, i)
+}
+
+// PutUint32 writes a little endian uint32 in the first 4 bytes of dst
+func PutUint32(dst []byte, i uint32) {
+	binary.LittleEndian.PutUint32(dst, i)
+}
+
+// PutUint16 writes a little endian uint16 in the first 2 bytes of dst
+func PutUint16(dst []byte, i uint16) {
+	binary.LittleEndian.PutUint16(dst, i)
+}
+
+// PutUint8 writes a uint8 in the first byte of dst
+func PutUint8(dst []byte, i uint8) {
+	dst[0] = byte(i)
+}
+
+// PutBool writes a boolean in the first byte of dst
+func PutBool(dst []byte, b bool) {
+	if b {
+		dst[0] = 1
+	} else {
+		dst[0] = 0
+	}
+}
+
 // ---- offset functions ----
 
 // WriteOffset writes an offset to dst
@@ -103,6 +194,19 @@ func WriteOffset(dst []byte, i int) []byte {
 	return MarshalUint32(dst, uint32(i))
 }
 
+// MaxOffset is the maximum value of an offset
+const MaxOffset = 1<<32 - 1
+
+// SafeWriteOffset writes an offset to dst. It fails if the offset does not fit in
+// 4 bytes or if it is negative, which happens when the size overflows an int on
+// 32-bit platforms.
+func SafeWriteOffset(dst []byte, i int) ([]byte, error) {
+	if i < 0 || uint64(i) > MaxOffset {
+		return dst, ErrOffsetOverflow
+	}
+	return MarshalUint32(dst, uint32(i)), nil
+}
+
 // ReadOffset reads an offset from buf
 func ReadOffset(buf []byte) uint64 {
 	return uint64(binary.LittleEndian.Uint32(buf))
@@ -135,6 +239,15 @@ func ExtendUint64(b []uint64, needLen int) []uint64 {
 	return b[:needLen]
 }
 
+// ExtendUint32 extends a uint32 buffer to a given size
+func ExtendUint32(b []uint32, needLen int) []uint32 {
+	b = b[:cap(b)]
+	if n := needLen - cap(b); n > 0 {
+		b = append(b, make([]uint32, n)...)
+	}
+	return b[:needLen]
+}
+
 // ExtendUint16 extends a uint16 buffer to a given size
 func ExtendUint16(b []uint16, needLen int) []uint16 {
 	b = b[:cap(b)]
@@ -153,6 +266,16 @@ func ExtendUint8(b []uint8, needLen int) []uint8 {
 	return b[:needLen]
 }
 
+// ValidateReserved validates that the reserved bytes of a container are zero
+func ValidateReserved(buf []byte) error {
+	for _, b := range buf {
+		if b != 0 {
+			return ErrReservedBytes
+		}
+	}
+	return nil
+}
+
 // ---- unmarshal dynamic content ----
 
 const bytesPerLengthOffset = 4
@@ -189,8 +312,9 @@ func ValidateBitlist(buf []byte, bitLimit uint64) error {
 	return nil
 }
 
-// DecodeDynamicLength decodes the length from the dynamic input
-func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
+// DecodeDynamicLength decodes the length from the dynamic input. The maximum size
+// is an uint64 since the limit of the list may not fit in an int on 32-bit platforms.
+func DecodeDynamicLength(buf []byte, maxSize uint64) (int, error) {
 	if len(buf) == 0 {
 		return 0, nil
 	}
@@ -198,11 +322,19 @@ func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 		return 0, fmt.Errorf("not enough data")
 	}
 	offset := binary.LittleEndian.Uint32(buf[:4])
+	if uint64(offset) > uint64(len(buf)) {
+		// the offset is out of bounds and it may not fit in an int on 32-bit platforms
+		return 0, ErrOffset
+	}
+	if offset < bytesPerLengthOffset {
+		// the list has bytes, at least the offset of its first element
+		return 0, ErrInvalidVariableOffset
+	}
 	length, ok := DivideInt(int(offset), bytesPerLengthOffset)
 	if !ok {
-		return 0, fmt.Errorf("bad")
+		return 0, ErrOffset
 	}
-	if length > maxSize {
+	if uint64(length) > maxSize {
 		return 0, fmt.Errorf("too big for the list")
 	}
 	return length, nil
@@ -216,12 +348,20 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 	}
 
 	size := uint64(len(src))
+	if size < uint64(length)*bytesPerLengthOffset {
+		// the buffer does not hold the offsets of the items
+		return ErrSize
+	}
 
 	indx := 0
 	dst := src
 
 	var offset, endOffset uint64
 	offset, dst = ReadOffset(src), dst[4:]
+	if offset != uint64(length)*bytesPerLengthOffset {
+		// the first item starts after the offsets
+		return ErrInvalidVariableOffset
+	}
 
 	for {
 		if length != 1 {
@@ -232,11 +372,9 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 		} else {
 			endOffset = uint64(len(src))
 		}
-		if offset > endOffset {
-			return fmt.Errorf("four")
-		}
-		if endOffset > size {
-			return fmt.Errorf("five")
+		// an offset equal to the size is an empty item at the end of the buffer
+		if offset > endOffset || endOffset > size {
+			return ErrOffset
 		}
 
 		err := f(indx, src[offset:endOffset])
@@ -255,12 +393,13 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 	return nil
 }
 
-func DivideInt2(a, b, max int) (int, error) {
+// DivideInt2 divides the int fully and checks that the result is not higher than max
+func DivideInt2(a, b int, max uint64) (int, error) {
 	num, ok := DivideInt(a, b)
 	if !ok {
 		return 0, fmt.Errorf("xx")
 	}
-	if num > max {
+	if uint64(num) > max {
 		return 0, fmt.Errorf("yy")
 	}
 	return num, nil
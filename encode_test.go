@@ -1,7 +1,9 @@
 package ssz
 
 import (
+	"bytes"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -94,3 +96,129 @@ func TestBitlist_MaxValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSafeWriteOffset(t *testing.T) {
+	if _, err := SafeWriteOffset(nil, -1); err != ErrOffsetOverflow {
+		t.Fatalf("expected offset overflow but found %v", err)
+	}
+	buf, err := SafeWriteOffset(nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ReadOffset(buf) != 1 {
+		t.Fatal("bad offset")
+	}
+
+	// offsets higher than 4 bytes can only be represented with a 64-bit int
+	big := uint64(MaxOffset) + 1
+	if uint64(int(big)) == big {
+		if _, err := SafeWriteOffset(nil, int(big)); err != ErrOffsetOverflow {
+			t.Fatalf("expected offset overflow but found %v", err)
+		}
+	}
+}
+
+func TestGrow(t *testing.T) {
+	dst := make([]byte, 2, 4)
+	dst[0] = 1
+	if buf := Grow(dst, 2); &buf[0] != &dst[0] {
+		t.Fatal("expected the buffer with enough capacity to be reused")
+	}
+	buf := Grow(dst, 3)
+	if len(buf) != 2 || cap(buf) < 5 || buf[0] != 1 {
+		t.Fatalf("bad buffer %v with capacity %d", buf, cap(buf))
+	}
+}
+
+func TestDecodeDynamicLengthOutOfBounds(t *testing.T) {
+	buf := MarshalUint32(nil, MaxOffset)
+	if _, err := DecodeDynamicLength(buf, 1024); err != ErrOffset {
+		t.Fatalf("expected offset error but found %v", err)
+	}
+	buf = MarshalUint32(nil, math.MaxInt32)
+	if _, err := DecodeDynamicLength(buf, 1024); err != ErrOffset {
+		t.Fatalf("expected offset error but found %v", err)
+	}
+}
+
+func TestLimitsHigherThanInt32(t *testing.T) {
+	// limits that do not fit in an int on 32-bit platforms
+	buf := make([]byte, 16)
+	MarshalUint32(buf[:0], 8)
+	num, err := DecodeDynamicLength(buf, 1<<40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 2 {
+		t.Fatalf("expected 2 elements but found %d", num)
+	}
+	if num, err = DivideInt2(len(buf), 8, 1<<40); err != nil || num != 2 {
+		t.Fatalf("expected 2 elements but found %d (%v)", num, err)
+	}
+	if _, err = DivideInt2(len(buf), 8, 1); err == nil {
+		t.Fatal("expected error for a list higher than the limit")
+	}
+}
+
+func TestDynamicOffsetBoundaries(t *testing.T) {
+	decode := func(buf []byte) ([][]byte, error) {
+		num, err := DecodeDynamicLength(buf, 1024)
+		if err != nil {
+			return nil, err
+		}
+		items := make([][]byte, num)
+		err = UnmarshalDynamic(buf, num, func(indx int, b []byte) error {
+			items[indx] = b
+			return nil
+		})
+		return items, err
+	}
+	offsets := func(offsets []uint32, data ...byte) []byte {
+		var buf []byte
+		for _, o := range offsets {
+			buf = MarshalUint32(buf, o)
+		}
+		return append(buf, data...)
+	}
+
+	// the offsets equal to the size of the buffer are the empty items at its end
+	items, err := decode(offsets([]uint32{8, 9}, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || !bytes.Equal(items[0], []byte{1}) || len(items[1]) != 0 {
+		t.Fatalf("bad items %v", items)
+	}
+	if items, err = decode(offsets([]uint32{8, 8})); err != nil || len(items) != 2 || len(items[0]) != 0 || len(items[1]) != 0 {
+		t.Fatalf("expected two empty items but found %v (%v)", items, err)
+	}
+	if items, err = decode(offsets([]uint32{4})); err != nil || len(items) != 1 || len(items[0]) != 0 {
+		t.Fatalf("expected an empty item but found %v (%v)", items, err)
+	}
+
+	cases := map[string]struct {
+		buf []byte
+		err error
+	}{
+		"first beyond the end":     {offsets([]uint32{12, 8}, 1), ErrOffset},
+		"first at max offset":      {offsets([]uint32{math.MaxUint32, 8}, 1), ErrOffset},
+		"last beyond the end":      {offsets([]uint32{8, 10}, 1), ErrOffset},
+		"last at max offset":       {offsets([]uint32{8, math.MaxUint32}, 1), ErrOffset},
+		"last before the previous": {offsets([]uint32{8, 7}, 1), ErrOffset},
+		"first not aligned":        {offsets([]uint32{6}, 1, 2), ErrOffset},
+		"first at zero":            {offsets([]uint32{0}, 1), ErrInvalidVariableOffset},
+	}
+	for name, c := range cases {
+		if _, err := decode(c.buf); err != c.err {
+			t.Fatalf("expected %v for the %s offset but found %v", c.err, name, err)
+		}
+	}
+
+	// the items are not decoded with less bytes than their offsets
+	if err := UnmarshalDynamic([]byte{8, 0, 0}, 2, func(int, []byte) error { return nil }); err != ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+	if err := UnmarshalDynamic(offsets([]uint32{4, 8}), 2, func(int, []byte) error { return nil }); err != ErrInvalidVariableOffset {
+		t.Fatalf("expected ErrInvalidVariableOffset but found %v", err)
+	}
+}
@@ -6,7 +6,11 @@ require (
 	github.com/golang/snappy v0.0.4
 	github.com/minio/sha256-simd v1.0.0
 	github.com/mitchellh/mapstructure v1.4.3
+	golang.org/x/tools v0.17.0
 	gopkg.in/yaml.v2 v2.4.0
 )
 
-require github.com/klauspost/cpuid/v2 v2.0.4 // indirect
+require (
+	github.com/klauspost/cpuid/v2 v2.0.4 // indirect
+	golang.org/x/mod v0.14.0 // indirect
+)
@@ -30,13 +30,22 @@ func init() {
 	zeroHashLevels = make(map[string]int)
 	zeroHashLevels[string(falseBytes)] = 0
 
-	tmp := [64]byte{}
+	zeroHashes = computeZeroHashes(sha256.New())
+	for i := 1; i < len(zeroHashes); i++ {
+		zeroHashLevels[string(zeroHashes[i][:])] = i
+	}
+}
+
+// computeZeroHashes returns the roots of the trees of zero chunks of each
+// depth with the given hash function
+func computeZeroHashes(hh hash.Hash) (res [65][32]byte) {
 	for i := 0; i < 64; i++ {
-		copy(tmp[:32], zeroHashes[i][:])
-		copy(tmp[32:], zeroHashes[i][:])
-		zeroHashes[i+1] = sha256.Sum256(tmp[:])
-		zeroHashLevels[string(zeroHashes[i+1][:])] = i + 1
+		hh.Write(res[i][:])
+		hh.Write(res[i][:])
+		hh.Sum(res[i+1][:0])
+		hh.Reset()
 	}
+	return
 }
 
 // HashWithDefaultHasher hashes a HashRoot object with a Hasher from
@@ -52,6 +61,42 @@ func HashWithDefaultHasher(v HashRoot) ([32]byte, error) {
 	return root, err
 }
 
+// Equal reports whether a and b have the same hash tree root. Both
+// trees are hashed on each call (O(size) of the objects). Objects that
+// fail to hash are not equal.
+func Equal(a, b HashRoot) bool {
+	rootA, err := HashWithDefaultHasher(a)
+	if err != nil {
+		return false
+	}
+	rootB, err := HashWithDefaultHasher(b)
+	if err != nil {
+		return false
+	}
+	return rootA == rootB
+}
+
+// VerifyRoot decodes the buffer in the object (if it implements Unmarshaler) and
+// checks that its hash tree root is the expected one. The error wraps
+// ErrRootMismatch with both roots if they do not match.
+func VerifyRoot(u HashRoot, buf []byte, expected [32]byte) error {
+	if obj, ok := u.(Unmarshaler); ok {
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	} else if len(buf) != 0 {
+		return fmt.Errorf("cannot decode the buffer, %T does not implement ssz.Unmarshaler", u)
+	}
+	root, err := HashWithDefaultHasher(u)
+	if err != nil {
+		return err
+	}
+	if root != expected {
+		return fmt.Errorf("%w: expected 0x%x but found 0x%x", ErrRootMismatch, expected, root)
+	}
+	return nil
+}
+
 var zeroBytes = make([]byte, 32)
 
 // DefaultHasherPool is a default hasher pool
@@ -68,23 +113,35 @@ type Hasher struct {
 	// tmp array used during the merkleize process
 	merkleizeTmp []byte
 
-	// sha256 hash function
+	// hash function (sha256 by default)
 	hash hash.Hash
+
+	// roots of the zero subtrees with the hash function
+	zeroHashes *[65][32]byte
 }
 
 // NewHasher creates a new Hasher object
 func NewHasher() *Hasher {
 	return &Hasher{
-		hash: sha256.New(),
-		tmp:  make([]byte, 32),
+		hash:       sha256.New(),
+		tmp:        make([]byte, 32),
+		zeroHashes: &zeroHashes,
 	}
 }
 
-// NewHasher creates a new Hasher object with a custom hash function
-func NewHasherWithHash(hh hash.Hash) *Hasher {
+// NewHasherWithHash creates a new Hasher object with a custom hash function
+// (i.e. keccak256) instead of sha256. The function must return a hash with
+// a 32 bytes digest.
+func NewHasherWithHash(fn func() hash.Hash) *Hasher {
+	hh := fn()
+	if hh.Size() != 32 {
+		panic(fmt.Sprintf("the hash function must return 32 bytes but returns %d", hh.Size()))
+	}
+	zero := computeZeroHashes(hh)
 	return &Hasher{
-		hash: hh,
-		tmp:  make([]byte, 32),
+		hash:       hh,
+		tmp:        make([]byte, 32),
+		zeroHashes: &zero,
 	}
 }
 
@@ -128,8 +185,34 @@ func (h *Hasher) PutUint8(i uint8) {
 	h.AppendBytes32([]byte{byte(i)})
 }
 
+// ChunkCount returns the number of 32 bytes chunks of byteLen bytes
+func ChunkCount(byteLen int) int {
+	return (byteLen + 31) / 32
+}
+
+// MerkleizeLimit returns the limit in chunks of the tree of a list of at most maxItems
+// items of itemSize bytes that are packed in the chunks (i.e. 8 for a list of uint64).
+// The items merkleized to their own root (i.e. containers) have 32 bytes.
+func MerkleizeLimit(maxItems, itemSize uint64) uint64 {
+	return (maxItems*itemSize + 31) / 32
+}
+
+// NextPowerOfTwo returns the lowest power of two that is higher or equal than n (0 for 0),
+// which is the number of leaves of the tree of n chunks
+func NextPowerOfTwo(n uint64) uint64 {
+	n--
+	n |= n >> 1
+	n |= n >> 2
+	n |= n >> 4
+	n |= n >> 8
+	n |= n >> 16
+	n |= n >> 32
+	n++
+	return n
+}
+
 func CalculateLimit(maxCapacity, numItems, size uint64) uint64 {
-	limit := (maxCapacity*size + 31) / 32
+	limit := MerkleizeLimit(maxCapacity, size)
 	if limit != 0 {
 		return limit
 	}
@@ -158,6 +241,36 @@ func (h *Hasher) Append(i []byte) {
 	h.buf = append(h.buf, i...)
 }
 
+// AppendUint64Array appends the little endian encoding of an array of uint64
+// without padding. The buffer is extended only once for all the values.
+func (h *Hasher) AppendUint64Array(b []uint64) {
+	offset := len(h.buf)
+	h.buf = extendByteSlice(h.buf, offset+8*len(b))
+	for indx, i := range b {
+		binary.LittleEndian.PutUint64(h.buf[offset+8*indx:], i)
+	}
+}
+
+// AppendUint32Array appends the little endian encoding of an array of uint32
+// without padding. The buffer is extended only once for all the values.
+func (h *Hasher) AppendUint32Array(b []uint32) {
+	offset := len(h.buf)
+	h.buf = extendByteSlice(h.buf, offset+4*len(b))
+	for indx, i := range b {
+		binary.LittleEndian.PutUint32(h.buf[offset+4*indx:], i)
+	}
+}
+
+// AppendUint16Array appends the little endian encoding of an array of uint16
+// without padding. The buffer is extended only once for all the values.
+func (h *Hasher) AppendUint16Array(b []uint16) {
+	offset := len(h.buf)
+	h.buf = extendByteSlice(h.buf, offset+2*len(b))
+	for indx, i := range b {
+		binary.LittleEndian.PutUint16(h.buf[offset+2*indx:], i)
+	}
+}
+
 // PutRootVector appends an array of roots
 func (h *Hasher) PutRootVector(b [][]byte, maxCapacity ...uint64) error {
 	indx := h.Index()
@@ -182,9 +295,7 @@ func (h *Hasher) PutRootVector(b [][]byte, maxCapacity ...uint64) error {
 // PutUint64Array appends an array of uint64
 func (h *Hasher) PutUint64Array(b []uint64, maxCapacity ...uint64) {
 	indx := h.Index()
-	for _, i := range b {
-		h.AppendUint64(i)
-	}
+	h.AppendUint64Array(b)
 
 	// pad zero bytes to the left
 	h.FillUpTo32()
@@ -252,6 +363,23 @@ func (h *Hasher) PutBytes(b []byte) {
 	h.Merkleize(indx)
 }
 
+// PutZeroBytes appends the root of n zero bytes (i.e. reserved bytes), which is the
+// same as PutBytes with n zero bytes but it does not hash the zero chunks
+func (h *Hasher) PutZeroBytes(n int) {
+	if n <= 32 {
+		h.AppendBytes32(zeroBytes[:n])
+		return
+	}
+	depth := bits.Len64(NextPowerOfTwo(uint64(ChunkCount(n)))) - 1
+	h.buf = append(h.buf, h.zeroHashes[depth][:]...)
+}
+
+// Reserve grows the buffer of the hasher to append n leaves of 32 bytes without
+// reallocating it (i.e. the fields of a container)
+func (h *Hasher) Reserve(n int) {
+	h.buf = Grow(h.buf, n*32)
+}
+
 // Index marks the current buffer index
 func (h *Hasher) Index() int {
 	return len(h.buf)
@@ -284,6 +412,37 @@ func (h *Hasher) MerkleizeWithMixin(indx int, num, limit uint64) {
 	h.buf = append(h.buf[:indx], input...)
 }
 
+// Branch returns the merkle branch of the leaf at position leaf among the chunks
+// appended since indx. The chunks are not merkleized.
+func (h *Hasher) Branch(indx int, leaf int) ([][32]byte, error) {
+	input := h.buf[indx:]
+	if len(input)%32 != 0 {
+		return nil, fmt.Errorf("expected chunks of 32 bytes")
+	}
+	num := len(input) / 32
+	if leaf < 0 || leaf >= num {
+		return nil, fmt.Errorf("leaf %d out of range for %d chunks", leaf, num)
+	}
+
+	layer := make([][32]byte, NextPowerOfTwo(uint64(num)))
+	for i := 0; i < num; i++ {
+		copy(layer[i][:], input[i*32:])
+	}
+
+	branch := [][32]byte{}
+	for len(layer) > 1 {
+		branch = append(branch, layer[leaf^1])
+
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			h.doHash(next[i][:0], layer[2*i][:], layer[2*i+1][:])
+		}
+		layer = next
+		leaf >>= 1
+	}
+	return branch, nil
+}
+
 // HashRoot creates the hash final hash root
 func (h *Hasher) HashRoot() (res [32]byte, err error) {
 	if len(h.buf) != 32 {
@@ -314,23 +473,12 @@ func (hh *HasherPool) Put(h *Hasher) {
 	hh.pool.Put(h)
 }
 
-func nextPowerOfTwo(v uint64) uint {
-	v--
-	v |= v >> 1
-	v |= v >> 2
-	v |= v >> 4
-	v |= v >> 8
-	v |= v >> 16
-	v++
-	return uint(v)
-}
-
 func getDepth(d uint64) uint8 {
 	if d <= 1 {
 		return 0
 	}
-	i := nextPowerOfTwo(d)
-	return 64 - uint8(bits.LeadingZeros(i)) - 1
+	// use the 64 bits version since uint is 32 bits long on 32-bit platforms
+	return uint8(bits.Len64(d - 1))
 }
 
 func (h *Hasher) doHash(dst []byte, a []byte, b []byte) []byte {
@@ -380,7 +528,7 @@ func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
 			if i&(uint64(1)<<j) == 0 {
 				// if we are at the count, we want to merge in zero-hashes for padding
 				if i == count && j < depth {
-					h.doHash(hh, hh, zeroHashes[j][:])
+					h.doHash(hh, hh, h.zeroHashes[j][:])
 				} else {
 					// store the merge result (may be no merge, i.e. bottom leaf node)
 					copy(getTmp(j), hh)
@@ -401,14 +549,14 @@ func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
 
 	// complement with 0 if empty, or if not the right power of 2
 	if (uint64(1) << depth) != count {
-		merge(count, zeroHashes[0][:])
+		merge(count, h.zeroHashes[0][:])
 	}
 
 	// the next power of two may be smaller than the ultimate virtual size,
 	// complement with zero-hashes at each depth.
 	res := getTmp(depth)
 	for j := depth; j < getDepth(limit); j++ {
-		res = h.doHash(res, res, zeroHashes[j][:])[:32]
+		res = h.doHash(res, res, h.zeroHashes[j][:])[:32]
 	}
 	return append(dst, res...)
 }
@@ -1,7 +1,15 @@
 package ssz
 
 import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
 	"testing"
+
+	"github.com/minio/sha256-simd"
 )
 
 func TestDepth(t *testing.T) {
@@ -21,6 +29,8 @@ func TestDepth(t *testing.T) {
 		{9, 4},
 		{16, 4},
 		{1024, 10},
+		{1 << 40, 40},
+		{1<<40 + 1, 41},
 	}
 	for _, c := range cases {
 		if depth := getDepth(c.Num); depth != c.Res {
@@ -46,10 +56,280 @@ func TestNextPowerOfTwo(t *testing.T) {
 		{10, 16},
 		{11, 16},
 		{13, 16},
+		{1 << 32, 1 << 32},
+		{1<<32 + 1, 1 << 33},
 	}
 	for _, c := range cases {
-		if next := nextPowerOfTwo(c.Num); uint64(next) != c.Res {
+		if next := NextPowerOfTwo(c.Num); next != c.Res {
 			t.Fatalf("num %d, expected %d but found %d", c.Num, c.Res, next)
 		}
 	}
 }
+
+func TestChunkCount(t *testing.T) {
+	for byteLen, expected := range map[int]int{0: 0, 1: 1, 32: 1, 33: 2, 64: 2, 1000: 32} {
+		if num := ChunkCount(byteLen); num != expected {
+			t.Fatalf("expected %d chunks of %d bytes but found %d", expected, byteLen, num)
+		}
+	}
+
+	cases := []struct {
+		maxItems, itemSize, limit uint64
+	}{
+		{0, 8, 0},
+		{1024, 1, 32},
+		{1000, 1, 32},
+		{1024, 8, 256},
+		{5, 8, 2},
+		{16, 2, 1},
+		{100, 32, 100},
+		{1 << 40, 32, 1 << 40},
+	}
+	for _, c := range cases {
+		if limit := MerkleizeLimit(c.maxItems, c.itemSize); limit != c.limit {
+			t.Fatalf("expected the limit %d of %d items of %d bytes but found %d", c.limit, c.maxItems, c.itemSize, limit)
+		}
+		if limit := CalculateLimit(c.maxItems, 0, c.itemSize); c.limit != 0 && limit != c.limit {
+			t.Fatalf("expected the same limit %d with CalculateLimit but found %d", c.limit, limit)
+		}
+	}
+}
+
+func TestPutZeroBytes(t *testing.T) {
+	for _, n := range []int{0, 1, 4, 32, 33, 64, 65, 100, 1024} {
+		expected := NewHasher()
+		expected.PutBytes(make([]byte, n))
+
+		hh := NewHasher()
+		hh.PutZeroBytes(n)
+		if !bytes.Equal(hh.buf, expected.buf) {
+			t.Fatalf("expected the root of %d zero bytes %x but found %x", n, expected.buf, hh.buf)
+		}
+	}
+}
+
+func TestReserve(t *testing.T) {
+	hh := NewHasher()
+	hh.PutUint64(1)
+	hh.Reserve(4)
+	if cap(hh.buf) < 5*32 {
+		t.Fatalf("expected capacity for 5 leaves but found %d bytes", cap(hh.buf))
+	}
+	buf := hh.buf
+	for i := 0; i < 4; i++ {
+		hh.PutUint64(uint64(i))
+	}
+	if &buf[:1][0] != &hh.buf[0] {
+		t.Fatal("the buffer was reallocated")
+	}
+	if hh.buf[0] != 1 || len(hh.buf) != 5*32 {
+		t.Fatal("bad leaves")
+	}
+}
+
+func TestAppendUintArray(t *testing.T) {
+	for _, num := range []int{0, 1, 3, 4, 5, 100} {
+		b64 := make([]uint64, num)
+		b32 := make([]uint32, num)
+		b16 := make([]uint16, num)
+		for i := 0; i < num; i++ {
+			b64[i] = uint64(i) * 0x0102030405060708
+			b32[i] = uint32(i) * 0x01020304
+			b16[i] = uint16(i) * 0x0102
+		}
+
+		h := NewHasher()
+		h.AppendUint64Array(b64)
+		h.AppendUint32Array(b32)
+		h.AppendUint16Array(b16)
+
+		var expected []byte
+		for i := 0; i < num; i++ {
+			expected = MarshalUint64(expected, b64[i])
+		}
+		for i := 0; i < num; i++ {
+			expected = MarshalUint32(expected, b32[i])
+		}
+		for i := 0; i < num; i++ {
+			expected = MarshalUint16(expected, b16[i])
+		}
+		if !bytes.Equal(h.buf, expected) {
+			t.Fatalf("bad encoding of %d values", num)
+		}
+	}
+}
+
+func BenchmarkPutUint64Array(b *testing.B) {
+	arr := make([]uint64, 1<<16)
+	for i := range arr {
+		arr[i] = uint64(i)
+	}
+
+	h := NewHasher()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.PutUint64Array(arr, 1<<20)
+	}
+}
+
+type uint64Root struct {
+	val uint64
+	err error
+}
+
+func (u *uint64Root) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(u)
+}
+
+func (u *uint64Root) HashTreeRootWith(hh *Hasher) error {
+	if u.err != nil {
+		return u.err
+	}
+	hh.PutUint64(u.val)
+	return nil
+}
+
+func (u *uint64Root) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 8 {
+		return ErrSize
+	}
+	u.val = UnmarshallUint64(buf)
+	return nil
+}
+
+func TestVerifyRoot(t *testing.T) {
+	var root [32]byte
+	root[0] = 5
+	buf := MarshalUint64(nil, 5)
+
+	obj := new(uint64Root)
+	if err := VerifyRoot(obj, buf, root); err != nil {
+		t.Fatal(err)
+	}
+	if obj.val != 5 {
+		t.Fatal("expected the buffer to be decoded")
+	}
+
+	// the error describes both roots
+	err := VerifyRoot(new(uint64Root), MarshalUint64(nil, 6), root)
+	if !errors.Is(err, ErrRootMismatch) {
+		t.Fatalf("expected a root mismatch but found %v", err)
+	}
+	if !strings.Contains(err.Error(), fmt.Sprintf("expected 0x%x but found 0x06", root)) {
+		t.Fatalf("bad error %v", err)
+	}
+
+	// the decoding and hashing errors are returned
+	if err := VerifyRoot(new(uint64Root), buf[:4], root); err != ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+	if err := VerifyRoot(&uint64Root{err: ErrConcreteType}, buf, root); err != ErrConcreteType {
+		t.Fatalf("expected ErrConcreteType but found %v", err)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal(&uint64Root{val: 1}, &uint64Root{val: 1}) {
+		t.Fatal("expected equal objects")
+	}
+	if Equal(&uint64Root{val: 1}, &uint64Root{val: 2}) {
+		t.Fatal("expected different objects")
+	}
+	// an object that fails to hash is not equal
+	if Equal(&uint64Root{err: ErrSize}, &uint64Root{err: ErrSize}) {
+		t.Fatal("expected different objects")
+	}
+}
+
+// countingHash is a stub hash that counts the digests of the wrapped hash
+type countingHash struct {
+	hash.Hash
+	sums int
+}
+
+func (c *countingHash) Sum(b []byte) []byte {
+	c.sums++
+	return c.Hash.Sum(b)
+}
+
+// merkleizeWith merkleizes the chunks padded with zero chunks up to the limit
+// (a power of two) with the given hash function
+func merkleizeWith(fn func() hash.Hash, chunks [][32]byte, limit int) (root [32]byte) {
+	layer := append([][32]byte{}, chunks...)
+	for len(layer) < limit {
+		layer = append(layer, [32]byte{})
+	}
+	hh := fn()
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			hh.Reset()
+			hh.Write(layer[2*i][:])
+			hh.Write(layer[2*i+1][:])
+			hh.Sum(next[i][:0])
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+func TestNewHasherWithHash(t *testing.T) {
+	// a container with an uint64 and a list of 2 uint64 with a limit of 16 chunks
+	hashRoot := func(hh *Hasher) [32]byte {
+		indx := hh.Index()
+		hh.PutUint64(1)
+
+		subIndx := hh.Index()
+		hh.AppendUint64(2)
+		hh.AppendUint64(3)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(subIndx, 2, 16)
+
+		hh.Merkleize(indx)
+		root, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	expectedRoot := func(fn func() hash.Hash) [32]byte {
+		var leaf, chunk, length [32]byte
+		leaf[0], chunk[0], chunk[8], length[0] = 1, 2, 3, 2
+		list := merkleizeWith(fn, [][32]byte{merkleizeWith(fn, [][32]byte{chunk}, 16), length}, 2)
+		return merkleizeWith(fn, [][32]byte{leaf, list}, 2)
+	}
+
+	sha256Root := hashRoot(NewHasher())
+	if sha256Root != expectedRoot(sha256.New) {
+		t.Fatal("bad sha256 root")
+	}
+
+	// the hash of the hasher is used to merkleize the chunks
+	stub := &countingHash{Hash: sha256.New()}
+	hh := NewHasherWithHash(func() hash.Hash { return stub })
+	stub.sums = 0
+	if root := hashRoot(hh); root != sha256Root {
+		t.Fatal("bad root with the stub hash")
+	}
+	if stub.sums == 0 {
+		t.Fatal("the stub hash was not used")
+	}
+
+	// the zero subtrees are hashed with the custom hash too
+	root := hashRoot(NewHasherWithHash(sha512.New512_256))
+	if root != expectedRoot(sha512.New512_256) {
+		t.Fatal("bad root with the custom hash")
+	}
+	if root == sha256Root {
+		t.Fatal("expected a different root with the custom hash")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic with a 64 bytes digest")
+		}
+	}()
+	NewHasherWithHash(sha512.New)
+}
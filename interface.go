@@ -7,11 +7,23 @@ type Marshaler interface {
 	SizeSSZ() int
 }
 
+// PooledMarshaler is the interface implemented by types that can marshal themselves
+// into a borrowed buffer of a BufferPool
+type PooledMarshaler interface {
+	MarshalSSZPooled() (*Buffer, error)
+}
+
 // Unmarshaler is the interface implemented by types that can unmarshal a SSZ description of themselves
 type Unmarshaler interface {
 	UnmarshalSSZ(buf []byte) error
 }
 
+// ArenaUnmarshaler is the interface implemented by types that can unmarshal themselves
+// with the memory of an Allocator
+type ArenaUnmarshaler interface {
+	UnmarshalSSZArena(buf []byte, alloc Allocator) error
+}
+
 type HashRoot interface {
 	HashTreeRoot() ([32]byte, error)
 	HashTreeRootWith(hh *Hasher) error
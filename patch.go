@@ -0,0 +1,83 @@
+package ssz
+
+import (
+	"bytes"
+)
+
+// MakePatch creates a patch with the fields of the container that changed between the
+// old and new ssz encodings. The patch uses the same format as the generated
+// MarshalFieldsSSZ: a bitvector with the changed fields followed by the new encoding
+// of each changed field in order. Dynamic fields are prefixed with their length.
+func MakePatch(schema *Schema, old, new []byte) ([]byte, error) {
+	oldFields, err := schema.Split(old)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := schema.Split(new)
+	if err != nil {
+		return nil, err
+	}
+
+	present := make([]byte, (len(schema.Fields)+7)/8)
+	for indx := range schema.Fields {
+		if !bytes.Equal(oldFields[indx], newFields[indx]) {
+			present[indx/8] |= 1 << (indx % 8)
+		}
+	}
+
+	dst := present
+	for indx, f := range schema.Fields {
+		if present[indx/8]&(1<<(indx%8)) == 0 {
+			continue
+		}
+		if !f.IsFixed() {
+			if dst, err = SafeWriteOffset(dst, len(newFields[indx])); err != nil {
+				return nil, err
+			}
+		}
+		dst = append(dst, newFields[indx]...)
+	}
+	return dst, nil
+}
+
+// ApplyPatch applies a patch created with MakePatch to the old ssz encoding
+// and returns the new encoding of the container
+func ApplyPatch(schema *Schema, old, patch []byte) ([]byte, error) {
+	fields, err := schema.Split(old)
+	if err != nil {
+		return nil, err
+	}
+
+	num := len(schema.Fields)
+	if len(patch) < (num+7)/8 {
+		return nil, ErrSize
+	}
+	present := patch[:(num+7)/8]
+	patch = patch[(num+7)/8:]
+	if num%8 != 0 && present[len(present)-1]>>(num%8) != 0 {
+		return nil, ErrUnknownField
+	}
+
+	for indx, f := range schema.Fields {
+		if present[indx/8]&(1<<(indx%8)) == 0 {
+			continue
+		}
+		size := uint64(f.Size)
+		if !f.IsFixed() {
+			if len(patch) < bytesPerLengthOffset {
+				return nil, ErrSize
+			}
+			size = ReadOffset(patch)
+			patch = patch[bytesPerLengthOffset:]
+		}
+		if size > uint64(len(patch)) {
+			return nil, ErrSize
+		}
+		fields[indx] = patch[:size]
+		patch = patch[size:]
+	}
+	if len(patch) != 0 {
+		return nil, ErrSize
+	}
+	return schema.Join(fields)
+}
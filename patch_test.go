@@ -0,0 +1,151 @@
+package ssz
+
+import (
+	"bytes"
+	"testing"
+)
+
+func testPatchSchema() *Schema {
+	return &Schema{
+		Name: "Obj",
+		Fields: []*SchemaField{
+			{Name: "A", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "B", Type: "List[byte,32]"},
+			{Name: "C", Type: "uint16", Size: 2, Fixed: true},
+			{Name: "D", Type: "List[byte,32]"},
+		},
+	}
+}
+
+func TestSchemaSplitJoin(t *testing.T) {
+	s := testPatchSchema()
+	if str := s.String(); str != "Container(A:uint64,B:List[byte,32],C:uint16,D:List[byte,32])" {
+		t.Fatalf("bad schema string %s", str)
+	}
+
+	fields := [][]byte{
+		MarshalUint64(nil, 1),
+		{0x1, 0x2},
+		MarshalUint16(nil, 2),
+		{0x3},
+	}
+	buf, err := s.Join(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != s.FixedSize()+3 {
+		t.Fatalf("bad size %d", len(buf))
+	}
+	fields2, err := s.Split(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for indx := range fields {
+		if !bytes.Equal(fields[indx], fields2[indx]) {
+			t.Fatalf("bad field %d", indx)
+		}
+	}
+
+	// the first offset must point to the end of the fixed part
+	buf[8]++
+	if _, err := s.Split(buf); err == nil {
+		t.Fatal("expected error for a wrong first offset")
+	}
+	// too short
+	if _, err := s.Split(buf[:s.FixedSize()-1]); err == nil {
+		t.Fatal("expected error for a short buffer")
+	}
+	// fixed fields must have their size
+	fields[0] = fields[0][:7]
+	if _, err := s.Join(fields); err == nil {
+		t.Fatal("expected error for a short fixed field")
+	}
+}
+
+func TestSchemaFramedField(t *testing.T) {
+	s := &Schema{
+		Name: "Frame",
+		Fields: []*SchemaField{
+			{Name: "Len", Type: "uint16", Size: 2, Fixed: true},
+			{Name: "Data", Type: "List[byte,32]", LengthFrom: "Len"},
+			{Name: "Tag", Type: "uint8", Size: 1, Fixed: true},
+		},
+	}
+	if size := s.FixedSize(); size != 3 {
+		t.Fatalf("bad fixed size %d", size)
+	}
+
+	fields := [][]byte{MarshalUint16(nil, 2), {0x1, 0x2}, {0x3}}
+	buf, err := s.Join(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, []byte{0x2, 0x0, 0x1, 0x2, 0x3}) {
+		t.Fatalf("bad encoding %x", buf)
+	}
+	fields2, err := s.Split(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for indx := range fields {
+		if !bytes.Equal(fields[indx], fields2[indx]) {
+			t.Fatalf("bad field %d", indx)
+		}
+	}
+
+	// the length field must match the framed bytes
+	if _, err := s.Split(append(buf, 0)); err != ErrLengthField {
+		t.Fatalf("expected ErrLengthField but found %v", err)
+	}
+	fields[1] = []byte{0x1}
+	if _, err := s.Join(fields); err != ErrLengthField {
+		t.Fatalf("expected ErrLengthField but found %v", err)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	s := testPatchSchema()
+
+	old, err := s.Join([][]byte{MarshalUint64(nil, 1), {0x1, 0x2}, MarshalUint16(nil, 2), {0x3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := s.Join([][]byte{MarshalUint64(nil, 1), {0x1, 0x2, 0x3, 0x4}, MarshalUint16(nil, 2), {0x3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := MakePatch(s, old, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the bitvector, the offset and the new encoding of the changed field
+	if len(patch) != 1+4+4 {
+		t.Fatalf("expected only the changed field in the patch but found %d bytes", len(patch))
+	}
+	res, err := ApplyPatch(s, old, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res, updated) {
+		t.Fatal("bad patched encoding")
+	}
+
+	// an empty patch does not modify the encoding
+	patch, err = MakePatch(s, old, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patch) != 1 {
+		t.Fatalf("expected an empty patch but found %d bytes", len(patch))
+	}
+
+	// unknown fields in the bitvector
+	if _, err := ApplyPatch(s, old, []byte{0x10}); err != ErrUnknownField {
+		t.Fatalf("expected unknown field error but found %v", err)
+	}
+	// trailing bytes
+	if _, err := ApplyPatch(s, old, []byte{0x00, 0x01}); err != ErrSize {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
@@ -0,0 +1,260 @@
+package ssz
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Schema describes the layout of the fields of a ssz container
+type Schema struct {
+	// Name is the name of the container
+	Name string
+	// Fields are the fields of the container in order
+	Fields []*SchemaField
+}
+
+// SchemaField is a field of a ssz container
+type SchemaField struct {
+	// Name is the name of the field
+	Name string
+	// Type is the canonical ssz type of the field (i.e. uint64 or List[byte,32])
+	Type string
+	// Size is the size of the encoding of the field if it is fixed or zero if it is dynamic
+	Size int
+	// Fixed is true if the field has a fixed size. It is set explicitly since a fixed
+	// field can have a zero size (i.e. an empty container).
+	Fixed bool
+	// LengthFrom is the name of the uint field with the length of the dynamic field if it
+	// is framed (ssz-length-from). The framed field is encoded in place without an offset,
+	// so the container does not have other dynamic fields.
+	LengthFrom string
+}
+
+// IsFixed returns true if the field has a fixed size
+func (f *SchemaField) IsFixed() bool {
+	return f.Fixed
+}
+
+// String returns the canonical type signature of the container
+func (s *Schema) String() string {
+	fields := make([]string, 0, len(s.Fields))
+	for _, f := range s.Fields {
+		fields = append(fields, f.Name+":"+f.Type)
+	}
+	return "Container(" + strings.Join(fields, ",") + ")"
+}
+
+// FixedSize returns the size of the fixed part of the container
+func (s *Schema) FixedSize() int {
+	size := 0
+	for _, f := range s.Fields {
+		if f.IsFixed() {
+			size += f.Size
+		} else if f.LengthFrom == "" {
+			size += bytesPerLengthOffset
+		}
+	}
+	return size
+}
+
+// frameLength returns the value of the little endian uint field with the length of
+// a framed field
+func (s *Schema) frameLength(fields [][]byte, name string) (uint64, error) {
+	for indx, f := range s.Fields {
+		if f.Name != name {
+			continue
+		}
+		b := fields[indx]
+		if len(b) == 0 || len(b) > 8 {
+			return 0, ErrLengthField
+		}
+		var n uint64
+		for i := len(b) - 1; i >= 0; i-- {
+			n = n<<8 | uint64(b[i])
+		}
+		return n, nil
+	}
+	return 0, ErrLengthField
+}
+
+// Split splits the ssz encoding of the container into the encodings of each field
+func (s *Schema) Split(buf []byte) ([][]byte, error) {
+	fixedSize := s.FixedSize()
+	if len(buf) < fixedSize {
+		return nil, ErrSize
+	}
+
+	fields := make([][]byte, len(s.Fields))
+	offsets := []int{}
+
+	pos := 0
+	for indx, f := range s.Fields {
+		if f.IsFixed() {
+			fields[indx] = buf[pos : pos+f.Size]
+			pos += f.Size
+			continue
+		}
+		if f.LengthFrom != "" {
+			// the framed bytes are the ones beyond the fixed part
+			n, err := s.frameLength(fields, f.LengthFrom)
+			if err != nil {
+				return nil, err
+			}
+			if n != uint64(len(buf)-fixedSize) {
+				return nil, ErrLengthField
+			}
+			fields[indx] = buf[pos : pos+int(n)]
+			pos += int(n)
+			continue
+		}
+		offset64 := ReadOffset(buf[pos : pos+bytesPerLengthOffset])
+		if offset64 > uint64(len(buf)) {
+			return nil, ErrOffset
+		}
+		offset := int(offset64)
+		if len(offsets) == 0 {
+			if offset != fixedSize {
+				return nil, ErrInvalidVariableOffset
+			}
+		} else if offset < offsets[len(offsets)-1] {
+			return nil, ErrOffset
+		}
+		offsets = append(offsets, offset)
+		pos += bytesPerLengthOffset
+	}
+	if len(offsets) == 0 && len(buf) != pos {
+		return nil, ErrSize
+	}
+
+	// the dynamic fields are encoded between their offset and the next one
+	offsets = append(offsets, len(buf))
+	dynIndx := 0
+	for indx, f := range s.Fields {
+		if f.IsFixed() || f.LengthFrom != "" {
+			continue
+		}
+		fields[indx] = buf[offsets[dynIndx]:offsets[dynIndx+1]]
+		dynIndx++
+	}
+	return fields, nil
+}
+
+// Join creates the ssz encoding of the container from the encodings of each field
+func (s *Schema) Join(fields [][]byte) ([]byte, error) {
+	if len(fields) != len(s.Fields) {
+		return nil, ErrSize
+	}
+	size := s.FixedSize()
+	for indx, f := range s.Fields {
+		if f.IsFixed() {
+			if len(fields[indx]) != f.Size {
+				return nil, ErrSize
+			}
+		} else {
+			size += len(fields[indx])
+		}
+	}
+
+	dst := make([]byte, 0, size)
+	offset := s.FixedSize()
+
+	var err error
+	for indx, f := range s.Fields {
+		if f.IsFixed() {
+			dst = append(dst, fields[indx]...)
+			continue
+		}
+		if f.LengthFrom != "" {
+			// the framed bytes are written in place
+			n, err := s.frameLength(fields, f.LengthFrom)
+			if err != nil {
+				return nil, err
+			}
+			if n != uint64(len(fields[indx])) {
+				return nil, ErrLengthField
+			}
+			dst = append(dst, fields[indx]...)
+			continue
+		}
+		if dst, err = SafeWriteOffset(dst, offset); err != nil {
+			return nil, err
+		}
+		offset += len(fields[indx])
+	}
+	for indx, f := range s.Fields {
+		if !f.IsFixed() && f.LengthFrom == "" {
+			dst = append(dst, fields[indx]...)
+		}
+	}
+	return dst, nil
+}
+
+// SchemaObject is a ssz object that describes the layout of its fields
+type SchemaObject interface {
+	Marshaler
+	Unmarshaler
+	HashRoot
+	SSZSchema() *Schema
+}
+
+// SchemaRegistry holds the types generated with the runtime-schema flag. They are
+// registered when their package is initialized.
+var SchemaRegistry = &TypeRegistry{}
+
+// TypeRegistry is a set of ssz types that can be enumerated and created at runtime,
+// i.e. to decode any registered type in a debug endpoint
+type TypeRegistry struct {
+	lock  sync.RWMutex
+	types map[string]func() SchemaObject
+}
+
+// Register adds the type with the given name, which is created with newObj.
+// It panics if the name is already registered.
+func (r *TypeRegistry) Register(name string, newObj func() SchemaObject) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.types[name]; ok {
+		panic(fmt.Sprintf("ssz: type %s registered twice", name))
+	}
+	if r.types == nil {
+		r.types = map[string]func() SchemaObject{}
+	}
+	r.types[name] = newObj
+}
+
+// Names returns the names of the registered types in order
+func (r *TypeRegistry) Names() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	names := make([]string, 0, len(r.types))
+	for name := range r.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates an empty object of the registered type
+func (r *TypeRegistry) New(name string) (SchemaObject, bool) {
+	r.lock.RLock()
+	newObj, ok := r.types[name]
+	r.lock.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	return newObj(), true
+}
+
+// Schema returns the layout of the fields of the registered type
+func (r *TypeRegistry) Schema(name string) (*Schema, bool) {
+	obj, ok := r.New(name)
+	if !ok {
+		return nil, false
+	}
+	return obj.SSZSchema(), true
+}
@@ -8,11 +8,17 @@ import (
 	external2Alias "github.com/photon-storage/fastssz/spectests/external2"
 )
 
-// MarshalSSZ ssz marshals the AggregateAndProof object
+// MarshalSSZ ssz marshals the AggregateAndProof object to a new buffer owned by the caller
 func (a *AggregateAndProof) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
 }
 
+// MarshalSSZPooled ssz marshals the AggregateAndProof object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (a *AggregateAndProof) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(a)
+}
+
 // MarshalSSZTo ssz marshals the AggregateAndProof object to a target array
 func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -22,7 +28,9 @@ func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = ssz.MarshalUint64(dst, a.Index)
 
 	// Offset (1) 'Aggregate'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if a.Aggregate == nil {
 		a.Aggregate = new(Attestation)
 	}
@@ -43,6 +51,11 @@ func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the AggregateAndProof object
 func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the AggregateAndProof object with the memory of the allocator
+func (a *AggregateAndProof) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 108 {
@@ -60,12 +73,12 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 108 {
+	if o1 != 108 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (2) 'SelectionProof'
-	if err = a.SelectionProof.UnmarshalSSZ(buf[12:108]); err != nil {
+	if err = ssz.UnmarshalWithAllocator(&a.SelectionProof, buf[12:108], alloc); err != nil {
 		return err
 	}
 
@@ -73,15 +86,25 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o1:]
 		if a.Aggregate == nil {
-			a.Aggregate = new(Attestation)
+			a.Aggregate = ssz.AllocNew[Attestation](alloc)
 		}
-		if err = a.Aggregate.UnmarshalSSZ(buf); err != nil {
+		if err = a.Aggregate.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the AggregateAndProof object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (a *AggregateAndProof) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := a.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AggregateAndProof object
 func (a *AggregateAndProof) SizeSSZ() (size int) {
 	size = 108
@@ -103,6 +126,7 @@ func (a *AggregateAndProof) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the AggregateAndProof object with a hasher
 func (a *AggregateAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'Index'
 	hh.PutUint64(a.Index)
@@ -121,11 +145,115 @@ func (a *AggregateAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Checkpoint object
+// MerkleProof returns the merkle branch that proves the field against the root of the AggregateAndProof object
+func (a *AggregateAndProof) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Index":
+		leaf = 0
+	case "Aggregate":
+		leaf = 1
+	case "SelectionProof":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(a.Index)
+
+	// Field (1) 'Aggregate'
+	if err = a.Aggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'SelectionProof'
+	if err = a.SelectionProof.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the AggregateAndProof object are zero
+func (a *AggregateAndProof) IsZeroSSZ() bool {
+	// Field (0) 'Index'
+	if a.Index != 0 {
+		return false
+	}
+
+	// Field (1) 'Aggregate'
+	if a.Aggregate != nil && !a.Aggregate.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'SelectionProof'
+	if !ssz.IsZero(&a.SelectionProof) {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the AggregateAndProof object into dst reusing the memory of dst
+func (a *AggregateAndProof) CopyInto(dst *AggregateAndProof) {
+	// Field (0) 'Index'
+	dst.Index = a.Index
+
+	// Field (1) 'Aggregate'
+	if a.Aggregate == nil {
+		dst.Aggregate = nil
+	} else {
+		if dst.Aggregate == nil {
+			dst.Aggregate = new(Attestation)
+		}
+		a.Aggregate.CopyInto(dst.Aggregate)
+	}
+
+	// Field (2) 'SelectionProof'
+	if obj, ok := interface{}(&a.SelectionProof).(interface{ CopyInto(*external.Signature) }); ok {
+		obj.CopyInto(&dst.SelectionProof)
+	} else {
+		dst.SelectionProof = a.SelectionProof
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the AggregateAndProof object
+func (a *AggregateAndProof) SSZSchemaString() string {
+	return "Container(Index:uint64,Aggregate:Attestation,SelectionProof:Signature)"
+}
+
+// SSZSchema returns the layout of the fields of the AggregateAndProof object
+func (a *AggregateAndProof) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "AggregateAndProof",
+		Fields: []*ssz.SchemaField{
+			{Name: "Index", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Aggregate", Type: "Attestation"},
+			{Name: "SelectionProof", Type: "Signature", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Checkpoint object to a new buffer owned by the caller
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
 }
 
+// MarshalSSZPooled ssz marshals the Checkpoint object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (c *Checkpoint) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(c)
+}
+
 // MarshalSSZTo ssz marshals the Checkpoint object to a target array
 func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -145,6 +273,11 @@ func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Checkpoint object
 func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Checkpoint object with the memory of the allocator
+func (c *Checkpoint) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 40 {
@@ -156,19 +289,36 @@ func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
 
 	// Field (1) 'Root'
 	if cap(c.Root) == 0 {
-		c.Root = make([]byte, 0, len(buf[8:40]))
+		c.Root = ssz.AllocBytes(alloc, len(buf[8:40]))[:0]
 	}
-	c.Root = append(c.Root, buf[8:40]...)
+	c.Root = append(c.Root[:0], buf[8:40]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Checkpoint object at the start of the buffer and
+// returns the number of bytes consumed
+func (c *Checkpoint) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 40 {
+		return 0, ssz.ErrSize
+	}
+	if err := c.UnmarshalSSZ(buf[:40]); err != nil {
+		return 0, err
+	}
+	return 40, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Checkpoint object
 func (c *Checkpoint) SizeSSZ() (size int) {
 	size = 40
 	return
 }
 
+// SizeSSZCheckpoint returns the ssz encoded size in bytes of any Checkpoint object
+func SizeSSZCheckpoint() int {
+	return 40
+}
+
 // HashTreeRoot ssz hashes the Checkpoint object
 func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -177,6 +327,7 @@ func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Checkpoint object with a hasher
 func (c *Checkpoint) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Epoch'
 	hh.PutUint64(uint64(c.Epoch))
@@ -192,23 +343,109 @@ func (c *Checkpoint) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the AttestationData object
+// MerkleProof returns the merkle branch that proves the field against the root of the Checkpoint object
+func (c *Checkpoint) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Epoch":
+		leaf = 0
+	case "Root":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(uint64(c.Epoch))
+
+	// Field (1) 'Root'
+	if len(c.Root) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Root)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Checkpoint object are zero
+func (c *Checkpoint) IsZeroSSZ() bool {
+	// Field (0) 'Epoch'
+	if c.Epoch != 0 {
+		return false
+	}
+
+	// Field (1) 'Root'
+	for _, elem := range c.Root {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the Checkpoint object into dst reusing the memory of dst
+func (c *Checkpoint) CopyInto(dst *Checkpoint) {
+	// Field (0) 'Epoch'
+	dst.Epoch = c.Epoch
+
+	// Field (1) 'Root'
+	dst.Root = append(dst.Root[:0], c.Root...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Checkpoint object
+func (c *Checkpoint) SSZSchemaString() string {
+	return "Container(Epoch:uint64,Root:Vector[byte,32])"
+}
+
+// SSZSchema returns the layout of the fields of the Checkpoint object
+func (c *Checkpoint) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Checkpoint",
+		Fields: []*ssz.SchemaField{
+			{Name: "Epoch", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Root", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the AttestationData object to a new buffer owned by the caller
 func (a *AttestationData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
 }
 
+// MarshalSSZPooled ssz marshals the AttestationData object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (a *AttestationData) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(a)
+}
+
 // MarshalSSZTo ssz marshals the AttestationData object to a target array
 func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, uint64(a.Slot))
+	{
+		dst = append(dst, make([]byte, 48)...)
+		fixed := dst[len(dst)-48:]
 
-	// Field (1) 'Index'
-	dst = ssz.MarshalUint64(dst, a.Index)
+		// Field (0) 'Slot'
+		ssz.PutUint64(fixed[0:8], uint64(a.Slot))
 
-	// Field (2) 'BeaconBlockHash'
-	dst = append(dst, a.BeaconBlockHash[:]...)
+		// Field (1) 'Index'
+		ssz.PutUint64(fixed[8:16], a.Index)
+
+		// Field (2) 'BeaconBlockHash'
+		copy(fixed[16:48], a.BeaconBlockHash[:])
+	}
 
 	// Field (3) 'Source'
 	if a.Source != nil {
@@ -229,6 +466,11 @@ func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the AttestationData object
 func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the AttestationData object with the memory of the allocator
+func (a *AttestationData) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 128 {
@@ -246,29 +488,46 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 
 	// Field (3) 'Source'
 	if a.Source == nil {
-		a.Source = new(Checkpoint)
+		a.Source = ssz.AllocNew[Checkpoint](alloc)
 	}
-	if err = a.Source.UnmarshalSSZ(buf[48:88]); err != nil {
+	if err = a.Source.UnmarshalSSZArena(buf[48:88], alloc); err != nil {
 		return err
 	}
 
 	// Field (4) 'Target'
 	if a.Target == nil {
-		a.Target = new(Checkpoint)
+		a.Target = ssz.AllocNew[Checkpoint](alloc)
 	}
-	if err = a.Target.UnmarshalSSZ(buf[88:128]); err != nil {
+	if err = a.Target.UnmarshalSSZArena(buf[88:128], alloc); err != nil {
 		return err
 	}
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the AttestationData object at the start of the buffer and
+// returns the number of bytes consumed
+func (a *AttestationData) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 128 {
+		return 0, ssz.ErrSize
+	}
+	if err := a.UnmarshalSSZ(buf[:128]); err != nil {
+		return 0, err
+	}
+	return 128, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AttestationData object
 func (a *AttestationData) SizeSSZ() (size int) {
 	size = 128
 	return
 }
 
+// SizeSSZAttestationData returns the ssz encoded size in bytes of any AttestationData object
+func SizeSSZAttestationData() int {
+	return 128
+}
+
 // HashTreeRoot ssz hashes the AttestationData object
 func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
@@ -277,6 +536,7 @@ func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the AttestationData object with a hasher
 func (a *AttestationData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(5)
 
 	// Field (0) 'Slot'
 	hh.PutUint64(uint64(a.Slot))
@@ -305,18 +565,159 @@ func (a *AttestationData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Attestation object
+// MerkleProof returns the merkle branch that proves the field against the root of the AttestationData object
+func (a *AttestationData) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Slot":
+		leaf = 0
+	case "Index":
+		leaf = 1
+	case "BeaconBlockHash":
+		leaf = 2
+	case "Source":
+		leaf = 3
+	case "Target":
+		leaf = 4
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(a.Slot))
+
+	// Field (1) 'Index'
+	hh.PutUint64(a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	hh.PutBytes(a.BeaconBlockHash[:])
+
+	// Field (3) 'Source'
+	if a.Source != nil {
+		if err = a.Source.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Target'
+	if a.Target != nil {
+		if err = a.Target.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the AttestationData object are zero
+func (a *AttestationData) IsZeroSSZ() bool {
+	// Field (0) 'Slot'
+	if a.Slot != 0 {
+		return false
+	}
+
+	// Field (1) 'Index'
+	if a.Index != 0 {
+		return false
+	}
+
+	// Field (2) 'BeaconBlockHash'
+	if a.BeaconBlockHash != [32]byte{} {
+		return false
+	}
+
+	// Field (3) 'Source'
+	if a.Source != nil && !a.Source.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (4) 'Target'
+	if a.Target != nil && !a.Target.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the AttestationData object into dst reusing the memory of dst
+func (a *AttestationData) CopyInto(dst *AttestationData) {
+	// Field (0) 'Slot'
+	dst.Slot = a.Slot
+
+	// Field (1) 'Index'
+	dst.Index = a.Index
+
+	// Field (2) 'BeaconBlockHash'
+	dst.BeaconBlockHash = a.BeaconBlockHash
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		dst.Source = nil
+	} else {
+		if dst.Source == nil {
+			dst.Source = new(Checkpoint)
+		}
+		a.Source.CopyInto(dst.Source)
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		dst.Target = nil
+	} else {
+		if dst.Target == nil {
+			dst.Target = new(Checkpoint)
+		}
+		a.Target.CopyInto(dst.Target)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the AttestationData object
+func (a *AttestationData) SSZSchemaString() string {
+	return "Container(Slot:uint64,Index:uint64,BeaconBlockHash:Vector[byte,32],Source:Checkpoint,Target:Checkpoint)"
+}
+
+// SSZSchema returns the layout of the fields of the AttestationData object
+func (a *AttestationData) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "AttestationData",
+		Fields: []*ssz.SchemaField{
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Index", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "BeaconBlockHash", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Source", Type: "Checkpoint", Size: 40, Fixed: true},
+			{Name: "Target", Type: "Checkpoint", Size: 40, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Attestation object to a new buffer owned by the caller
 func (a *Attestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
 }
 
+// MarshalSSZPooled ssz marshals the Attestation object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (a *Attestation) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(a)
+}
+
 // MarshalSSZTo ssz marshals the Attestation object to a target array
 func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(228)
 
 	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(a.AggregationBits)
 
 	// Field (1) 'Data'
@@ -345,6 +746,11 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Attestation object
 func (a *Attestation) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Attestation object with the memory of the allocator
+func (a *Attestation) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 228 {
@@ -359,23 +765,23 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 228 {
+	if o0 != 228 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Data'
 	if a.Data == nil {
-		a.Data = new(AttestationData)
+		a.Data = ssz.AllocNew[AttestationData](alloc)
 	}
-	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = a.Data.UnmarshalSSZArena(buf[4:132], alloc); err != nil {
 		return err
 	}
 
 	// Field (2) 'Signature'
 	if a.Signature == nil {
-		a.Signature = new(external.Signature)
+		a.Signature = ssz.AllocNew[external.Signature](alloc)
 	}
-	if err = a.Signature.UnmarshalSSZ(buf[132:228]); err != nil {
+	if err = ssz.UnmarshalWithAllocator(a.Signature, buf[132:228], alloc); err != nil {
 		return err
 	}
 
@@ -386,13 +792,23 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 		if cap(a.AggregationBits) == 0 {
-			a.AggregationBits = make([]byte, 0, len(buf))
+			a.AggregationBits = ssz.AllocBytes(alloc, len(buf))[:0]
 		}
-		a.AggregationBits = append(a.AggregationBits, buf...)
+		a.AggregationBits = append(a.AggregationBits[:0], buf...)
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Attestation object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (a *Attestation) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := a.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Attestation object
 func (a *Attestation) SizeSSZ() (size int) {
 	size = 228
@@ -411,6 +827,7 @@ func (a *Attestation) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Attestation object with a hasher
 func (a *Attestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'AggregationBits'
 	if len(a.AggregationBits) == 0 {
@@ -437,66 +854,212 @@ func (a *Attestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the DepositData object
-func (d *DepositData) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
-}
-
-// MarshalSSZTo ssz marshals the DepositData object to a target array
-func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MerkleProof returns the merkle branch that proves the field against the root of the Attestation object
+func (a *Attestation) MerkleProof(field string) (proof [][32]byte, err error) {
 
-	// Field (0) 'Pubkey'
-	dst = append(dst, d.Pubkey[:]...)
+	var leaf int
+	switch field {
+	case "AggregationBits":
+		leaf = 0
+	case "Data":
+		leaf = 1
+	case "Signature":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
 
-	// Field (1) 'WithdrawalCredentials'
-	dst = append(dst, d.WithdrawalCredentials[:]...)
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
 
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, d.Amount)
+	indx := hh.Index()
 
-	// Field (3) 'Signature'
-	if len(d.Signature) != 96 {
-		err = ssz.ErrBytesLength
+	// Field (0) 'AggregationBits'
+	if len(a.AggregationBits) == 0 {
+		err = ssz.ErrEmptyBitlist
 		return
 	}
-	dst = append(dst, d.Signature...)
+	hh.PutBitlist(a.AggregationBits, 2048)
 
-	return
-}
+	// Field (1) 'Data'
+	if a.Data != nil {
+		if err = a.Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
 
-// UnmarshalSSZ ssz unmarshals the DepositData object
-func (d *DepositData) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 184 {
-		return ssz.ErrSize
+	// Field (2) 'Signature'
+	if a.Signature != nil {
+		if err = a.Signature.HashTreeRootWith(hh); err != nil {
+			return
+		}
 	}
 
-	// Field (0) 'Pubkey'
-	copy(d.Pubkey[:], buf[0:48])
+	return hh.Branch(indx, leaf)
 
-	// Field (1) 'WithdrawalCredentials'
-	copy(d.WithdrawalCredentials[:], buf[48:80])
+}
 
-	// Field (2) 'Amount'
-	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+// IsZeroSSZ returns true if all the fields of the Attestation object are zero
+func (a *Attestation) IsZeroSSZ() bool {
+	// Field (0) 'AggregationBits'
+	if len(a.AggregationBits) != 0 {
+		return false
+	}
 
-	// Field (3) 'Signature'
+	// Field (1) 'Data'
+	if a.Data != nil && !a.Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'Signature'
+	if a.Signature != nil && !ssz.IsZero(a.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the Attestation object into dst reusing the memory of dst
+func (a *Attestation) CopyInto(dst *Attestation) {
+	// Field (0) 'AggregationBits'
+	dst.AggregationBits = append(dst.AggregationBits[:0], a.AggregationBits...)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = new(AttestationData)
+		}
+		a.Data.CopyInto(dst.Data)
+	}
+
+	// Field (2) 'Signature'
+	if a.Signature == nil {
+		dst.Signature = nil
+	} else {
+		if dst.Signature == nil {
+			dst.Signature = new(external.Signature)
+		}
+		if obj, ok := interface{}(a.Signature).(interface{ CopyInto(*external.Signature) }); ok {
+			obj.CopyInto(dst.Signature)
+		} else {
+			*dst.Signature = *a.Signature
+		}
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Attestation object
+func (a *Attestation) SSZSchemaString() string {
+	return "Container(AggregationBits:Bitlist[2048],Data:AttestationData,Signature:Signature)"
+}
+
+// SSZSchema returns the layout of the fields of the Attestation object
+func (a *Attestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Attestation",
+		Fields: []*ssz.SchemaField{
+			{Name: "AggregationBits", Type: "Bitlist[2048]"},
+			{Name: "Data", Type: "AttestationData", Size: 128, Fixed: true},
+			{Name: "Signature", Type: "Signature", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the DepositData object to a new buffer owned by the caller
+func (d *DepositData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZPooled ssz marshals the DepositData object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (d *DepositData) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositData object to a target array
+func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	{
+		dst = append(dst, make([]byte, 88)...)
+		fixed := dst[len(dst)-88:]
+
+		// Field (0) 'Pubkey'
+		copy(fixed[0:48], d.Pubkey[:])
+
+		// Field (1) 'WithdrawalCredentials'
+		copy(fixed[48:80], d.WithdrawalCredentials[:])
+
+		// Field (2) 'Amount'
+		ssz.PutUint64(fixed[80:88], d.Amount)
+	}
+
+	// Field (3) 'Signature'
+	if len(d.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, d.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositData object
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the DepositData object with the memory of the allocator
+func (d *DepositData) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	copy(d.Pubkey[:], buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	copy(d.WithdrawalCredentials[:], buf[48:80])
+
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Signature'
 	if cap(d.Signature) == 0 {
-		d.Signature = make([]byte, 0, len(buf[88:184]))
+		d.Signature = ssz.AllocBytes(alloc, len(buf[88:184]))[:0]
 	}
-	d.Signature = append(d.Signature, buf[88:184]...)
+	d.Signature = append(d.Signature[:0], buf[88:184]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the DepositData object at the start of the buffer and
+// returns the number of bytes consumed
+func (d *DepositData) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 184 {
+		return 0, ssz.ErrSize
+	}
+	if err := d.UnmarshalSSZ(buf[:184]); err != nil {
+		return 0, err
+	}
+	return 184, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the DepositData object
 func (d *DepositData) SizeSSZ() (size int) {
 	size = 184
 	return
 }
 
+// SizeSSZDepositData returns the ssz encoded size in bytes of any DepositData object
+func SizeSSZDepositData() int {
+	return 184
+}
+
 // HashTreeRoot ssz hashes the DepositData object
 func (d *DepositData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -505,6 +1068,7 @@ func (d *DepositData) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the DepositData object with a hasher
 func (d *DepositData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(4)
 
 	// Field (0) 'Pubkey'
 	hh.PutBytes(d.Pubkey[:])
@@ -526,11 +1090,120 @@ func (d *DepositData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Deposit object
+// MerkleProof returns the merkle branch that proves the field against the root of the DepositData object
+func (d *DepositData) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Pubkey":
+		leaf = 0
+	case "WithdrawalCredentials":
+		leaf = 1
+	case "Amount":
+		leaf = 2
+	case "Signature":
+		leaf = 3
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(d.WithdrawalCredentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(d.Amount)
+
+	// Field (3) 'Signature'
+	if len(d.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(d.Signature)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the DepositData object are zero
+func (d *DepositData) IsZeroSSZ() bool {
+	// Field (0) 'Pubkey'
+	if d.Pubkey != [48]byte{} {
+		return false
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	if d.WithdrawalCredentials != [32]byte{} {
+		return false
+	}
+
+	// Field (2) 'Amount'
+	if d.Amount != 0 {
+		return false
+	}
+
+	// Field (3) 'Signature'
+	for _, elem := range d.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the DepositData object into dst reusing the memory of dst
+func (d *DepositData) CopyInto(dst *DepositData) {
+	// Field (0) 'Pubkey'
+	dst.Pubkey = d.Pubkey
+
+	// Field (1) 'WithdrawalCredentials'
+	dst.WithdrawalCredentials = d.WithdrawalCredentials
+
+	// Field (2) 'Amount'
+	dst.Amount = d.Amount
+
+	// Field (3) 'Signature'
+	dst.Signature = append(dst.Signature[:0], d.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the DepositData object
+func (d *DepositData) SSZSchemaString() string {
+	return "Container(Pubkey:Vector[byte,48],WithdrawalCredentials:Vector[byte,32],Amount:uint64,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the DepositData object
+func (d *DepositData) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "DepositData",
+		Fields: []*ssz.SchemaField{
+			{Name: "Pubkey", Type: "Vector[byte,48]", Size: 48, Fixed: true},
+			{Name: "WithdrawalCredentials", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Amount", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Deposit object to a new buffer owned by the caller
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
 }
 
+// MarshalSSZPooled ssz marshals the Deposit object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (d *Deposit) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(d)
+}
+
 // MarshalSSZTo ssz marshals the Deposit object to a target array
 func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -560,6 +1233,11 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Deposit object
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Deposit object with the memory of the allocator
+func (d *Deposit) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 1240 {
@@ -567,31 +1245,48 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'Proof'
-	d.Proof = make([][]byte, 33)
+	d.Proof = ssz.AllocSlice[[]byte](alloc, 33)
 	for ii := 0; ii < 33; ii++ {
 		if cap(d.Proof[ii]) == 0 {
-			d.Proof[ii] = make([]byte, 0, len(buf[0:1056][ii*32:(ii+1)*32]))
+			d.Proof[ii] = ssz.AllocBytes(alloc, len(buf[0:1056][ii*32:(ii+1)*32]))[:0]
 		}
-		d.Proof[ii] = append(d.Proof[ii], buf[0:1056][ii*32:(ii+1)*32]...)
+		d.Proof[ii] = append(d.Proof[ii][:0], buf[0:1056][ii*32:(ii+1)*32]...)
 	}
 
 	// Field (1) 'Data'
 	if d.Data == nil {
-		d.Data = new(DepositData)
+		d.Data = ssz.AllocNew[DepositData](alloc)
 	}
-	if err = d.Data.UnmarshalSSZ(buf[1056:1240]); err != nil {
+	if err = d.Data.UnmarshalSSZArena(buf[1056:1240], alloc); err != nil {
 		return err
 	}
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Deposit object at the start of the buffer and
+// returns the number of bytes consumed
+func (d *Deposit) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 1240 {
+		return 0, ssz.ErrSize
+	}
+	if err := d.UnmarshalSSZ(buf[:1240]); err != nil {
+		return 0, err
+	}
+	return 1240, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Deposit object
 func (d *Deposit) SizeSSZ() (size int) {
 	size = 1240
 	return
 }
 
+// SizeSSZDeposit returns the ssz encoded size in bytes of any Deposit object
+func SizeSSZDeposit() int {
+	return 1240
+}
+
 // HashTreeRoot ssz hashes the Deposit object
 func (d *Deposit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -600,6 +1295,7 @@ func (d *Deposit) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Deposit object with a hasher
 func (d *Deposit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Proof'
 	{
@@ -629,11 +1325,122 @@ func (d *Deposit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the DepositMessage object
+// MerkleProof returns the merkle branch that proves the field against the root of the Deposit object
+func (d *Deposit) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Proof":
+		leaf = 0
+	case "Data":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Proof'
+	{
+		if len(d.Proof) != 33 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range d.Proof {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'Data'
+	if d.Data != nil {
+		if err = d.Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Deposit object are zero
+func (d *Deposit) IsZeroSSZ() bool {
+	// Field (0) 'Proof'
+	for ii := range d.Proof {
+		for _, elem := range d.Proof[ii] {
+			if elem != 0 {
+				return false
+			}
+		}
+	}
+
+	// Field (1) 'Data'
+	if d.Data != nil && !d.Data.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the Deposit object into dst reusing the memory of dst
+func (d *Deposit) CopyInto(dst *Deposit) {
+	// Field (0) 'Proof'
+	if cap(dst.Proof) < len(d.Proof) {
+		dst.Proof = make([][]byte, len(d.Proof))
+	} else {
+		dst.Proof = dst.Proof[:len(d.Proof)]
+	}
+	for ii := range d.Proof {
+		dst.Proof[ii] = append(dst.Proof[ii][:0], d.Proof[ii]...)
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = new(DepositData)
+		}
+		d.Data.CopyInto(dst.Data)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Deposit object
+func (d *Deposit) SSZSchemaString() string {
+	return "Container(Proof:Vector[Vector[byte,32],33],Data:DepositData)"
+}
+
+// SSZSchema returns the layout of the fields of the Deposit object
+func (d *Deposit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Deposit",
+		Fields: []*ssz.SchemaField{
+			{Name: "Proof", Type: "Vector[Vector[byte,32],33]", Size: 1056, Fixed: true},
+			{Name: "Data", Type: "DepositData", Size: 184, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the DepositMessage object to a new buffer owned by the caller
 func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
 }
 
+// MarshalSSZPooled ssz marshals the DepositMessage object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (d *DepositMessage) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(d)
+}
+
 // MarshalSSZTo ssz marshals the DepositMessage object to a target array
 func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -660,6 +1467,11 @@ func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the DepositMessage object
 func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the DepositMessage object with the memory of the allocator
+func (d *DepositMessage) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 88 {
@@ -668,15 +1480,15 @@ func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Pubkey'
 	if cap(d.Pubkey) == 0 {
-		d.Pubkey = make([]byte, 0, len(buf[0:48]))
+		d.Pubkey = ssz.AllocBytes(alloc, len(buf[0:48]))[:0]
 	}
-	d.Pubkey = append(d.Pubkey, buf[0:48]...)
+	d.Pubkey = append(d.Pubkey[:0], buf[0:48]...)
 
 	// Field (1) 'WithdrawalCredentials'
 	if cap(d.WithdrawalCredentials) == 0 {
-		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+		d.WithdrawalCredentials = ssz.AllocBytes(alloc, len(buf[48:80]))[:0]
 	}
-	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials[:0], buf[48:80]...)
 
 	// Field (2) 'Amount'
 	d.Amount = ssz.UnmarshallUint64(buf[80:88])
@@ -684,12 +1496,29 @@ func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the DepositMessage object at the start of the buffer and
+// returns the number of bytes consumed
+func (d *DepositMessage) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 88 {
+		return 0, ssz.ErrSize
+	}
+	if err := d.UnmarshalSSZ(buf[:88]); err != nil {
+		return 0, err
+	}
+	return 88, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
 func (d *DepositMessage) SizeSSZ() (size int) {
 	size = 88
 	return
 }
 
+// SizeSSZDepositMessage returns the ssz encoded size in bytes of any DepositMessage object
+func SizeSSZDepositMessage() int {
+	return 88
+}
+
 // HashTreeRoot ssz hashes the DepositMessage object
 func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -698,6 +1527,7 @@ func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the DepositMessage object with a hasher
 func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'Pubkey'
 	if len(d.Pubkey) != 48 {
@@ -720,79 +1550,187 @@ func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the IndexedAttestation object
-func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(i)
-}
+// MerkleProof returns the merkle branch that proves the field against the root of the DepositMessage object
+func (d *DepositMessage) MerkleProof(field string) (proof [][32]byte, err error) {
 
-// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
-func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(228)
+	var leaf int
+	switch field {
+	case "Pubkey":
+		leaf = 0
+	case "WithdrawalCredentials":
+		leaf = 1
+	case "Amount":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
 
-	// Offset (0) 'AttestationIndices'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(i.AttestationIndices) * 8
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
 
-	// Field (1) 'Data'
-	if i.Data != nil {
-		if dst, err = i.Data.MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	indx := hh.Index()
 
-	// Field (2) 'Signature'
-	if len(i.Signature) != 96 {
+	// Field (0) 'Pubkey'
+	if len(d.Pubkey) != 48 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, i.Signature...)
+	hh.PutBytes(d.Pubkey)
 
-	// Field (0) 'AttestationIndices'
-	if len(i.AttestationIndices) > 2048 {
-		err = ssz.ErrListTooBig
+	// Field (1) 'WithdrawalCredentials'
+	if len(d.WithdrawalCredentials) != 32 {
+		err = ssz.ErrBytesLength
 		return
 	}
-	for ii := 0; ii < len(i.AttestationIndices); ii++ {
-		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
-	}
+	hh.PutBytes(d.WithdrawalCredentials)
 
-	return
-}
+	// Field (2) 'Amount'
+	hh.PutUint64(d.Amount)
 
-// UnmarshalSSZ ssz unmarshals the IndexedAttestation object
-func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 228 {
-		return ssz.ErrSize
-	}
+	return hh.Branch(indx, leaf)
 
-	tail := buf
-	var o0 uint64
+}
 
-	// Offset (0) 'AttestationIndices'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+// IsZeroSSZ returns true if all the fields of the DepositMessage object are zero
+func (d *DepositMessage) IsZeroSSZ() bool {
+	// Field (0) 'Pubkey'
+	for _, elem := range d.Pubkey {
+		if elem != 0 {
+			return false
+		}
 	}
 
-	if o0 < 228 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (1) 'WithdrawalCredentials'
+	for _, elem := range d.WithdrawalCredentials {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (2) 'Amount'
+	if d.Amount != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the DepositMessage object into dst reusing the memory of dst
+func (d *DepositMessage) CopyInto(dst *DepositMessage) {
+	// Field (0) 'Pubkey'
+	dst.Pubkey = append(dst.Pubkey[:0], d.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst.WithdrawalCredentials = append(dst.WithdrawalCredentials[:0], d.WithdrawalCredentials...)
+
+	// Field (2) 'Amount'
+	dst.Amount = d.Amount
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the DepositMessage object
+func (d *DepositMessage) SSZSchemaString() string {
+	return "Container(Pubkey:Vector[byte,48],WithdrawalCredentials:Vector[byte,32],Amount:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the DepositMessage object
+func (d *DepositMessage) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "DepositMessage",
+		Fields: []*ssz.SchemaField{
+			{Name: "Pubkey", Type: "Vector[byte,48]", Size: 48, Fixed: true},
+			{Name: "WithdrawalCredentials", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Amount", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the IndexedAttestation object to a new buffer owned by the caller
+func (x *IndexedAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(x)
+}
+
+// MarshalSSZPooled ssz marshals the IndexedAttestation object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (x *IndexedAttestation) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(x)
+}
+
+// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
+func (x *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(228)
+
+	// Offset (0) 'AttestationIndices'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(x.AttestationIndices) * 8
+
+	// Field (1) 'Data'
+	if x.Data != nil {
+		if dst, err = x.Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Signature'
+	if len(x.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, x.Signature...)
+
+	// Field (0) 'AttestationIndices'
+	if len(x.AttestationIndices) > 2048 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(x.AttestationIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, x.AttestationIndices[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the IndexedAttestation object
+func (x *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	return x.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the IndexedAttestation object with the memory of the allocator
+func (x *IndexedAttestation) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 228 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Data'
-	if i.Data == nil {
-		i.Data = new(AttestationData)
+	if x.Data == nil {
+		x.Data = ssz.AllocNew[AttestationData](alloc)
 	}
-	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = x.Data.UnmarshalSSZArena(buf[4:132], alloc); err != nil {
 		return err
 	}
 
 	// Field (2) 'Signature'
-	if cap(i.Signature) == 0 {
-		i.Signature = make([]byte, 0, len(buf[132:228]))
+	if cap(x.Signature) == 0 {
+		x.Signature = ssz.AllocBytes(alloc, len(buf[132:228]))[:0]
 	}
-	i.Signature = append(i.Signature, buf[132:228]...)
+	x.Signature = append(x.Signature[:0], buf[132:228]...)
 
 	// Field (0) 'AttestationIndices'
 	{
@@ -801,78 +1739,205 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		x.AttestationIndices = ssz.AllocExtend(alloc, x.AttestationIndices, num)
 		for ii := 0; ii < num; ii++ {
-			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			x.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the IndexedAttestation object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (x *IndexedAttestation) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := x.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestation object
-func (i *IndexedAttestation) SizeSSZ() (size int) {
+func (x *IndexedAttestation) SizeSSZ() (size int) {
 	size = 228
 
 	// Field (0) 'AttestationIndices'
-	size += len(i.AttestationIndices) * 8
+	size += len(x.AttestationIndices) * 8
 
 	return
 }
 
 // HashTreeRoot ssz hashes the IndexedAttestation object
-func (i *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(i)
+func (x *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(x)
 }
 
 // HashTreeRootWith ssz hashes the IndexedAttestation object with a hasher
-func (i *IndexedAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+func (x *IndexedAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'AttestationIndices'
 	{
-		if len(i.AttestationIndices) > 2048 {
+		if len(x.AttestationIndices) > 2048 {
 			err = ssz.ErrListTooBig
 			return
 		}
 		subIndx := hh.Index()
-		for _, i := range i.AttestationIndices {
-			hh.AppendUint64(i)
-		}
+		hh.AppendUint64Array(x.AttestationIndices)
 		hh.FillUpTo32()
-		numItems := uint64(len(i.AttestationIndices))
+		numItems := uint64(len(x.AttestationIndices))
 		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
 	}
 
 	// Field (1) 'Data'
-	if i.Data != nil {
-		if err = i.Data.HashTreeRootWith(hh); err != nil {
+	if x.Data != nil {
+		if err = x.Data.HashTreeRootWith(hh); err != nil {
 			return
 		}
 	}
 
 	// Field (2) 'Signature'
-	if len(i.Signature) != 96 {
+	if len(x.Signature) != 96 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	hh.PutBytes(i.Signature)
+	hh.PutBytes(x.Signature)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the PendingAttestation object
+// MerkleProof returns the merkle branch that proves the field against the root of the IndexedAttestation object
+func (x *IndexedAttestation) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "AttestationIndices":
+		leaf = 0
+	case "Data":
+		leaf = 1
+	case "Signature":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'AttestationIndices'
+	{
+		if len(x.AttestationIndices) > 2048 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(x.AttestationIndices)
+		hh.FillUpTo32()
+		numItems := uint64(len(x.AttestationIndices))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+	}
+
+	// Field (1) 'Data'
+	if x.Data != nil {
+		if err = x.Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Signature'
+	if len(x.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(x.Signature)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the IndexedAttestation object are zero
+func (x *IndexedAttestation) IsZeroSSZ() bool {
+	// Field (0) 'AttestationIndices'
+	if len(x.AttestationIndices) != 0 {
+		return false
+	}
+
+	// Field (1) 'Data'
+	if x.Data != nil && !x.Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'Signature'
+	for _, elem := range x.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the IndexedAttestation object into dst reusing the memory of dst
+func (x *IndexedAttestation) CopyInto(dst *IndexedAttestation) {
+	// Field (0) 'AttestationIndices'
+	dst.AttestationIndices = append(dst.AttestationIndices[:0], x.AttestationIndices...)
+
+	// Field (1) 'Data'
+	if x.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = new(AttestationData)
+		}
+		x.Data.CopyInto(dst.Data)
+	}
+
+	// Field (2) 'Signature'
+	dst.Signature = append(dst.Signature[:0], x.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the IndexedAttestation object
+func (x *IndexedAttestation) SSZSchemaString() string {
+	return "Container(AttestationIndices:List[uint64,2048],Data:AttestationData,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the IndexedAttestation object
+func (x *IndexedAttestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "IndexedAttestation",
+		Fields: []*ssz.SchemaField{
+			{Name: "AttestationIndices", Type: "List[uint64,2048]"},
+			{Name: "Data", Type: "AttestationData", Size: 128, Fixed: true},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the PendingAttestation object to a new buffer owned by the caller
 func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
 }
 
+// MarshalSSZPooled ssz marshals the PendingAttestation object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (p *PendingAttestation) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(p)
+}
+
 // MarshalSSZTo ssz marshals the PendingAttestation object to a target array
 func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(148)
 
 	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(p.AggregationBits)
 
 	// Field (1) 'Data'
@@ -882,11 +1947,16 @@ func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
-	// Field (2) 'InclusionDelay'
-	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+	{
+		dst = append(dst, make([]byte, 16)...)
+		fixed := dst[len(dst)-16:]
 
-	// Field (3) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+		// Field (2) 'InclusionDelay'
+		ssz.PutUint64(fixed[0:8], p.InclusionDelay)
+
+		// Field (3) 'ProposerIndex'
+		ssz.PutUint64(fixed[8:16], p.ProposerIndex)
+	}
 
 	// Field (0) 'AggregationBits'
 	if len(p.AggregationBits) > 2048 {
@@ -900,6 +1970,11 @@ func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the PendingAttestation object
 func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the PendingAttestation object with the memory of the allocator
+func (p *PendingAttestation) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 148 {
@@ -914,15 +1989,15 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 148 {
+	if o0 != 148 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Data'
 	if p.Data == nil {
-		p.Data = new(AttestationData)
+		p.Data = ssz.AllocNew[AttestationData](alloc)
 	}
-	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = p.Data.UnmarshalSSZArena(buf[4:132], alloc); err != nil {
 		return err
 	}
 
@@ -939,13 +2014,23 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 		if cap(p.AggregationBits) == 0 {
-			p.AggregationBits = make([]byte, 0, len(buf))
+			p.AggregationBits = ssz.AllocBytes(alloc, len(buf))[:0]
 		}
-		p.AggregationBits = append(p.AggregationBits, buf...)
+		p.AggregationBits = append(p.AggregationBits[:0], buf...)
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the PendingAttestation object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (p *PendingAttestation) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := p.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
 func (p *PendingAttestation) SizeSSZ() (size int) {
 	size = 148
@@ -964,6 +2049,7 @@ func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the PendingAttestation object with a hasher
 func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(4)
 
 	// Field (0) 'AggregationBits'
 	if len(p.AggregationBits) == 0 {
@@ -989,11 +2075,129 @@ func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Fork object
+// MerkleProof returns the merkle branch that proves the field against the root of the PendingAttestation object
+func (p *PendingAttestation) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "AggregationBits":
+		leaf = 0
+	case "Data":
+		leaf = 1
+	case "InclusionDelay":
+		leaf = 2
+	case "ProposerIndex":
+		leaf = 3
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	if len(p.AggregationBits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(p.AggregationBits, 2048)
+
+	// Field (1) 'Data'
+	if p.Data != nil {
+		if err = p.Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'InclusionDelay'
+	hh.PutUint64(p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	hh.PutUint64(p.ProposerIndex)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the PendingAttestation object are zero
+func (p *PendingAttestation) IsZeroSSZ() bool {
+	// Field (0) 'AggregationBits'
+	if len(p.AggregationBits) != 0 {
+		return false
+	}
+
+	// Field (1) 'Data'
+	if p.Data != nil && !p.Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'InclusionDelay'
+	if p.InclusionDelay != 0 {
+		return false
+	}
+
+	// Field (3) 'ProposerIndex'
+	if p.ProposerIndex != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the PendingAttestation object into dst reusing the memory of dst
+func (p *PendingAttestation) CopyInto(dst *PendingAttestation) {
+	// Field (0) 'AggregationBits'
+	dst.AggregationBits = append(dst.AggregationBits[:0], p.AggregationBits...)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = new(AttestationData)
+		}
+		p.Data.CopyInto(dst.Data)
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst.InclusionDelay = p.InclusionDelay
+
+	// Field (3) 'ProposerIndex'
+	dst.ProposerIndex = p.ProposerIndex
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the PendingAttestation object
+func (p *PendingAttestation) SSZSchemaString() string {
+	return "Container(AggregationBits:Bitlist[2048],Data:AttestationData,InclusionDelay:uint64,ProposerIndex:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the PendingAttestation object
+func (p *PendingAttestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "PendingAttestation",
+		Fields: []*ssz.SchemaField{
+			{Name: "AggregationBits", Type: "Bitlist[2048]"},
+			{Name: "Data", Type: "AttestationData", Size: 128, Fixed: true},
+			{Name: "InclusionDelay", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ProposerIndex", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Fork object to a new buffer owned by the caller
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(f)
 }
 
+// MarshalSSZPooled ssz marshals the Fork object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (f *Fork) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(f)
+}
+
 // MarshalSSZTo ssz marshals the Fork object to a target array
 func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1020,6 +2224,11 @@ func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Fork object
 func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Fork object with the memory of the allocator
+func (f *Fork) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
@@ -1028,15 +2237,15 @@ func (f *Fork) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'PreviousVersion'
 	if cap(f.PreviousVersion) == 0 {
-		f.PreviousVersion = make([]byte, 0, len(buf[0:4]))
+		f.PreviousVersion = ssz.AllocBytes(alloc, len(buf[0:4]))[:0]
 	}
-	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
+	f.PreviousVersion = append(f.PreviousVersion[:0], buf[0:4]...)
 
 	// Field (1) 'CurrentVersion'
 	if cap(f.CurrentVersion) == 0 {
-		f.CurrentVersion = make([]byte, 0, len(buf[4:8]))
+		f.CurrentVersion = ssz.AllocBytes(alloc, len(buf[4:8]))[:0]
 	}
-	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
+	f.CurrentVersion = append(f.CurrentVersion[:0], buf[4:8]...)
 
 	// Field (2) 'Epoch'
 	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
@@ -1044,12 +2253,29 @@ func (f *Fork) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Fork object at the start of the buffer and
+// returns the number of bytes consumed
+func (f *Fork) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 16 {
+		return 0, ssz.ErrSize
+	}
+	if err := f.UnmarshalSSZ(buf[:16]); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Fork object
 func (f *Fork) SizeSSZ() (size int) {
 	size = 16
 	return
 }
 
+// SizeSSZFork returns the ssz encoded size in bytes of any Fork object
+func SizeSSZFork() int {
+	return 16
+}
+
 // HashTreeRoot ssz hashes the Fork object
 func (f *Fork) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(f)
@@ -1058,6 +2284,7 @@ func (f *Fork) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Fork object with a hasher
 func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'PreviousVersion'
 	if len(f.PreviousVersion) != 4 {
@@ -1080,53 +2307,164 @@ func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Validator object
-func (v *Validator) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(v)
-}
+// MerkleProof returns the merkle branch that proves the field against the root of the Fork object
+func (f *Fork) MerkleProof(field string) (proof [][32]byte, err error) {
 
-// MarshalSSZTo ssz marshals the Validator object to a target array
-func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	var leaf int
+	switch field {
+	case "PreviousVersion":
+		leaf = 0
+	case "CurrentVersion":
+		leaf = 1
+	case "Epoch":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
 
-	// Field (0) 'Pubkey'
-	if len(v.Pubkey) != 48 {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'PreviousVersion'
+	if len(f.PreviousVersion) != 4 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, v.Pubkey...)
+	hh.PutBytes(f.PreviousVersion)
 
-	// Field (1) 'WithdrawalCredentials'
-	if len(v.WithdrawalCredentials) != 32 {
+	// Field (1) 'CurrentVersion'
+	if len(f.CurrentVersion) != 4 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, v.WithdrawalCredentials...)
+	hh.PutBytes(f.CurrentVersion)
 
-	// Field (2) 'EffectiveBalance'
-	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+	// Field (2) 'Epoch'
+	hh.PutUint64(f.Epoch)
 
-	// Field (3) 'Slashed'
-	dst = ssz.MarshalBool(dst, v.Slashed)
+	return hh.Branch(indx, leaf)
 
-	// Field (4) 'ActivationEligibilityEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+}
 
-	// Field (5) 'ActivationEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+// IsZeroSSZ returns true if all the fields of the Fork object are zero
+func (f *Fork) IsZeroSSZ() bool {
+	// Field (0) 'PreviousVersion'
+	for _, elem := range f.PreviousVersion {
+		if elem != 0 {
+			return false
+		}
+	}
 
-	// Field (6) 'ExitEpoch'
-	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+	// Field (1) 'CurrentVersion'
+	for _, elem := range f.CurrentVersion {
+		if elem != 0 {
+			return false
+		}
+	}
 
-	// Field (7) 'WithdrawableEpoch'
-	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+	// Field (2) 'Epoch'
+	if f.Epoch != 0 {
+		return false
+	}
 
-	return
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the Validator object
-func (v *Validator) UnmarshalSSZ(buf []byte) error {
-	var err error
+// CopyInto copies the Fork object into dst reusing the memory of dst
+func (f *Fork) CopyInto(dst *Fork) {
+	// Field (0) 'PreviousVersion'
+	dst.PreviousVersion = append(dst.PreviousVersion[:0], f.PreviousVersion...)
+
+	// Field (1) 'CurrentVersion'
+	dst.CurrentVersion = append(dst.CurrentVersion[:0], f.CurrentVersion...)
+
+	// Field (2) 'Epoch'
+	dst.Epoch = f.Epoch
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Fork object
+func (f *Fork) SSZSchemaString() string {
+	return "Container(PreviousVersion:Vector[byte,4],CurrentVersion:Vector[byte,4],Epoch:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the Fork object
+func (f *Fork) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Fork",
+		Fields: []*ssz.SchemaField{
+			{Name: "PreviousVersion", Type: "Vector[byte,4]", Size: 4, Fixed: true},
+			{Name: "CurrentVersion", Type: "Vector[byte,4]", Size: 4, Fixed: true},
+			{Name: "Epoch", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Validator object to a new buffer owned by the caller
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZPooled ssz marshals the Validator object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (v *Validator) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(v)
+}
+
+// MarshalSSZTo ssz marshals the Validator object to a target array
+func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if len(v.Pubkey) != 48 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, v.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(v.WithdrawalCredentials) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, v.WithdrawalCredentials...)
+
+	{
+		dst = append(dst, make([]byte, 41)...)
+		fixed := dst[len(dst)-41:]
+
+		// Field (2) 'EffectiveBalance'
+		ssz.PutUint64(fixed[0:8], v.EffectiveBalance)
+
+		// Field (3) 'Slashed'
+		ssz.PutBool(fixed[8:9], v.Slashed)
+
+		// Field (4) 'ActivationEligibilityEpoch'
+		ssz.PutUint64(fixed[9:17], v.ActivationEligibilityEpoch)
+
+		// Field (5) 'ActivationEpoch'
+		ssz.PutUint64(fixed[17:25], v.ActivationEpoch)
+
+		// Field (6) 'ExitEpoch'
+		ssz.PutUint64(fixed[25:33], v.ExitEpoch)
+
+		// Field (7) 'WithdrawableEpoch'
+		ssz.PutUint64(fixed[33:41], v.WithdrawableEpoch)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Validator object
+func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Validator object with the memory of the allocator
+func (v *Validator) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
 	size := uint64(len(buf))
 	if size != 121 {
 		return ssz.ErrSize
@@ -1134,15 +2472,15 @@ func (v *Validator) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Pubkey'
 	if cap(v.Pubkey) == 0 {
-		v.Pubkey = make([]byte, 0, len(buf[0:48]))
+		v.Pubkey = ssz.AllocBytes(alloc, len(buf[0:48]))[:0]
 	}
-	v.Pubkey = append(v.Pubkey, buf[0:48]...)
+	v.Pubkey = append(v.Pubkey[:0], buf[0:48]...)
 
 	// Field (1) 'WithdrawalCredentials'
 	if cap(v.WithdrawalCredentials) == 0 {
-		v.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+		v.WithdrawalCredentials = ssz.AllocBytes(alloc, len(buf[48:80]))[:0]
 	}
-	v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf[48:80]...)
+	v.WithdrawalCredentials = append(v.WithdrawalCredentials[:0], buf[48:80]...)
 
 	// Field (2) 'EffectiveBalance'
 	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
@@ -1165,12 +2503,29 @@ func (v *Validator) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Validator object at the start of the buffer and
+// returns the number of bytes consumed
+func (v *Validator) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 121 {
+		return 0, ssz.ErrSize
+	}
+	if err := v.UnmarshalSSZ(buf[:121]); err != nil {
+		return 0, err
+	}
+	return 121, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Validator object
 func (v *Validator) SizeSSZ() (size int) {
 	size = 121
 	return
 }
 
+// SizeSSZValidator returns the ssz encoded size in bytes of any Validator object
+func SizeSSZValidator() int {
+	return 121
+}
+
 // HashTreeRoot ssz hashes the Validator object
 func (v *Validator) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -1179,6 +2534,7 @@ func (v *Validator) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Validator object with a hasher
 func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(8)
 
 	// Field (0) 'Pubkey'
 	if len(v.Pubkey) != 48 {
@@ -1216,26 +2572,207 @@ func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the VoluntaryExit object
+// MerkleProof returns the merkle branch that proves the field against the root of the Validator object
+func (v *Validator) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Pubkey":
+		leaf = 0
+	case "WithdrawalCredentials":
+		leaf = 1
+	case "EffectiveBalance":
+		leaf = 2
+	case "Slashed":
+		leaf = 3
+	case "ActivationEligibilityEpoch":
+		leaf = 4
+	case "ActivationEpoch":
+		leaf = 5
+	case "ExitEpoch":
+		leaf = 6
+	case "WithdrawableEpoch":
+		leaf = 7
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if len(v.Pubkey) != 48 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(v.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if len(v.WithdrawalCredentials) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(v.WithdrawalCredentials)
+
+	// Field (2) 'EffectiveBalance'
+	hh.PutUint64(v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	hh.PutBool(v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	hh.PutUint64(v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	hh.PutUint64(v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	hh.PutUint64(v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	hh.PutUint64(v.WithdrawableEpoch)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Validator object are zero
+func (v *Validator) IsZeroSSZ() bool {
+	// Field (0) 'Pubkey'
+	for _, elem := range v.Pubkey {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	for _, elem := range v.WithdrawalCredentials {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (2) 'EffectiveBalance'
+	if v.EffectiveBalance != 0 {
+		return false
+	}
+
+	// Field (3) 'Slashed'
+	if v.Slashed {
+		return false
+	}
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	if v.ActivationEligibilityEpoch != 0 {
+		return false
+	}
+
+	// Field (5) 'ActivationEpoch'
+	if v.ActivationEpoch != 0 {
+		return false
+	}
+
+	// Field (6) 'ExitEpoch'
+	if v.ExitEpoch != 0 {
+		return false
+	}
+
+	// Field (7) 'WithdrawableEpoch'
+	if v.WithdrawableEpoch != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the Validator object into dst reusing the memory of dst
+func (v *Validator) CopyInto(dst *Validator) {
+	// Field (0) 'Pubkey'
+	dst.Pubkey = append(dst.Pubkey[:0], v.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst.WithdrawalCredentials = append(dst.WithdrawalCredentials[:0], v.WithdrawalCredentials...)
+
+	// Field (2) 'EffectiveBalance'
+	dst.EffectiveBalance = v.EffectiveBalance
+
+	// Field (3) 'Slashed'
+	dst.Slashed = v.Slashed
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst.ActivationEligibilityEpoch = v.ActivationEligibilityEpoch
+
+	// Field (5) 'ActivationEpoch'
+	dst.ActivationEpoch = v.ActivationEpoch
+
+	// Field (6) 'ExitEpoch'
+	dst.ExitEpoch = v.ExitEpoch
+
+	// Field (7) 'WithdrawableEpoch'
+	dst.WithdrawableEpoch = v.WithdrawableEpoch
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Validator object
+func (v *Validator) SSZSchemaString() string {
+	return "Container(Pubkey:Vector[byte,48],WithdrawalCredentials:Vector[byte,32],EffectiveBalance:uint64,Slashed:bool,ActivationEligibilityEpoch:uint64,ActivationEpoch:uint64,ExitEpoch:uint64,WithdrawableEpoch:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the Validator object
+func (v *Validator) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Validator",
+		Fields: []*ssz.SchemaField{
+			{Name: "Pubkey", Type: "Vector[byte,48]", Size: 48, Fixed: true},
+			{Name: "WithdrawalCredentials", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "EffectiveBalance", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Slashed", Type: "bool", Size: 1, Fixed: true},
+			{Name: "ActivationEligibilityEpoch", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ActivationEpoch", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ExitEpoch", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "WithdrawableEpoch", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the VoluntaryExit object to a new buffer owned by the caller
 func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
 }
 
+// MarshalSSZPooled ssz marshals the VoluntaryExit object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (v *VoluntaryExit) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(v)
+}
+
 // MarshalSSZTo ssz marshals the VoluntaryExit object to a target array
 func (v *VoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'Epoch'
-	dst = ssz.MarshalUint64(dst, v.Epoch)
+	{
+		dst = append(dst, make([]byte, 16)...)
+		fixed := dst[len(dst)-16:]
 
-	// Field (1) 'ValidatorIndex'
-	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+		// Field (0) 'Epoch'
+		ssz.PutUint64(fixed[0:8], v.Epoch)
+
+		// Field (1) 'ValidatorIndex'
+		ssz.PutUint64(fixed[8:16], v.ValidatorIndex)
+	}
 
 	return
 }
 
 // UnmarshalSSZ ssz unmarshals the VoluntaryExit object
 func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the VoluntaryExit object with the memory of the allocator
+func (v *VoluntaryExit) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
@@ -1251,12 +2788,29 @@ func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the VoluntaryExit object at the start of the buffer and
+// returns the number of bytes consumed
+func (v *VoluntaryExit) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 16 {
+		return 0, ssz.ErrSize
+	}
+	if err := v.UnmarshalSSZ(buf[:16]); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the VoluntaryExit object
 func (v *VoluntaryExit) SizeSSZ() (size int) {
 	size = 16
 	return
 }
 
+// SizeSSZVoluntaryExit returns the ssz encoded size in bytes of any VoluntaryExit object
+func SizeSSZVoluntaryExit() int {
+	return 16
+}
+
 // HashTreeRoot ssz hashes the VoluntaryExit object
 func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -1265,6 +2819,7 @@ func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher
 func (v *VoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Epoch'
 	hh.PutUint64(v.Epoch)
@@ -1276,11 +2831,86 @@ func (v *VoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedVoluntaryExit object
+// MerkleProof returns the merkle branch that proves the field against the root of the VoluntaryExit object
+func (v *VoluntaryExit) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Epoch":
+		leaf = 0
+	case "ValidatorIndex":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(v.Epoch)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(v.ValidatorIndex)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the VoluntaryExit object are zero
+func (v *VoluntaryExit) IsZeroSSZ() bool {
+	// Field (0) 'Epoch'
+	if v.Epoch != 0 {
+		return false
+	}
+
+	// Field (1) 'ValidatorIndex'
+	if v.ValidatorIndex != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the VoluntaryExit object into dst reusing the memory of dst
+func (v *VoluntaryExit) CopyInto(dst *VoluntaryExit) {
+	// Field (0) 'Epoch'
+	dst.Epoch = v.Epoch
+
+	// Field (1) 'ValidatorIndex'
+	dst.ValidatorIndex = v.ValidatorIndex
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the VoluntaryExit object
+func (v *VoluntaryExit) SSZSchemaString() string {
+	return "Container(Epoch:uint64,ValidatorIndex:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the VoluntaryExit object
+func (v *VoluntaryExit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "VoluntaryExit",
+		Fields: []*ssz.SchemaField{
+			{Name: "Epoch", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ValidatorIndex", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SignedVoluntaryExit object to a new buffer owned by the caller
 func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SignedVoluntaryExit object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SignedVoluntaryExit) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
 func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1300,6 +2930,11 @@ func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SignedVoluntaryExit object with the memory of the allocator
+func (s *SignedVoluntaryExit) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 112 {
@@ -1308,9 +2943,9 @@ func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Exit'
 	if s.Exit == nil {
-		s.Exit = new(VoluntaryExit)
+		s.Exit = ssz.AllocNew[VoluntaryExit](alloc)
 	}
-	if err = s.Exit.UnmarshalSSZ(buf[0:16]); err != nil {
+	if err = s.Exit.UnmarshalSSZArena(buf[0:16], alloc); err != nil {
 		return err
 	}
 
@@ -1320,12 +2955,29 @@ func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SignedVoluntaryExit object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SignedVoluntaryExit) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 112 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:112]); err != nil {
+		return 0, err
+	}
+	return 112, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) SizeSSZ() (size int) {
 	size = 112
 	return
 }
 
+// SizeSSZSignedVoluntaryExit returns the ssz encoded size in bytes of any SignedVoluntaryExit object
+func SizeSSZSignedVoluntaryExit() int {
+	return 112
+}
+
 // HashTreeRoot ssz hashes the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -1334,6 +2986,7 @@ func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher
 func (s *SignedVoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Exit'
 	if s.Exit != nil {
@@ -1349,11 +3002,97 @@ func (s *SignedVoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Eth1Block object
+// MerkleProof returns the merkle branch that proves the field against the root of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Exit":
+		leaf = 0
+	case "Signature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Exit'
+	if s.Exit != nil {
+		if err = s.Exit.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SignedVoluntaryExit object are zero
+func (s *SignedVoluntaryExit) IsZeroSSZ() bool {
+	// Field (0) 'Exit'
+	if s.Exit != nil && !s.Exit.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Signature'
+	if s.Signature != [96]byte{} {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the SignedVoluntaryExit object into dst reusing the memory of dst
+func (s *SignedVoluntaryExit) CopyInto(dst *SignedVoluntaryExit) {
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		dst.Exit = nil
+	} else {
+		if dst.Exit == nil {
+			dst.Exit = new(VoluntaryExit)
+		}
+		s.Exit.CopyInto(dst.Exit)
+	}
+
+	// Field (1) 'Signature'
+	dst.Signature = s.Signature
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SSZSchemaString() string {
+	return "Container(Exit:VoluntaryExit,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SignedVoluntaryExit",
+		Fields: []*ssz.SchemaField{
+			{Name: "Exit", Type: "VoluntaryExit", Size: 16, Fixed: true},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Eth1Block object to a new buffer owned by the caller
 func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
 }
 
+// MarshalSSZPooled ssz marshals the Eth1Block object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (e *Eth1Block) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(e)
+}
+
 // MarshalSSZTo ssz marshals the Eth1Block object to a target array
 func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1376,6 +3115,11 @@ func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Eth1Block object
 func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Eth1Block object with the memory of the allocator
+func (e *Eth1Block) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 48 {
@@ -1387,9 +3131,9 @@ func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
 
 	// Field (1) 'DepositRoot'
 	if cap(e.DepositRoot) == 0 {
-		e.DepositRoot = make([]byte, 0, len(buf[8:40]))
+		e.DepositRoot = ssz.AllocBytes(alloc, len(buf[8:40]))[:0]
 	}
-	e.DepositRoot = append(e.DepositRoot, buf[8:40]...)
+	e.DepositRoot = append(e.DepositRoot[:0], buf[8:40]...)
 
 	// Field (2) 'DepositCount'
 	e.DepositCount = ssz.UnmarshallUint64(buf[40:48])
@@ -1397,12 +3141,29 @@ func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
-func (e *Eth1Block) SizeSSZ() (size int) {
+// UnmarshalSSZWithOffset ssz unmarshals the Eth1Block object at the start of the buffer and
+// returns the number of bytes consumed
+func (e *Eth1Block) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 48 {
+		return 0, ssz.ErrSize
+	}
+	if err := e.UnmarshalSSZ(buf[:48]); err != nil {
+		return 0, err
+	}
+	return 48, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
+func (e *Eth1Block) SizeSSZ() (size int) {
 	size = 48
 	return
 }
 
+// SizeSSZEth1Block returns the ssz encoded size in bytes of any Eth1Block object
+func SizeSSZEth1Block() int {
+	return 48
+}
+
 // HashTreeRoot ssz hashes the Eth1Block object
 func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
@@ -1411,6 +3172,7 @@ func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Eth1Block object with a hasher
 func (e *Eth1Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'Timestamp'
 	hh.PutUint64(e.Timestamp)
@@ -1429,11 +3191,106 @@ func (e *Eth1Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Eth1Data object
+// MerkleProof returns the merkle branch that proves the field against the root of the Eth1Block object
+func (e *Eth1Block) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Timestamp":
+		leaf = 0
+	case "DepositRoot":
+		leaf = 1
+	case "DepositCount":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	// Field (1) 'DepositRoot'
+	if len(e.DepositRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(e.DepositRoot)
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(e.DepositCount)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Eth1Block object are zero
+func (e *Eth1Block) IsZeroSSZ() bool {
+	// Field (0) 'Timestamp'
+	if e.Timestamp != 0 {
+		return false
+	}
+
+	// Field (1) 'DepositRoot'
+	for _, elem := range e.DepositRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (2) 'DepositCount'
+	if e.DepositCount != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the Eth1Block object into dst reusing the memory of dst
+func (e *Eth1Block) CopyInto(dst *Eth1Block) {
+	// Field (0) 'Timestamp'
+	dst.Timestamp = e.Timestamp
+
+	// Field (1) 'DepositRoot'
+	dst.DepositRoot = append(dst.DepositRoot[:0], e.DepositRoot...)
+
+	// Field (2) 'DepositCount'
+	dst.DepositCount = e.DepositCount
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Eth1Block object
+func (e *Eth1Block) SSZSchemaString() string {
+	return "Container(Timestamp:uint64,DepositRoot:Vector[byte,32],DepositCount:uint64)"
+}
+
+// SSZSchema returns the layout of the fields of the Eth1Block object
+func (e *Eth1Block) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Eth1Block",
+		Fields: []*ssz.SchemaField{
+			{Name: "Timestamp", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "DepositRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "DepositCount", Type: "uint64", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Eth1Data object to a new buffer owned by the caller
 func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
 }
 
+// MarshalSSZPooled ssz marshals the Eth1Data object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (e *Eth1Data) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(e)
+}
+
 // MarshalSSZTo ssz marshals the Eth1Data object to a target array
 func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1460,6 +3317,11 @@ func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Eth1Data object
 func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Eth1Data object with the memory of the allocator
+func (e *Eth1Data) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 72 {
@@ -1468,28 +3330,45 @@ func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'DepositRoot'
 	if cap(e.DepositRoot) == 0 {
-		e.DepositRoot = make([]byte, 0, len(buf[0:32]))
+		e.DepositRoot = ssz.AllocBytes(alloc, len(buf[0:32]))[:0]
 	}
-	e.DepositRoot = append(e.DepositRoot, buf[0:32]...)
+	e.DepositRoot = append(e.DepositRoot[:0], buf[0:32]...)
 
 	// Field (1) 'DepositCount'
 	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
 
 	// Field (2) 'BlockHash'
 	if cap(e.BlockHash) == 0 {
-		e.BlockHash = make([]byte, 0, len(buf[40:72]))
+		e.BlockHash = ssz.AllocBytes(alloc, len(buf[40:72]))[:0]
 	}
-	e.BlockHash = append(e.BlockHash, buf[40:72]...)
+	e.BlockHash = append(e.BlockHash[:0], buf[40:72]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Eth1Data object at the start of the buffer and
+// returns the number of bytes consumed
+func (e *Eth1Data) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 72 {
+		return 0, ssz.ErrSize
+	}
+	if err := e.UnmarshalSSZ(buf[:72]); err != nil {
+		return 0, err
+	}
+	return 72, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Eth1Data object
 func (e *Eth1Data) SizeSSZ() (size int) {
 	size = 72
 	return
 }
 
+// SizeSSZEth1Data returns the ssz encoded size in bytes of any Eth1Data object
+func SizeSSZEth1Data() int {
+	return 72
+}
+
 // HashTreeRoot ssz hashes the Eth1Data object
 func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
@@ -1498,6 +3377,7 @@ func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Eth1Data object with a hasher
 func (e *Eth1Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'DepositRoot'
 	if len(e.DepositRoot) != 32 {
@@ -1520,11 +3400,112 @@ func (e *Eth1Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SigningRoot object
+// MerkleProof returns the merkle branch that proves the field against the root of the Eth1Data object
+func (e *Eth1Data) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "DepositRoot":
+		leaf = 0
+	case "DepositCount":
+		leaf = 1
+	case "BlockHash":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'DepositRoot'
+	if len(e.DepositRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(e.DepositRoot)
+
+	// Field (1) 'DepositCount'
+	hh.PutUint64(e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if len(e.BlockHash) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(e.BlockHash)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Eth1Data object are zero
+func (e *Eth1Data) IsZeroSSZ() bool {
+	// Field (0) 'DepositRoot'
+	for _, elem := range e.DepositRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'DepositCount'
+	if e.DepositCount != 0 {
+		return false
+	}
+
+	// Field (2) 'BlockHash'
+	for _, elem := range e.BlockHash {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the Eth1Data object into dst reusing the memory of dst
+func (e *Eth1Data) CopyInto(dst *Eth1Data) {
+	// Field (0) 'DepositRoot'
+	dst.DepositRoot = append(dst.DepositRoot[:0], e.DepositRoot...)
+
+	// Field (1) 'DepositCount'
+	dst.DepositCount = e.DepositCount
+
+	// Field (2) 'BlockHash'
+	dst.BlockHash = append(dst.BlockHash[:0], e.BlockHash...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Eth1Data object
+func (e *Eth1Data) SSZSchemaString() string {
+	return "Container(DepositRoot:Vector[byte,32],DepositCount:uint64,BlockHash:Vector[byte,32])"
+}
+
+// SSZSchema returns the layout of the fields of the Eth1Data object
+func (e *Eth1Data) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Eth1Data",
+		Fields: []*ssz.SchemaField{
+			{Name: "DepositRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "DepositCount", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "BlockHash", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SigningRoot object to a new buffer owned by the caller
 func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SigningRoot object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SigningRoot) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SigningRoot object to a target array
 func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1548,6 +3529,11 @@ func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the SigningRoot object
 func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SigningRoot object with the memory of the allocator
+func (s *SigningRoot) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 40 {
@@ -1556,25 +3542,42 @@ func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'ObjectRoot'
 	if cap(s.ObjectRoot) == 0 {
-		s.ObjectRoot = make([]byte, 0, len(buf[0:32]))
+		s.ObjectRoot = ssz.AllocBytes(alloc, len(buf[0:32]))[:0]
 	}
-	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
+	s.ObjectRoot = append(s.ObjectRoot[:0], buf[0:32]...)
 
 	// Field (1) 'Domain'
 	if cap(s.Domain) == 0 {
-		s.Domain = make([]byte, 0, len(buf[32:40]))
+		s.Domain = ssz.AllocBytes(alloc, len(buf[32:40]))[:0]
 	}
-	s.Domain = append(s.Domain, buf[32:40]...)
+	s.Domain = append(s.Domain[:0], buf[32:40]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SigningRoot object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SigningRoot) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 40 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:40]); err != nil {
+		return 0, err
+	}
+	return 40, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SigningRoot object
 func (s *SigningRoot) SizeSSZ() (size int) {
 	size = 40
 	return
 }
 
+// SizeSSZSigningRoot returns the ssz encoded size in bytes of any SigningRoot object
+func SizeSSZSigningRoot() int {
+	return 40
+}
+
 // HashTreeRoot ssz hashes the SigningRoot object
 func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -1583,6 +3586,7 @@ func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SigningRoot object with a hasher
 func (s *SigningRoot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'ObjectRoot'
 	if len(s.ObjectRoot) != 32 {
@@ -1602,11 +3606,98 @@ func (s *SigningRoot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the HistoricalBatch object
+// MerkleProof returns the merkle branch that proves the field against the root of the SigningRoot object
+func (s *SigningRoot) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "ObjectRoot":
+		leaf = 0
+	case "Domain":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	if len(s.ObjectRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.ObjectRoot)
+
+	// Field (1) 'Domain'
+	if len(s.Domain) != 8 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.Domain)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SigningRoot object are zero
+func (s *SigningRoot) IsZeroSSZ() bool {
+	// Field (0) 'ObjectRoot'
+	for _, elem := range s.ObjectRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'Domain'
+	for _, elem := range s.Domain {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SigningRoot object into dst reusing the memory of dst
+func (s *SigningRoot) CopyInto(dst *SigningRoot) {
+	// Field (0) 'ObjectRoot'
+	dst.ObjectRoot = append(dst.ObjectRoot[:0], s.ObjectRoot...)
+
+	// Field (1) 'Domain'
+	dst.Domain = append(dst.Domain[:0], s.Domain...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SigningRoot object
+func (s *SigningRoot) SSZSchemaString() string {
+	return "Container(ObjectRoot:Vector[byte,32],Domain:Vector[byte,8])"
+}
+
+// SSZSchema returns the layout of the fields of the SigningRoot object
+func (s *SigningRoot) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SigningRoot",
+		Fields: []*ssz.SchemaField{
+			{Name: "ObjectRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Domain", Type: "Vector[byte,8]", Size: 8, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the HistoricalBatch object to a new buffer owned by the caller
 func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(h)
 }
 
+// MarshalSSZPooled ssz marshals the HistoricalBatch object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (h *HistoricalBatch) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(h)
+}
+
 // MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
 func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1634,6 +3725,11 @@ func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the HistoricalBatch object
 func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the HistoricalBatch object with the memory of the allocator
+func (h *HistoricalBatch) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 4096 {
@@ -1647,23 +3743,40 @@ func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (1) 'StateRoots'
-	h.StateRoots = make([][]byte, 64)
+	h.StateRoots = ssz.AllocSlice[[]byte](alloc, 64)
 	for ii := 0; ii < 64; ii++ {
 		if cap(h.StateRoots[ii]) == 0 {
-			h.StateRoots[ii] = make([]byte, 0, len(buf[2048:4096][ii*32:(ii+1)*32]))
+			h.StateRoots[ii] = ssz.AllocBytes(alloc, len(buf[2048:4096][ii*32:(ii+1)*32]))[:0]
 		}
-		h.StateRoots[ii] = append(h.StateRoots[ii], buf[2048:4096][ii*32:(ii+1)*32]...)
+		h.StateRoots[ii] = append(h.StateRoots[ii][:0], buf[2048:4096][ii*32:(ii+1)*32]...)
 	}
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the HistoricalBatch object at the start of the buffer and
+// returns the number of bytes consumed
+func (h *HistoricalBatch) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 4096 {
+		return 0, ssz.ErrSize
+	}
+	if err := h.UnmarshalSSZ(buf[:4096]); err != nil {
+		return 0, err
+	}
+	return 4096, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
 func (h *HistoricalBatch) SizeSSZ() (size int) {
 	size = 4096
 	return
 }
 
+// SizeSSZHistoricalBatch returns the ssz encoded size in bytes of any HistoricalBatch object
+func SizeSSZHistoricalBatch() int {
+	return 4096
+}
+
 // HashTreeRoot ssz hashes the HistoricalBatch object
 func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(h)
@@ -1672,6 +3785,7 @@ func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
 func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'BlockRoots'
 	{
@@ -1703,11 +3817,119 @@ func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the ProposerSlashing object
+// MerkleProof returns the merkle branch that proves the field against the root of the HistoricalBatch object
+func (h *HistoricalBatch) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "BlockRoots":
+		leaf = 0
+	case "StateRoots":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoots'
+	{
+		subIndx := hh.Index()
+		for _, i := range h.BlockRoots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'StateRoots'
+	{
+		if len(h.StateRoots) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range h.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the HistoricalBatch object are zero
+func (h *HistoricalBatch) IsZeroSSZ() bool {
+	// Field (0) 'BlockRoots'
+	for ii := range h.BlockRoots {
+		if h.BlockRoots[ii] != [32]byte{} {
+			return false
+		}
+	}
+
+	// Field (1) 'StateRoots'
+	for ii := range h.StateRoots {
+		for _, elem := range h.StateRoots[ii] {
+			if elem != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the HistoricalBatch object into dst reusing the memory of dst
+func (h *HistoricalBatch) CopyInto(dst *HistoricalBatch) {
+	// Field (0) 'BlockRoots'
+	dst.BlockRoots = h.BlockRoots
+
+	// Field (1) 'StateRoots'
+	if cap(dst.StateRoots) < len(h.StateRoots) {
+		dst.StateRoots = make([][]byte, len(h.StateRoots))
+	} else {
+		dst.StateRoots = dst.StateRoots[:len(h.StateRoots)]
+	}
+	for ii := range h.StateRoots {
+		dst.StateRoots[ii] = append(dst.StateRoots[ii][:0], h.StateRoots[ii]...)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the HistoricalBatch object
+func (h *HistoricalBatch) SSZSchemaString() string {
+	return "Container(BlockRoots:Vector[Vector[byte,32],64],StateRoots:Vector[Vector[byte,32],64])"
+}
+
+// SSZSchema returns the layout of the fields of the HistoricalBatch object
+func (h *HistoricalBatch) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "HistoricalBatch",
+		Fields: []*ssz.SchemaField{
+			{Name: "BlockRoots", Type: "Vector[Vector[byte,32],64]", Size: 2048, Fixed: true},
+			{Name: "StateRoots", Type: "Vector[Vector[byte,32],64]", Size: 2048, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the ProposerSlashing object to a new buffer owned by the caller
 func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
 }
 
+// MarshalSSZPooled ssz marshals the ProposerSlashing object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (p *ProposerSlashing) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(p)
+}
+
 // MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
 func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1731,6 +3953,11 @@ func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the ProposerSlashing object
 func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the ProposerSlashing object with the memory of the allocator
+func (p *ProposerSlashing) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 416 {
@@ -1739,29 +3966,46 @@ func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Header1'
 	if p.Header1 == nil {
-		p.Header1 = new(SignedBeaconBlockHeader)
+		p.Header1 = ssz.AllocNew[SignedBeaconBlockHeader](alloc)
 	}
-	if err = p.Header1.UnmarshalSSZ(buf[0:208]); err != nil {
+	if err = p.Header1.UnmarshalSSZArena(buf[0:208], alloc); err != nil {
 		return err
 	}
 
 	// Field (1) 'Header2'
 	if p.Header2 == nil {
-		p.Header2 = new(SignedBeaconBlockHeader)
+		p.Header2 = ssz.AllocNew[SignedBeaconBlockHeader](alloc)
 	}
-	if err = p.Header2.UnmarshalSSZ(buf[208:416]); err != nil {
+	if err = p.Header2.UnmarshalSSZArena(buf[208:416], alloc); err != nil {
 		return err
 	}
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the ProposerSlashing object at the start of the buffer and
+// returns the number of bytes consumed
+func (p *ProposerSlashing) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 416 {
+		return 0, ssz.ErrSize
+	}
+	if err := p.UnmarshalSSZ(buf[:416]); err != nil {
+		return 0, err
+	}
+	return 416, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the ProposerSlashing object
 func (p *ProposerSlashing) SizeSSZ() (size int) {
 	size = 416
 	return
 }
 
+// SizeSSZProposerSlashing returns the ssz encoded size in bytes of any ProposerSlashing object
+func SizeSSZProposerSlashing() int {
+	return 416
+}
+
 // HashTreeRoot ssz hashes the ProposerSlashing object
 func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
@@ -1770,6 +4014,7 @@ func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher
 func (p *ProposerSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Header1'
 	if p.Header1 != nil {
@@ -1789,25 +4034,126 @@ func (p *ProposerSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the AttesterSlashing object
-func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(a)
-}
+// MerkleProof returns the merkle branch that proves the field against the root of the ProposerSlashing object
+func (p *ProposerSlashing) MerkleProof(field string) (proof [][32]byte, err error) {
 
-// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
-func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	var leaf int
+	switch field {
+	case "Header1":
+		leaf = 0
+	case "Header2":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Header1'
+	if p.Header1 != nil {
+		if err = p.Header1.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 != nil {
+		if err = p.Header2.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the ProposerSlashing object are zero
+func (p *ProposerSlashing) IsZeroSSZ() bool {
+	// Field (0) 'Header1'
+	if p.Header1 != nil && !p.Header1.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 != nil && !p.Header2.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the ProposerSlashing object into dst reusing the memory of dst
+func (p *ProposerSlashing) CopyInto(dst *ProposerSlashing) {
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		dst.Header1 = nil
+	} else {
+		if dst.Header1 == nil {
+			dst.Header1 = new(SignedBeaconBlockHeader)
+		}
+		p.Header1.CopyInto(dst.Header1)
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		dst.Header2 = nil
+	} else {
+		if dst.Header2 == nil {
+			dst.Header2 = new(SignedBeaconBlockHeader)
+		}
+		p.Header2.CopyInto(dst.Header2)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the ProposerSlashing object
+func (p *ProposerSlashing) SSZSchemaString() string {
+	return "Container(Header1:SignedBeaconBlockHeader,Header2:SignedBeaconBlockHeader)"
+}
+
+// SSZSchema returns the layout of the fields of the ProposerSlashing object
+func (p *ProposerSlashing) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "ProposerSlashing",
+		Fields: []*ssz.SchemaField{
+			{Name: "Header1", Type: "SignedBeaconBlockHeader", Size: 208, Fixed: true},
+			{Name: "Header2", Type: "SignedBeaconBlockHeader", Size: 208, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the AttesterSlashing object to a new buffer owned by the caller
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZPooled ssz marshals the AttesterSlashing object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (a *AttesterSlashing) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
+func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 	offset := int(8)
 
 	// Offset (0) 'Attestation1'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if a.Attestation1 == nil {
 		a.Attestation1 = new(IndexedAttestation)
 	}
 	offset += a.Attestation1.SizeSSZ()
 
 	// Offset (1) 'Attestation2'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if a.Attestation2 == nil {
 		a.Attestation2 = new(IndexedAttestation)
 	}
@@ -1828,6 +4174,11 @@ func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the AttesterSlashing object
 func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the AttesterSlashing object with the memory of the allocator
+func (a *AttesterSlashing) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 8 {
@@ -1842,7 +4193,7 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 8 {
+	if o0 != 8 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -1855,9 +4206,9 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o0:o1]
 		if a.Attestation1 == nil {
-			a.Attestation1 = new(IndexedAttestation)
+			a.Attestation1 = ssz.AllocNew[IndexedAttestation](alloc)
 		}
-		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
+		if err = a.Attestation1.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
@@ -1866,15 +4217,25 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o1:]
 		if a.Attestation2 == nil {
-			a.Attestation2 = new(IndexedAttestation)
+			a.Attestation2 = ssz.AllocNew[IndexedAttestation](alloc)
 		}
-		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
+		if err = a.Attestation2.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the AttesterSlashing object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (a *AttesterSlashing) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := a.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
 func (a *AttesterSlashing) SizeSSZ() (size int) {
 	size = 8
@@ -1902,6 +4263,7 @@ func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
 func (a *AttesterSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Attestation1'
 	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
@@ -1917,11 +4279,104 @@ func (a *AttesterSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconState object
+// MerkleProof returns the merkle branch that proves the field against the root of the AttesterSlashing object
+func (a *AttesterSlashing) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Attestation1":
+		leaf = 0
+	case "Attestation2":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the AttesterSlashing object are zero
+func (a *AttesterSlashing) IsZeroSSZ() bool {
+	// Field (0) 'Attestation1'
+	if a.Attestation1 != nil && !a.Attestation1.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 != nil && !a.Attestation2.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the AttesterSlashing object into dst reusing the memory of dst
+func (a *AttesterSlashing) CopyInto(dst *AttesterSlashing) {
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		dst.Attestation1 = nil
+	} else {
+		if dst.Attestation1 == nil {
+			dst.Attestation1 = new(IndexedAttestation)
+		}
+		a.Attestation1.CopyInto(dst.Attestation1)
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		dst.Attestation2 = nil
+	} else {
+		if dst.Attestation2 == nil {
+			dst.Attestation2 = new(IndexedAttestation)
+		}
+		a.Attestation2.CopyInto(dst.Attestation2)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the AttesterSlashing object
+func (a *AttesterSlashing) SSZSchemaString() string {
+	return "Container(Attestation1:IndexedAttestation,Attestation2:IndexedAttestation)"
+}
+
+// SSZSchema returns the layout of the fields of the AttesterSlashing object
+func (a *AttesterSlashing) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "AttesterSlashing",
+		Fields: []*ssz.SchemaField{
+			{Name: "Attestation1", Type: "IndexedAttestation"},
+			{Name: "Attestation2", Type: "IndexedAttestation"},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconState object to a new buffer owned by the caller
 func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconState object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconState) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconState object to a target array
 func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -1969,7 +4424,9 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.HistoricalRoots) * 32
 
 	// Field (8) 'Eth1Data'
@@ -1980,18 +4437,24 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.Eth1DataVotes) * 72
 
 	// Field (10) 'Eth1DepositIndex'
 	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
 
 	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.Validators) * 121
 
 	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.Balances) * 8
 
 	// Field (13) 'RandaoMixes'
@@ -2017,12 +4480,16 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation) * 1
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(b.PreviousEpochParticipation)
 
 	// Offset (16) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation) * 1
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(b.CurrentEpochParticipation)
 
 	// Field (17) 'JustificationBits'
 	if len(b.JustificationBits) != 1 {
@@ -2053,7 +4520,9 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Offset (21) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.InactivityScores) * 8
 
 	// Field (22) 'CurrentSyncCommitee'
@@ -2112,21 +4581,17 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (15) 'PreviousEpochParticipation'
 	if len(b.PreviousEpochParticipation) > 1099511627776 {
-		err = ssz.ErrListTooBig
+		err = ssz.ErrBytesLength
 		return
 	}
-	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
-		dst = ssz.MarshalUint8(dst, b.PreviousEpochParticipation[ii])
-	}
+	dst = append(dst, b.PreviousEpochParticipation...)
 
 	// Field (16) 'CurrentEpochParticipation'
 	if len(b.CurrentEpochParticipation) > 1099511627776 {
-		err = ssz.ErrListTooBig
+		err = ssz.ErrBytesLength
 		return
 	}
-	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
-		dst = ssz.MarshalUint8(dst, b.CurrentEpochParticipation[ii])
-	}
+	dst = append(dst, b.CurrentEpochParticipation...)
 
 	// Field (21) 'InactivityScores'
 	if len(b.InactivityScores) > 1099511627776 {
@@ -2142,6 +4607,11 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the BeaconState object
 func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconState object with the memory of the allocator
+func (b *BeaconState) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 10325 {
@@ -2156,26 +4626,26 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (1) 'GenesisValidatorsRoot'
 	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
+		b.GenesisValidatorsRoot = ssz.AllocBytes(alloc, len(buf[8:40]))[:0]
 	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot[:0], buf[8:40]...)
 
 	// Field (2) 'Slot'
 	b.Slot = ssz.UnmarshallUint64(buf[40:48])
 
 	// Field (3) 'Fork'
 	if b.Fork == nil {
-		b.Fork = new(Fork)
+		b.Fork = ssz.AllocNew[Fork](alloc)
 	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
+	if err = b.Fork.UnmarshalSSZArena(buf[48:64], alloc); err != nil {
 		return err
 	}
 
 	// Field (4) 'LatestBlockHeader'
 	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+		b.LatestBlockHeader = ssz.AllocNew[BeaconBlockHeader](alloc)
 	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
+	if err = b.LatestBlockHeader.UnmarshalSSZArena(buf[64:176], alloc); err != nil {
 		return err
 	}
 
@@ -2186,7 +4656,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (6) 'StateRoots'
-	b.StateRoots = make([][32]byte, 64)
+	b.StateRoots = ssz.AllocSlice[[32]byte](alloc, 64)
 	for ii := 0; ii < 64; ii++ {
 		copy(b.StateRoots[ii][:], buf[2224:4272][ii*32:(ii+1)*32])
 	}
@@ -2196,15 +4666,15 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 10325 {
+	if o7 != 10325 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (8) 'Eth1Data'
 	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+		b.Eth1Data = ssz.AllocNew[Eth1Data](alloc)
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[4276:4348]); err != nil {
+	if err = b.Eth1Data.UnmarshalSSZArena(buf[4276:4348], alloc); err != nil {
 		return err
 	}
 
@@ -2227,16 +4697,16 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 64)
+	b.RandaoMixes = ssz.AllocSlice[[]byte](alloc, 64)
 	for ii := 0; ii < 64; ii++ {
 		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[4368:6416][ii*32:(ii+1)*32]))
+			b.RandaoMixes[ii] = ssz.AllocBytes(alloc, len(buf[4368:6416][ii*32:(ii+1)*32]))[:0]
 		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[4368:6416][ii*32:(ii+1)*32]...)
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii][:0], buf[4368:6416][ii*32:(ii+1)*32]...)
 	}
 
 	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
+	b.Slashings = ssz.AllocExtend(alloc, b.Slashings, 64)
 	for ii := 0; ii < 64; ii++ {
 		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6416:6928][ii*8 : (ii+1)*8])
 	}
@@ -2253,31 +4723,31 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (17) 'JustificationBits'
 	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[6936:6937]))
+		b.JustificationBits = ssz.AllocBytes(alloc, len(buf[6936:6937]))[:0]
 	}
-	b.JustificationBits = append(b.JustificationBits, buf[6936:6937]...)
+	b.JustificationBits = append(b.JustificationBits[:0], buf[6936:6937]...)
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
 	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		b.PreviousJustifiedCheckpoint = ssz.AllocNew[Checkpoint](alloc)
 	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[6937:6977]); err != nil {
+	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZArena(buf[6937:6977], alloc); err != nil {
 		return err
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
 	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		b.CurrentJustifiedCheckpoint = ssz.AllocNew[Checkpoint](alloc)
 	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[6977:7017]); err != nil {
+	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZArena(buf[6977:7017], alloc); err != nil {
 		return err
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
 	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
+		b.FinalizedCheckpoint = ssz.AllocNew[Checkpoint](alloc)
 	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[7017:7057]); err != nil {
+	if err = b.FinalizedCheckpoint.UnmarshalSSZArena(buf[7017:7057], alloc); err != nil {
 		return err
 	}
 
@@ -2288,17 +4758,17 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (22) 'CurrentSyncCommitee'
 	if b.CurrentSyncCommitee == nil {
-		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+		b.CurrentSyncCommitee = ssz.AllocNew[SyncCommitteeMinimal](alloc)
 	}
-	if err = b.CurrentSyncCommitee.UnmarshalSSZ(buf[7061:8693]); err != nil {
+	if err = b.CurrentSyncCommitee.UnmarshalSSZArena(buf[7061:8693], alloc); err != nil {
 		return err
 	}
 
 	// Field (23) 'NextSyncCommittee'
 	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+		b.NextSyncCommittee = ssz.AllocNew[SyncCommitteeMinimal](alloc)
 	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[8693:10325]); err != nil {
+	if err = b.NextSyncCommittee.UnmarshalSSZArena(buf[8693:10325], alloc); err != nil {
 		return err
 	}
 
@@ -2309,7 +4779,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.HistoricalRoots = make([][32]byte, num)
+		b.HistoricalRoots = ssz.AllocSlice[[32]byte](alloc, num)
 		for ii := 0; ii < num; ii++ {
 			copy(b.HistoricalRoots[ii][:], buf[ii*32:(ii+1)*32])
 		}
@@ -2322,12 +4792,12 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
+		b.Eth1DataVotes = ssz.AllocExtend(alloc, b.Eth1DataVotes, num)
 		for ii := 0; ii < num; ii++ {
 			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
+				b.Eth1DataVotes[ii] = ssz.AllocNew[Eth1Data](alloc)
 			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+			if err = b.Eth1DataVotes[ii].UnmarshalSSZArena(buf[ii*72:(ii+1)*72], alloc); err != nil {
 				return err
 			}
 		}
@@ -2340,12 +4810,12 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Validators = make([]*Validator, num)
+		b.Validators = ssz.AllocExtend(alloc, b.Validators, num)
 		for ii := 0; ii < num; ii++ {
 			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
+				b.Validators[ii] = ssz.AllocNew[Validator](alloc)
 			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
+			if err = b.Validators[ii].UnmarshalSSZArena(buf[ii*121:(ii+1)*121], alloc); err != nil {
 				return err
 			}
 		}
@@ -2358,7 +4828,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		b.Balances = ssz.AllocExtend(alloc, b.Balances, num)
 		for ii := 0; ii < num; ii++ {
 			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
@@ -2367,27 +4837,25 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	// Field (15) 'PreviousEpochParticipation'
 	{
 		buf = tail[o15:o16]
-		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
-		if err != nil {
-			return err
+		if uint64(len(buf)) > 1099511627776 {
+			return ssz.ErrBytesLength
 		}
-		b.PreviousEpochParticipation = ssz.ExtendUint8(b.PreviousEpochParticipation, num)
-		for ii := 0; ii < num; ii++ {
-			b.PreviousEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = ssz.AllocBytes(alloc, len(buf))[:0]
 		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation[:0], buf...)
 	}
 
 	// Field (16) 'CurrentEpochParticipation'
 	{
 		buf = tail[o16:o21]
-		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
-		if err != nil {
-			return err
+		if uint64(len(buf)) > 1099511627776 {
+			return ssz.ErrBytesLength
 		}
-		b.CurrentEpochParticipation = ssz.ExtendUint8(b.CurrentEpochParticipation, num)
-		for ii := 0; ii < num; ii++ {
-			b.CurrentEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = ssz.AllocBytes(alloc, len(buf))[:0]
 		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation[:0], buf...)
 	}
 
 	// Field (21) 'InactivityScores'
@@ -2397,7 +4865,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		b.InactivityScores = ssz.AllocExtend(alloc, b.InactivityScores, num)
 		for ii := 0; ii < num; ii++ {
 			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
@@ -2405,6 +4873,16 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconState object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BeaconState) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
 func (b *BeaconState) SizeSSZ() (size int) {
 	size = 10325
@@ -2422,10 +4900,10 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	size += len(b.Balances) * 8
 
 	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation) * 1
+	size += len(b.PreviousEpochParticipation)
 
 	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation) * 1
+	size += len(b.CurrentEpochParticipation)
 
 	// Field (21) 'InactivityScores'
 	size += len(b.InactivityScores) * 8
@@ -2441,6 +4919,7 @@ func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the BeaconState object with a hasher
 func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(24)
 
 	// Field (0) 'GenesisTime'
 	hh.PutUint64(b.GenesisTime)
@@ -2497,207 +4976,843 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 			err = ssz.ErrListTooBig
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			hh.Append(i[:])
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			hh.Append(i[:])
+		}
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16777216, numItems, 32))
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data != nil {
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Eth1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 32)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if len(b.Balances) > 1099511627776 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.Balances)
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		if len(b.RandaoMixes) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if len(b.Slashings) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.Slashings)
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.PreviousEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.CurrentEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (17) 'JustificationBits'
+	if len(b.JustificationBits) != 1 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint != nil {
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint != nil {
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint != nil {
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if len(b.InactivityScores) > 1099511627776 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.InactivityScores)
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee != nil {
+		if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee != nil {
+		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconState object
+func (b *BeaconState) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "GenesisTime":
+		leaf = 0
+	case "GenesisValidatorsRoot":
+		leaf = 1
+	case "Slot":
+		leaf = 2
+	case "Fork":
+		leaf = 3
+	case "LatestBlockHeader":
+		leaf = 4
+	case "BlockRoots":
+		leaf = 5
+	case "StateRoots":
+		leaf = 6
+	case "HistoricalRoots":
+		leaf = 7
+	case "Eth1Data":
+		leaf = 8
+	case "Eth1DataVotes":
+		leaf = 9
+	case "Eth1DepositIndex":
+		leaf = 10
+	case "Validators":
+		leaf = 11
+	case "Balances":
+		leaf = 12
+	case "RandaoMixes":
+		leaf = 13
+	case "Slashings":
+		leaf = 14
+	case "PreviousEpochParticipation":
+		leaf = 15
+	case "CurrentEpochParticipation":
+		leaf = 16
+	case "JustificationBits":
+		leaf = 17
+	case "PreviousJustifiedCheckpoint":
+		leaf = 18
+	case "CurrentJustifiedCheckpoint":
+		leaf = 19
+	case "FinalizedCheckpoint":
+		leaf = 20
+	case "InactivityScores":
+		leaf = 21
+	case "CurrentSyncCommitee":
+		leaf = 22
+	case "NextSyncCommittee":
+		leaf = 23
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if len(b.GenesisValidatorsRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork != nil {
+		if err = b.Fork.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader != nil {
+		if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		if len(b.StateRoots) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if len(b.HistoricalRoots) > 16777216 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			hh.Append(i[:])
+		}
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16777216, numItems, 32))
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data != nil {
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Eth1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 32)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if len(b.Balances) > 1099511627776 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.Balances)
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		if len(b.RandaoMixes) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if len(b.Slashings) != 64 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.Slashings)
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.PreviousEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.CurrentEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (17) 'JustificationBits'
+	if len(b.JustificationBits) != 1 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint != nil {
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint != nil {
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint != nil {
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if len(b.InactivityScores) > 1099511627776 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(b.InactivityScores)
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee != nil {
+		if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee != nil {
+		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconState object are zero
+func (b *BeaconState) IsZeroSSZ() bool {
+	// Field (0) 'GenesisTime'
+	if b.GenesisTime != 0 {
+		return false
+	}
+
+	// Field (1) 'GenesisValidatorsRoot'
+	for _, elem := range b.GenesisValidatorsRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (2) 'Slot'
+	if b.Slot != 0 {
+		return false
+	}
+
+	// Field (3) 'Fork'
+	if b.Fork != nil && !b.Fork.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader != nil && !b.LatestBlockHeader.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (5) 'BlockRoots'
+	for ii := range b.BlockRoots {
+		if b.BlockRoots[ii] != [32]byte{} {
+			return false
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	for ii := range b.StateRoots {
+		if b.StateRoots[ii] != [32]byte{} {
+			return false
+		}
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if len(b.HistoricalRoots) != 0 {
+		return false
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data != nil && !b.Eth1Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if len(b.Eth1DataVotes) != 0 {
+		return false
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	if b.Eth1DepositIndex != 0 {
+		return false
+	}
+
+	// Field (11) 'Validators'
+	if len(b.Validators) != 0 {
+		return false
+	}
+
+	// Field (12) 'Balances'
+	if len(b.Balances) != 0 {
+		return false
+	}
+
+	// Field (13) 'RandaoMixes'
+	for ii := range b.RandaoMixes {
+		for _, elem := range b.RandaoMixes[ii] {
+			if elem != 0 {
+				return false
+			}
+		}
+	}
+
+	// Field (14) 'Slashings'
+	for ii := range b.Slashings {
+		if b.Slashings[ii] != 0 {
+			return false
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if len(b.PreviousEpochParticipation) != 0 {
+		return false
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if len(b.CurrentEpochParticipation) != 0 {
+		return false
+	}
+
+	// Field (17) 'JustificationBits'
+	for _, elem := range b.JustificationBits {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint != nil && !b.PreviousJustifiedCheckpoint.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint != nil && !b.CurrentJustifiedCheckpoint.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint != nil && !b.FinalizedCheckpoint.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (21) 'InactivityScores'
+	if len(b.InactivityScores) != 0 {
+		return false
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee != nil && !b.CurrentSyncCommitee.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee != nil && !b.NextSyncCommittee.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconState object into dst reusing the memory of dst
+func (b *BeaconState) CopyInto(dst *BeaconState) {
+	// Field (0) 'GenesisTime'
+	dst.GenesisTime = b.GenesisTime
+
+	// Field (1) 'GenesisValidatorsRoot'
+	dst.GenesisValidatorsRoot = append(dst.GenesisValidatorsRoot[:0], b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst.Slot = b.Slot
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		dst.Fork = nil
+	} else {
+		if dst.Fork == nil {
+			dst.Fork = new(Fork)
+		}
+		b.Fork.CopyInto(dst.Fork)
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		dst.LatestBlockHeader = nil
+	} else {
+		if dst.LatestBlockHeader == nil {
+			dst.LatestBlockHeader = new(BeaconBlockHeader)
 		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16777216, numItems, 32))
+		b.LatestBlockHeader.CopyInto(dst.LatestBlockHeader)
 	}
 
+	// Field (5) 'BlockRoots'
+	dst.BlockRoots = b.BlockRoots
+
+	// Field (6) 'StateRoots'
+	dst.StateRoots = append(dst.StateRoots[:0], b.StateRoots...)
+
+	// Field (7) 'HistoricalRoots'
+	dst.HistoricalRoots = append(dst.HistoricalRoots[:0], b.HistoricalRoots...)
+
 	// Field (8) 'Eth1Data'
-	if b.Eth1Data != nil {
-		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-			return
+	if b.Eth1Data == nil {
+		dst.Eth1Data = nil
+	} else {
+		if dst.Eth1Data == nil {
+			dst.Eth1Data = new(Eth1Data)
 		}
+		b.Eth1Data.CopyInto(dst.Eth1Data)
 	}
 
 	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+	if cap(dst.Eth1DataVotes) < len(b.Eth1DataVotes) {
+		dst.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+	} else {
+		dst.Eth1DataVotes = dst.Eth1DataVotes[:len(b.Eth1DataVotes)]
+	}
+	for ii := range b.Eth1DataVotes {
+		if b.Eth1DataVotes[ii] == nil {
+			dst.Eth1DataVotes[ii] = nil
+		} else {
+			if dst.Eth1DataVotes[ii] == nil {
+				dst.Eth1DataVotes[ii] = new(Eth1Data)
 			}
+			b.Eth1DataVotes[ii].CopyInto(dst.Eth1DataVotes[ii])
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 32)
 	}
 
 	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+	dst.Eth1DepositIndex = b.Eth1DepositIndex
 
 	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+	if cap(dst.Validators) < len(b.Validators) {
+		dst.Validators = make([]*Validator, len(b.Validators))
+	} else {
+		dst.Validators = dst.Validators[:len(b.Validators)]
+	}
+	for ii := range b.Validators {
+		if b.Validators[ii] == nil {
+			dst.Validators[ii] = nil
+		} else {
+			if dst.Validators[ii] == nil {
+				dst.Validators[ii] = new(Validator)
 			}
+			b.Validators[ii].CopyInto(dst.Validators[ii])
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
 
 	// Field (12) 'Balances'
-	{
-		if len(b.Balances) > 1099511627776 {
-			err = ssz.ErrListTooBig
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+	dst.Balances = append(dst.Balances[:0], b.Balances...)
 
 	// Field (13) 'RandaoMixes'
-	{
-		if len(b.RandaoMixes) != 64 {
-			err = ssz.ErrVectorLength
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	if cap(dst.RandaoMixes) < len(b.RandaoMixes) {
+		dst.RandaoMixes = make([][]byte, len(b.RandaoMixes))
+	} else {
+		dst.RandaoMixes = dst.RandaoMixes[:len(b.RandaoMixes)]
+	}
+	for ii := range b.RandaoMixes {
+		dst.RandaoMixes[ii] = append(dst.RandaoMixes[ii][:0], b.RandaoMixes[ii]...)
 	}
 
 	// Field (14) 'Slashings'
-	{
-		if len(b.Slashings) != 64 {
-			err = ssz.ErrVectorLength
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+	dst.Slashings = append(dst.Slashings[:0], b.Slashings...)
 
 	// Field (15) 'PreviousEpochParticipation'
-	{
-		if len(b.PreviousEpochParticipation) > 1099511627776 {
-			err = ssz.ErrListTooBig
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.PreviousEpochParticipation {
-			hh.AppendUint8(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.PreviousEpochParticipation))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
-	}
+	dst.PreviousEpochParticipation = append(dst.PreviousEpochParticipation[:0], b.PreviousEpochParticipation...)
 
 	// Field (16) 'CurrentEpochParticipation'
-	{
-		if len(b.CurrentEpochParticipation) > 1099511627776 {
-			err = ssz.ErrListTooBig
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.CurrentEpochParticipation {
-			hh.AppendUint8(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.CurrentEpochParticipation))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
-	}
+	dst.CurrentEpochParticipation = append(dst.CurrentEpochParticipation[:0], b.CurrentEpochParticipation...)
 
 	// Field (17) 'JustificationBits'
-	if len(b.JustificationBits) != 1 {
-		err = ssz.ErrBytesLength
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+	dst.JustificationBits = append(dst.JustificationBits[:0], b.JustificationBits...)
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint != nil {
-		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-			return
+	if b.PreviousJustifiedCheckpoint == nil {
+		dst.PreviousJustifiedCheckpoint = nil
+	} else {
+		if dst.PreviousJustifiedCheckpoint == nil {
+			dst.PreviousJustifiedCheckpoint = new(Checkpoint)
 		}
+		b.PreviousJustifiedCheckpoint.CopyInto(dst.PreviousJustifiedCheckpoint)
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint != nil {
-		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-			return
+	if b.CurrentJustifiedCheckpoint == nil {
+		dst.CurrentJustifiedCheckpoint = nil
+	} else {
+		if dst.CurrentJustifiedCheckpoint == nil {
+			dst.CurrentJustifiedCheckpoint = new(Checkpoint)
 		}
+		b.CurrentJustifiedCheckpoint.CopyInto(dst.CurrentJustifiedCheckpoint)
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint != nil {
-		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-			return
+	if b.FinalizedCheckpoint == nil {
+		dst.FinalizedCheckpoint = nil
+	} else {
+		if dst.FinalizedCheckpoint == nil {
+			dst.FinalizedCheckpoint = new(Checkpoint)
 		}
+		b.FinalizedCheckpoint.CopyInto(dst.FinalizedCheckpoint)
 	}
 
 	// Field (21) 'InactivityScores'
-	{
-		if len(b.InactivityScores) > 1099511627776 {
-			err = ssz.ErrListTooBig
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+	dst.InactivityScores = append(dst.InactivityScores[:0], b.InactivityScores...)
 
 	// Field (22) 'CurrentSyncCommitee'
-	if b.CurrentSyncCommitee != nil {
-		if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
-			return
+	if b.CurrentSyncCommitee == nil {
+		dst.CurrentSyncCommitee = nil
+	} else {
+		if dst.CurrentSyncCommitee == nil {
+			dst.CurrentSyncCommitee = new(SyncCommitteeMinimal)
 		}
+		b.CurrentSyncCommitee.CopyInto(dst.CurrentSyncCommitee)
 	}
 
 	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee != nil {
-		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-			return
-		}
-	}
-
-	hh.Merkleize(indx)
-	return
-}
-
-// MarshalSSZ ssz marshals the BeaconBlock object
+	if b.NextSyncCommittee == nil {
+		dst.NextSyncCommittee = nil
+	} else {
+		if dst.NextSyncCommittee == nil {
+			dst.NextSyncCommittee = new(SyncCommitteeMinimal)
+		}
+		b.NextSyncCommittee.CopyInto(dst.NextSyncCommittee)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconState object
+func (b *BeaconState) SSZSchemaString() string {
+	return "Container(GenesisTime:uint64,GenesisValidatorsRoot:Vector[byte,32],Slot:uint64,Fork:Fork,LatestBlockHeader:BeaconBlockHeader,BlockRoots:Vector[Vector[byte,32],64],StateRoots:Vector[Vector[byte,32],64],HistoricalRoots:List[Vector[byte,32],16777216],Eth1Data:Eth1Data,Eth1DataVotes:List[Eth1Data,32],Eth1DepositIndex:uint64,Validators:List[Validator,1099511627776],Balances:List[uint64,1099511627776],RandaoMixes:Vector[Vector[byte,32],64],Slashings:Vector[uint64,64],PreviousEpochParticipation:List[byte,1099511627776],CurrentEpochParticipation:List[byte,1099511627776],JustificationBits:Vector[byte,1],PreviousJustifiedCheckpoint:Checkpoint,CurrentJustifiedCheckpoint:Checkpoint,FinalizedCheckpoint:Checkpoint,InactivityScores:List[uint64,1099511627776],CurrentSyncCommitee:SyncCommitteeMinimal,NextSyncCommittee:SyncCommitteeMinimal)"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconState object
+func (b *BeaconState) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconState",
+		Fields: []*ssz.SchemaField{
+			{Name: "GenesisTime", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "GenesisValidatorsRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Fork", Type: "Fork", Size: 16, Fixed: true},
+			{Name: "LatestBlockHeader", Type: "BeaconBlockHeader", Size: 112, Fixed: true},
+			{Name: "BlockRoots", Type: "Vector[Vector[byte,32],64]", Size: 2048, Fixed: true},
+			{Name: "StateRoots", Type: "Vector[Vector[byte,32],64]", Size: 2048, Fixed: true},
+			{Name: "HistoricalRoots", Type: "List[Vector[byte,32],16777216]"},
+			{Name: "Eth1Data", Type: "Eth1Data", Size: 72, Fixed: true},
+			{Name: "Eth1DataVotes", Type: "List[Eth1Data,32]"},
+			{Name: "Eth1DepositIndex", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Validators", Type: "List[Validator,1099511627776]"},
+			{Name: "Balances", Type: "List[uint64,1099511627776]"},
+			{Name: "RandaoMixes", Type: "Vector[Vector[byte,32],64]", Size: 2048, Fixed: true},
+			{Name: "Slashings", Type: "Vector[uint64,64]", Size: 512, Fixed: true},
+			{Name: "PreviousEpochParticipation", Type: "List[byte,1099511627776]"},
+			{Name: "CurrentEpochParticipation", Type: "List[byte,1099511627776]"},
+			{Name: "JustificationBits", Type: "Vector[byte,1]", Size: 1, Fixed: true},
+			{Name: "PreviousJustifiedCheckpoint", Type: "Checkpoint", Size: 40, Fixed: true},
+			{Name: "CurrentJustifiedCheckpoint", Type: "Checkpoint", Size: 40, Fixed: true},
+			{Name: "FinalizedCheckpoint", Type: "Checkpoint", Size: 40, Fixed: true},
+			{Name: "InactivityScores", Type: "List[uint64,1099511627776]"},
+			{Name: "CurrentSyncCommitee", Type: "SyncCommitteeMinimal", Size: 1632, Fixed: true},
+			{Name: "NextSyncCommittee", Type: "SyncCommitteeMinimal", Size: 1632, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconBlock object to a new buffer owned by the caller
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconBlock object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconBlock) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconBlock object to a target array
 func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(84)
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	{
+		dst = append(dst, make([]byte, 16)...)
+		fixed := dst[len(dst)-16:]
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+		// Field (0) 'Slot'
+		ssz.PutUint64(fixed[0:8], b.Slot)
+
+		// Field (1) 'ProposerIndex'
+		ssz.PutUint64(fixed[8:16], b.ProposerIndex)
+	}
 
 	// Field (2) 'ParentRoot'
 	if len(b.ParentRoot) != 32 {
@@ -2714,7 +5829,9 @@ func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = append(dst, b.StateRoot...)
 
 	// Offset (4) 'Body'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if b.Body == nil {
 		b.Body = new(BeaconBlockBody)
 	}
@@ -2730,6 +5847,11 @@ func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlock object
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconBlock object with the memory of the allocator
+func (b *BeaconBlock) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 84 {
@@ -2747,22 +5869,22 @@ func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 
 	// Field (2) 'ParentRoot'
 	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+		b.ParentRoot = ssz.AllocBytes(alloc, len(buf[16:48]))[:0]
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+	b.ParentRoot = append(b.ParentRoot[:0], buf[16:48]...)
 
 	// Field (3) 'StateRoot'
 	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+		b.StateRoot = ssz.AllocBytes(alloc, len(buf[48:80]))[:0]
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+	b.StateRoot = append(b.StateRoot[:0], buf[48:80]...)
 
 	// Offset (4) 'Body'
 	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
+	if o4 != 84 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2770,15 +5892,25 @@ func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o4:]
 		if b.Body == nil {
-			b.Body = new(BeaconBlockBody)
+			b.Body = ssz.AllocNew[BeaconBlockBody](alloc)
 		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+		if err = b.Body.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconBlock object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BeaconBlock) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
 func (b *BeaconBlock) SizeSSZ() (size int) {
 	size = 84
@@ -2800,6 +5932,7 @@ func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
 func (b *BeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(5)
 
 	// Field (0) 'Slot'
 	hh.PutUint64(b.Slot)
@@ -2830,18 +5963,158 @@ func (b *BeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlock object
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconBlock object
+func (b *BeaconBlock) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Slot":
+		leaf = 0
+	case "ProposerIndex":
+		leaf = 1
+	case "ParentRoot":
+		leaf = 2
+	case "StateRoot":
+		leaf = 3
+	case "Body":
+		leaf = 4
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconBlock object are zero
+func (b *BeaconBlock) IsZeroSSZ() bool {
+	// Field (0) 'Slot'
+	if b.Slot != 0 {
+		return false
+	}
+
+	// Field (1) 'ProposerIndex'
+	if b.ProposerIndex != 0 {
+		return false
+	}
+
+	// Field (2) 'ParentRoot'
+	for _, elem := range b.ParentRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (3) 'StateRoot'
+	for _, elem := range b.StateRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (4) 'Body'
+	if b.Body != nil && !b.Body.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconBlock object into dst reusing the memory of dst
+func (b *BeaconBlock) CopyInto(dst *BeaconBlock) {
+	// Field (0) 'Slot'
+	dst.Slot = b.Slot
+
+	// Field (1) 'ProposerIndex'
+	dst.ProposerIndex = b.ProposerIndex
+
+	// Field (2) 'ParentRoot'
+	dst.ParentRoot = append(dst.ParentRoot[:0], b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	dst.StateRoot = append(dst.StateRoot[:0], b.StateRoot...)
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		dst.Body = nil
+	} else {
+		if dst.Body == nil {
+			dst.Body = new(BeaconBlockBody)
+		}
+		b.Body.CopyInto(dst.Body)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconBlock object
+func (b *BeaconBlock) SSZSchemaString() string {
+	return "Container(Slot:uint64,ProposerIndex:uint64,ParentRoot:Vector[byte,32],StateRoot:Vector[byte,32],Body:BeaconBlockBody)"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconBlock object
+func (b *BeaconBlock) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconBlock",
+		Fields: []*ssz.SchemaField{
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ProposerIndex", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ParentRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "StateRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Body", Type: "BeaconBlockBody"},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlock object to a new buffer owned by the caller
 func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SignedBeaconBlock object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SignedBeaconBlock) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
 func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(100)
 
 	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if s.Block == nil {
 		s.Block = new(BeaconBlock)
 	}
@@ -2864,6 +6137,11 @@ func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
 func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SignedBeaconBlock object with the memory of the allocator
+func (s *SignedBeaconBlock) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 100 {
@@ -2878,29 +6156,39 @@ func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 100 {
+	if o0 != 100 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Signature'
 	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
+		s.Signature = ssz.AllocBytes(alloc, len(buf[4:100]))[:0]
 	}
-	s.Signature = append(s.Signature, buf[4:100]...)
+	s.Signature = append(s.Signature[:0], buf[4:100]...)
 
 	// Field (0) 'Block'
 	{
 		buf = tail[o0:]
 		if s.Block == nil {
-			s.Block = new(BeaconBlock)
+			s.Block = ssz.AllocNew[BeaconBlock](alloc)
 		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+		if err = s.Block.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SignedBeaconBlock object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (s *SignedBeaconBlock) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := s.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
 func (s *SignedBeaconBlock) SizeSSZ() (size int) {
 	size = 100
@@ -2914,13 +6202,49 @@ func (s *SignedBeaconBlock) SizeSSZ() (size int) {
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlock object
-func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+// HashTreeRoot ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(2)
+
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Block":
+		leaf = 0
+	case "Signature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
-func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'Block'
@@ -2935,33 +6259,93 @@ func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	hh.PutBytes(s.Signature)
 
-	hh.Merkleize(indx)
-	return
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SignedBeaconBlock object are zero
+func (s *SignedBeaconBlock) IsZeroSSZ() bool {
+	// Field (0) 'Block'
+	if s.Block != nil && !s.Block.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Signature'
+	for _, elem := range s.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SignedBeaconBlock object into dst reusing the memory of dst
+func (s *SignedBeaconBlock) CopyInto(dst *SignedBeaconBlock) {
+	// Field (0) 'Block'
+	if s.Block == nil {
+		dst.Block = nil
+	} else {
+		if dst.Block == nil {
+			dst.Block = new(BeaconBlock)
+		}
+		s.Block.CopyInto(dst.Block)
+	}
+
+	// Field (1) 'Signature'
+	dst.Signature = append(dst.Signature[:0], s.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SSZSchemaString() string {
+	return "Container(Block:BeaconBlock,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SignedBeaconBlock",
+		Fields: []*ssz.SchemaField{
+			{Name: "Block", Type: "BeaconBlock"},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
 }
 
-// MarshalSSZ ssz marshals the Transfer object
+// MarshalSSZ ssz marshals the Transfer object to a new buffer owned by the caller
 func (t *Transfer) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
 }
 
+// MarshalSSZPooled ssz marshals the Transfer object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (t *Transfer) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(t)
+}
+
 // MarshalSSZTo ssz marshals the Transfer object to a target array
 func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'Sender'
-	dst = ssz.MarshalUint64(dst, t.Sender)
+	{
+		dst = append(dst, make([]byte, 40)...)
+		fixed := dst[len(dst)-40:]
 
-	// Field (1) 'Recipient'
-	dst = ssz.MarshalUint64(dst, t.Recipient)
+		// Field (0) 'Sender'
+		ssz.PutUint64(fixed[0:8], t.Sender)
 
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, t.Amount)
+		// Field (1) 'Recipient'
+		ssz.PutUint64(fixed[8:16], t.Recipient)
 
-	// Field (3) 'Fee'
-	dst = ssz.MarshalUint64(dst, t.Fee)
+		// Field (2) 'Amount'
+		ssz.PutUint64(fixed[16:24], t.Amount)
 
-	// Field (4) 'Slot'
-	dst = ssz.MarshalUint64(dst, t.Slot)
+		// Field (3) 'Fee'
+		ssz.PutUint64(fixed[24:32], t.Fee)
+
+		// Field (4) 'Slot'
+		ssz.PutUint64(fixed[32:40], t.Slot)
+	}
 
 	// Field (5) 'Pubkey'
 	if len(t.Pubkey) != 48 {
@@ -2982,6 +6366,11 @@ func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Transfer object
 func (t *Transfer) UnmarshalSSZ(buf []byte) error {
+	return t.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Transfer object with the memory of the allocator
+func (t *Transfer) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 184 {
@@ -3005,25 +6394,42 @@ func (t *Transfer) UnmarshalSSZ(buf []byte) error {
 
 	// Field (5) 'Pubkey'
 	if cap(t.Pubkey) == 0 {
-		t.Pubkey = make([]byte, 0, len(buf[40:88]))
+		t.Pubkey = ssz.AllocBytes(alloc, len(buf[40:88]))[:0]
 	}
-	t.Pubkey = append(t.Pubkey, buf[40:88]...)
+	t.Pubkey = append(t.Pubkey[:0], buf[40:88]...)
 
 	// Field (6) 'Signature'
 	if cap(t.Signature) == 0 {
-		t.Signature = make([]byte, 0, len(buf[88:184]))
+		t.Signature = ssz.AllocBytes(alloc, len(buf[88:184]))[:0]
 	}
-	t.Signature = append(t.Signature, buf[88:184]...)
+	t.Signature = append(t.Signature[:0], buf[88:184]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Transfer object at the start of the buffer and
+// returns the number of bytes consumed
+func (t *Transfer) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 184 {
+		return 0, ssz.ErrSize
+	}
+	if err := t.UnmarshalSSZ(buf[:184]); err != nil {
+		return 0, err
+	}
+	return 184, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Transfer object
 func (t *Transfer) SizeSSZ() (size int) {
 	size = 184
 	return
 }
 
+// SizeSSZTransfer returns the ssz encoded size in bytes of any Transfer object
+func SizeSSZTransfer() int {
+	return 184
+}
+
 // HashTreeRoot ssz hashes the Transfer object
 func (t *Transfer) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(t)
@@ -3032,6 +6438,7 @@ func (t *Transfer) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Transfer object with a hasher
 func (t *Transfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(7)
 
 	// Field (0) 'Sender'
 	hh.PutUint64(t.Sender)
@@ -3066,11 +6473,168 @@ func (t *Transfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBody object
+// MerkleProof returns the merkle branch that proves the field against the root of the Transfer object
+func (t *Transfer) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Sender":
+		leaf = 0
+	case "Recipient":
+		leaf = 1
+	case "Amount":
+		leaf = 2
+	case "Fee":
+		leaf = 3
+	case "Slot":
+		leaf = 4
+	case "Pubkey":
+		leaf = 5
+	case "Signature":
+		leaf = 6
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Sender'
+	hh.PutUint64(t.Sender)
+
+	// Field (1) 'Recipient'
+	hh.PutUint64(t.Recipient)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(t.Amount)
+
+	// Field (3) 'Fee'
+	hh.PutUint64(t.Fee)
+
+	// Field (4) 'Slot'
+	hh.PutUint64(t.Slot)
+
+	// Field (5) 'Pubkey'
+	if len(t.Pubkey) != 48 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Pubkey)
+
+	// Field (6) 'Signature'
+	if len(t.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Signature)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Transfer object are zero
+func (t *Transfer) IsZeroSSZ() bool {
+	// Field (0) 'Sender'
+	if t.Sender != 0 {
+		return false
+	}
+
+	// Field (1) 'Recipient'
+	if t.Recipient != 0 {
+		return false
+	}
+
+	// Field (2) 'Amount'
+	if t.Amount != 0 {
+		return false
+	}
+
+	// Field (3) 'Fee'
+	if t.Fee != 0 {
+		return false
+	}
+
+	// Field (4) 'Slot'
+	if t.Slot != 0 {
+		return false
+	}
+
+	// Field (5) 'Pubkey'
+	for _, elem := range t.Pubkey {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (6) 'Signature'
+	for _, elem := range t.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the Transfer object into dst reusing the memory of dst
+func (t *Transfer) CopyInto(dst *Transfer) {
+	// Field (0) 'Sender'
+	dst.Sender = t.Sender
+
+	// Field (1) 'Recipient'
+	dst.Recipient = t.Recipient
+
+	// Field (2) 'Amount'
+	dst.Amount = t.Amount
+
+	// Field (3) 'Fee'
+	dst.Fee = t.Fee
+
+	// Field (4) 'Slot'
+	dst.Slot = t.Slot
+
+	// Field (5) 'Pubkey'
+	dst.Pubkey = append(dst.Pubkey[:0], t.Pubkey...)
+
+	// Field (6) 'Signature'
+	dst.Signature = append(dst.Signature[:0], t.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Transfer object
+func (t *Transfer) SSZSchemaString() string {
+	return "Container(Sender:uint64,Recipient:uint64,Amount:uint64,Fee:uint64,Slot:uint64,Pubkey:Vector[byte,48],Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the Transfer object
+func (t *Transfer) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Transfer",
+		Fields: []*ssz.SchemaField{
+			{Name: "Sender", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Recipient", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Amount", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Fee", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "Pubkey", Type: "Vector[byte,48]", Size: 48, Fixed: true},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBody object to a new buffer owned by the caller
 func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconBlockBody object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconBlockBody) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
 func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -3094,29 +6658,39 @@ func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = append(dst, b.Graffiti[:]...)
 
 	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.ProposerSlashings) * 416
 
 	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
 		offset += 4
 		offset += b.AttesterSlashings[ii].SizeSSZ()
 	}
 
 	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	for ii := 0; ii < len(b.Attestations); ii++ {
 		offset += 4
 		offset += b.Attestations[ii].SizeSSZ()
 	}
 
 	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.Deposits) * 1240
 
 	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.VoluntaryExits) * 112
 
 	// Field (8) 'SyncAggregate'
@@ -3145,7 +6719,9 @@ func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	{
 		offset = 4 * len(b.AttesterSlashings)
 		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
 			offset += b.AttesterSlashings[ii].SizeSSZ()
 		}
 	}
@@ -3163,7 +6739,9 @@ func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	{
 		offset = 4 * len(b.Attestations)
 		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
 			offset += b.Attestations[ii].SizeSSZ()
 		}
 	}
@@ -3200,6 +6778,11 @@ func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
 func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconBlockBody object with the memory of the allocator
+func (b *BeaconBlockBody) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 444 {
@@ -3211,15 +6794,15 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		b.RandaoReveal = ssz.AllocBytes(alloc, len(buf[0:96]))[:0]
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	b.RandaoReveal = append(b.RandaoReveal[:0], buf[0:96]...)
 
 	// Field (1) 'Eth1Data'
 	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+		b.Eth1Data = ssz.AllocNew[Eth1Data](alloc)
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+	if err = b.Eth1Data.UnmarshalSSZArena(buf[96:168], alloc); err != nil {
 		return err
 	}
 
@@ -3231,7 +6814,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 444 {
+	if o3 != 444 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -3257,9 +6840,9 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 
 	// Field (8) 'SyncAggregate'
 	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
+		b.SyncAggregate = ssz.AllocNew[SyncAggregate](alloc)
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:444]); err != nil {
+	if err = b.SyncAggregate.UnmarshalSSZArena(buf[220:444], alloc); err != nil {
 		return err
 	}
 
@@ -3270,12 +6853,12 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		b.ProposerSlashings = ssz.AllocExtend(alloc, b.ProposerSlashings, num)
 		for ii := 0; ii < num; ii++ {
 			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
+				b.ProposerSlashings[ii] = ssz.AllocNew[ProposerSlashing](alloc)
 			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+			if err = b.ProposerSlashings[ii].UnmarshalSSZArena(buf[ii*416:(ii+1)*416], alloc); err != nil {
 				return err
 			}
 		}
@@ -3288,12 +6871,12 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		b.AttesterSlashings = ssz.AllocExtend(alloc, b.AttesterSlashings, num)
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
 			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
+				b.AttesterSlashings[indx] = ssz.AllocNew[AttesterSlashing](alloc)
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+			if err = b.AttesterSlashings[indx].UnmarshalSSZArena(buf, alloc); err != nil {
 				return err
 			}
 			return nil
@@ -3310,12 +6893,12 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Attestations = make([]*Attestation, num)
+		b.Attestations = ssz.AllocExtend(alloc, b.Attestations, num)
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
 			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
+				b.Attestations[indx] = ssz.AllocNew[Attestation](alloc)
 			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+			if err = b.Attestations[indx].UnmarshalSSZArena(buf, alloc); err != nil {
 				return err
 			}
 			return nil
@@ -3332,12 +6915,12 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Deposits = make([]*Deposit, num)
+		b.Deposits = ssz.AllocExtend(alloc, b.Deposits, num)
 		for ii := 0; ii < num; ii++ {
 			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
+				b.Deposits[ii] = ssz.AllocNew[Deposit](alloc)
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
+			if err = b.Deposits[ii].UnmarshalSSZArena(buf[ii*1240:(ii+1)*1240], alloc); err != nil {
 				return err
 			}
 		}
@@ -3350,12 +6933,12 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		b.VoluntaryExits = ssz.AllocExtend(alloc, b.VoluntaryExits, num)
 		for ii := 0; ii < num; ii++ {
 			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				b.VoluntaryExits[ii] = ssz.AllocNew[SignedVoluntaryExit](alloc)
 			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+			if err = b.VoluntaryExits[ii].UnmarshalSSZArena(buf[ii*112:(ii+1)*112], alloc); err != nil {
 				return err
 			}
 		}
@@ -3363,6 +6946,16 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconBlockBody object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BeaconBlockBody) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
 func (b *BeaconBlockBody) SizeSSZ() (size int) {
 	size = 444
@@ -3382,22 +6975,164 @@ func (b *BeaconBlockBody) SizeSSZ() (size int) {
 		size += b.Attestations[ii].SizeSSZ()
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(9)
+
+	// Field (0) 'RandaoReveal'
+	if len(b.RandaoReveal) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data != nil {
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
 	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate != nil {
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
 
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBody object
-func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconBlockBody object
+func (b *BeaconBlockBody) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "RandaoReveal":
+		leaf = 0
+	case "Eth1Data":
+		leaf = 1
+	case "Graffiti":
+		leaf = 2
+	case "ProposerSlashings":
+		leaf = 3
+	case "AttesterSlashings":
+		leaf = 4
+	case "Attestations":
+		leaf = 5
+	case "Deposits":
+		leaf = 6
+	case "VoluntaryExits":
+		leaf = 7
+	case "SyncAggregate":
+		leaf = 8
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
 
-// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
-func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -3504,15 +7239,210 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	hh.Merkleize(indx)
-	return
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconBlockBody object are zero
+func (b *BeaconBlockBody) IsZeroSSZ() bool {
+	// Field (0) 'RandaoReveal'
+	for _, elem := range b.RandaoReveal {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data != nil && !b.Eth1Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'Graffiti'
+	if b.Graffiti != [32]byte{} {
+		return false
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if len(b.ProposerSlashings) != 0 {
+		return false
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if len(b.AttesterSlashings) != 0 {
+		return false
+	}
+
+	// Field (5) 'Attestations'
+	if len(b.Attestations) != 0 {
+		return false
+	}
+
+	// Field (6) 'Deposits'
+	if len(b.Deposits) != 0 {
+		return false
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if len(b.VoluntaryExits) != 0 {
+		return false
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate != nil && !b.SyncAggregate.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconBlockBody object into dst reusing the memory of dst
+func (b *BeaconBlockBody) CopyInto(dst *BeaconBlockBody) {
+	// Field (0) 'RandaoReveal'
+	dst.RandaoReveal = append(dst.RandaoReveal[:0], b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		dst.Eth1Data = nil
+	} else {
+		if dst.Eth1Data == nil {
+			dst.Eth1Data = new(Eth1Data)
+		}
+		b.Eth1Data.CopyInto(dst.Eth1Data)
+	}
+
+	// Field (2) 'Graffiti'
+	dst.Graffiti = b.Graffiti
+
+	// Field (3) 'ProposerSlashings'
+	if cap(dst.ProposerSlashings) < len(b.ProposerSlashings) {
+		dst.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+	} else {
+		dst.ProposerSlashings = dst.ProposerSlashings[:len(b.ProposerSlashings)]
+	}
+	for ii := range b.ProposerSlashings {
+		if b.ProposerSlashings[ii] == nil {
+			dst.ProposerSlashings[ii] = nil
+		} else {
+			if dst.ProposerSlashings[ii] == nil {
+				dst.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			b.ProposerSlashings[ii].CopyInto(dst.ProposerSlashings[ii])
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if cap(dst.AttesterSlashings) < len(b.AttesterSlashings) {
+		dst.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+	} else {
+		dst.AttesterSlashings = dst.AttesterSlashings[:len(b.AttesterSlashings)]
+	}
+	for ii := range b.AttesterSlashings {
+		if b.AttesterSlashings[ii] == nil {
+			dst.AttesterSlashings[ii] = nil
+		} else {
+			if dst.AttesterSlashings[ii] == nil {
+				dst.AttesterSlashings[ii] = new(AttesterSlashing)
+			}
+			b.AttesterSlashings[ii].CopyInto(dst.AttesterSlashings[ii])
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if cap(dst.Attestations) < len(b.Attestations) {
+		dst.Attestations = make([]*Attestation, len(b.Attestations))
+	} else {
+		dst.Attestations = dst.Attestations[:len(b.Attestations)]
+	}
+	for ii := range b.Attestations {
+		if b.Attestations[ii] == nil {
+			dst.Attestations[ii] = nil
+		} else {
+			if dst.Attestations[ii] == nil {
+				dst.Attestations[ii] = new(Attestation)
+			}
+			b.Attestations[ii].CopyInto(dst.Attestations[ii])
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if cap(dst.Deposits) < len(b.Deposits) {
+		dst.Deposits = make([]*Deposit, len(b.Deposits))
+	} else {
+		dst.Deposits = dst.Deposits[:len(b.Deposits)]
+	}
+	for ii := range b.Deposits {
+		if b.Deposits[ii] == nil {
+			dst.Deposits[ii] = nil
+		} else {
+			if dst.Deposits[ii] == nil {
+				dst.Deposits[ii] = new(Deposit)
+			}
+			b.Deposits[ii].CopyInto(dst.Deposits[ii])
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if cap(dst.VoluntaryExits) < len(b.VoluntaryExits) {
+		dst.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+	} else {
+		dst.VoluntaryExits = dst.VoluntaryExits[:len(b.VoluntaryExits)]
+	}
+	for ii := range b.VoluntaryExits {
+		if b.VoluntaryExits[ii] == nil {
+			dst.VoluntaryExits[ii] = nil
+		} else {
+			if dst.VoluntaryExits[ii] == nil {
+				dst.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			b.VoluntaryExits[ii].CopyInto(dst.VoluntaryExits[ii])
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		dst.SyncAggregate = nil
+	} else {
+		if dst.SyncAggregate == nil {
+			dst.SyncAggregate = new(SyncAggregate)
+		}
+		b.SyncAggregate.CopyInto(dst.SyncAggregate)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconBlockBody object
+func (b *BeaconBlockBody) SSZSchemaString() string {
+	return "Container(RandaoReveal:Vector[byte,96],Eth1Data:Eth1Data,Graffiti:Vector[byte,32],ProposerSlashings:List[ProposerSlashing,16],AttesterSlashings:List[AttesterSlashing,2],Attestations:List[Attestation,128],Deposits:List[Deposit,16],VoluntaryExits:List[SignedVoluntaryExit,16],SyncAggregate:SyncAggregate)"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconBlockBody object
+func (b *BeaconBlockBody) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconBlockBody",
+		Fields: []*ssz.SchemaField{
+			{Name: "RandaoReveal", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+			{Name: "Eth1Data", Type: "Eth1Data", Size: 72, Fixed: true},
+			{Name: "Graffiti", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "ProposerSlashings", Type: "List[ProposerSlashing,16]"},
+			{Name: "AttesterSlashings", Type: "List[AttesterSlashing,2]"},
+			{Name: "Attestations", Type: "List[Attestation,128]"},
+			{Name: "Deposits", Type: "List[Deposit,16]"},
+			{Name: "VoluntaryExits", Type: "List[SignedVoluntaryExit,16]"},
+			{Name: "SyncAggregate", Type: "SyncAggregate", Size: 224, Fixed: true},
+		},
+	}
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object to a new buffer owned by the caller
 func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SignedBeaconBlockHeader object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SignedBeaconBlockHeader) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
 func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -3536,6 +7466,11 @@ func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err erro
 
 // UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
 func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SignedBeaconBlockHeader object with the memory of the allocator
+func (s *SignedBeaconBlockHeader) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 208 {
@@ -3544,27 +7479,44 @@ func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Header'
 	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
+		s.Header = ssz.AllocNew[BeaconBlockHeader](alloc)
 	}
-	if err = s.Header.UnmarshalSSZ(buf[0:112]); err != nil {
+	if err = s.Header.UnmarshalSSZArena(buf[0:112], alloc); err != nil {
 		return err
 	}
 
 	// Field (1) 'Signature'
 	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[112:208]))
+		s.Signature = ssz.AllocBytes(alloc, len(buf[112:208]))[:0]
 	}
-	s.Signature = append(s.Signature, buf[112:208]...)
+	s.Signature = append(s.Signature[:0], buf[112:208]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SignedBeaconBlockHeader object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SignedBeaconBlockHeader) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 208 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:208]); err != nil {
+		return 0, err
+	}
+	return 208, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
 func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
 	size = 208
 	return
 }
 
+// SizeSSZSignedBeaconBlockHeader returns the ssz encoded size in bytes of any SignedBeaconBlockHeader object
+func SizeSSZSignedBeaconBlockHeader() int {
+	return 208
+}
+
 // HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
 func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -3573,6 +7525,7 @@ func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
 func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Header'
 	if s.Header != nil {
@@ -3592,20 +7545,117 @@ func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
+// MerkleProof returns the merkle branch that proves the field against the root of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Header":
+		leaf = 0
+	case "Signature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if s.Header != nil {
+		if err = s.Header.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SignedBeaconBlockHeader object are zero
+func (s *SignedBeaconBlockHeader) IsZeroSSZ() bool {
+	// Field (0) 'Header'
+	if s.Header != nil && !s.Header.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Signature'
+	for _, elem := range s.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SignedBeaconBlockHeader object into dst reusing the memory of dst
+func (s *SignedBeaconBlockHeader) CopyInto(dst *SignedBeaconBlockHeader) {
+	// Field (0) 'Header'
+	if s.Header == nil {
+		dst.Header = nil
+	} else {
+		if dst.Header == nil {
+			dst.Header = new(BeaconBlockHeader)
+		}
+		s.Header.CopyInto(dst.Header)
+	}
+
+	// Field (1) 'Signature'
+	dst.Signature = append(dst.Signature[:0], s.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SSZSchemaString() string {
+	return "Container(Header:BeaconBlockHeader,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SignedBeaconBlockHeader",
+		Fields: []*ssz.SchemaField{
+			{Name: "Header", Type: "BeaconBlockHeader", Size: 112, Fixed: true},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object to a new buffer owned by the caller
 func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconBlockHeader object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconBlockHeader) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
 func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	{
+		dst = append(dst, make([]byte, 16)...)
+		fixed := dst[len(dst)-16:]
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+		// Field (0) 'Slot'
+		ssz.PutUint64(fixed[0:8], b.Slot)
+
+		// Field (1) 'ProposerIndex'
+		ssz.PutUint64(fixed[8:16], b.ProposerIndex)
+	}
 
 	// Field (2) 'ParentRoot'
 	if len(b.ParentRoot) != 32 {
@@ -3633,6 +7683,11 @@ func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
 func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconBlockHeader object with the memory of the allocator
+func (b *BeaconBlockHeader) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 112 {
@@ -3647,31 +7702,48 @@ func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
 
 	// Field (2) 'ParentRoot'
 	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+		b.ParentRoot = ssz.AllocBytes(alloc, len(buf[16:48]))[:0]
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+	b.ParentRoot = append(b.ParentRoot[:0], buf[16:48]...)
 
 	// Field (3) 'StateRoot'
 	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+		b.StateRoot = ssz.AllocBytes(alloc, len(buf[48:80]))[:0]
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+	b.StateRoot = append(b.StateRoot[:0], buf[48:80]...)
 
 	// Field (4) 'BodyRoot'
 	if cap(b.BodyRoot) == 0 {
-		b.BodyRoot = make([]byte, 0, len(buf[80:112]))
+		b.BodyRoot = ssz.AllocBytes(alloc, len(buf[80:112]))[:0]
 	}
-	b.BodyRoot = append(b.BodyRoot, buf[80:112]...)
+	b.BodyRoot = append(b.BodyRoot[:0], buf[80:112]...)
 
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconBlockHeader object at the start of the buffer and
+// returns the number of bytes consumed
+func (b *BeaconBlockHeader) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 112 {
+		return 0, ssz.ErrSize
+	}
+	if err := b.UnmarshalSSZ(buf[:112]); err != nil {
+		return 0, err
+	}
+	return 112, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
 func (b *BeaconBlockHeader) SizeSSZ() (size int) {
 	size = 112
 	return
 }
 
+// SizeSSZBeaconBlockHeader returns the ssz encoded size in bytes of any BeaconBlockHeader object
+func SizeSSZBeaconBlockHeader() int {
+	return 112
+}
+
 // HashTreeRoot ssz hashes the BeaconBlockHeader object
 func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
@@ -3679,6 +7751,63 @@ func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
 
 // HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
 func (b *BeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(5)
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	if len(b.BodyRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.BodyRoot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Slot":
+		leaf = 0
+	case "ProposerIndex":
+		leaf = 1
+	case "ParentRoot":
+		leaf = 2
+	case "StateRoot":
+		leaf = 3
+	case "BodyRoot":
+		leaf = 4
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
 	indx := hh.Index()
 
 	// Field (0) 'Slot'
@@ -3708,22 +7837,103 @@ func (b *BeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	hh.PutBytes(b.BodyRoot)
 
-	hh.Merkleize(indx)
-	return
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconBlockHeader object are zero
+func (b *BeaconBlockHeader) IsZeroSSZ() bool {
+	// Field (0) 'Slot'
+	if b.Slot != 0 {
+		return false
+	}
+
+	// Field (1) 'ProposerIndex'
+	if b.ProposerIndex != 0 {
+		return false
+	}
+
+	// Field (2) 'ParentRoot'
+	for _, elem := range b.ParentRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (3) 'StateRoot'
+	for _, elem := range b.StateRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (4) 'BodyRoot'
+	for _, elem := range b.BodyRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconBlockHeader object into dst reusing the memory of dst
+func (b *BeaconBlockHeader) CopyInto(dst *BeaconBlockHeader) {
+	// Field (0) 'Slot'
+	dst.Slot = b.Slot
+
+	// Field (1) 'ProposerIndex'
+	dst.ProposerIndex = b.ProposerIndex
+
+	// Field (2) 'ParentRoot'
+	dst.ParentRoot = append(dst.ParentRoot[:0], b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	dst.StateRoot = append(dst.StateRoot[:0], b.StateRoot...)
+
+	// Field (4) 'BodyRoot'
+	dst.BodyRoot = append(dst.BodyRoot[:0], b.BodyRoot...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SSZSchemaString() string {
+	return "Container(Slot:uint64,ProposerIndex:uint64,ParentRoot:Vector[byte,32],StateRoot:Vector[byte,32],BodyRoot:Vector[byte,32])"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconBlockHeader",
+		Fields: []*ssz.SchemaField{
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ProposerIndex", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ParentRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "StateRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "BodyRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+		},
+	}
 }
 
-// MarshalSSZ ssz marshals the ErrorResponse object
+// MarshalSSZ ssz marshals the ErrorResponse object to a new buffer owned by the caller
 func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
 }
 
+// MarshalSSZPooled ssz marshals the ErrorResponse object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (e *ErrorResponse) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(e)
+}
+
 // MarshalSSZTo ssz marshals the ErrorResponse object to a target array
 func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(4)
 
 	// Offset (0) 'Message'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += e.Message.SizeSSZ()
 
 	// Field (0) 'Message'
@@ -3736,6 +7946,11 @@ func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the ErrorResponse object
 func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the ErrorResponse object with the memory of the allocator
+func (e *ErrorResponse) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -3750,20 +7965,30 @@ func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (0) 'Message'
 	{
 		buf = tail[o0:]
-		if err = e.Message.UnmarshalSSZ(buf); err != nil {
+		if err = ssz.UnmarshalWithAllocator(&e.Message, buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the ErrorResponse object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (e *ErrorResponse) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := e.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
 func (e *ErrorResponse) SizeSSZ() (size int) {
 	size = 4
@@ -3782,6 +8007,7 @@ func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
 func (e *ErrorResponse) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(1)
 
 	// Field (0) 'Message'
 	if err = e.Message.HashTreeRootWith(hh); err != nil {
@@ -3792,11 +8018,78 @@ func (e *ErrorResponse) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Dummy object
+// MerkleProof returns the merkle branch that proves the field against the root of the ErrorResponse object
+func (e *ErrorResponse) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Message":
+		leaf = 0
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = e.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the ErrorResponse object are zero
+func (e *ErrorResponse) IsZeroSSZ() bool {
+	// Field (0) 'Message'
+	if !ssz.IsZero(&e.Message) {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the ErrorResponse object into dst reusing the memory of dst
+func (e *ErrorResponse) CopyInto(dst *ErrorResponse) {
+	// Field (0) 'Message'
+	if obj, ok := interface{}(&e.Message).(interface{ CopyInto(*external.DynamicBytes) }); ok {
+		obj.CopyInto(&dst.Message)
+	} else {
+		dst.Message = e.Message
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the ErrorResponse object
+func (e *ErrorResponse) SSZSchemaString() string {
+	return "Container(Message:DynamicBytes)"
+}
+
+// SSZSchema returns the layout of the fields of the ErrorResponse object
+func (e *ErrorResponse) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "ErrorResponse",
+		Fields: []*ssz.SchemaField{
+			{Name: "Message", Type: "DynamicBytes"},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the Dummy object to a new buffer owned by the caller
 func (d *Dummy) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
 }
 
+// MarshalSSZPooled ssz marshals the Dummy object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (d *Dummy) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(d)
+}
+
 // MarshalSSZTo ssz marshals the Dummy object to a target array
 func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -3806,6 +8099,11 @@ func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Dummy object
 func (d *Dummy) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Dummy object with the memory of the allocator
+func (d *Dummy) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 0 {
@@ -3815,12 +8113,26 @@ func (d *Dummy) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Dummy object at the start of the buffer and
+// returns the number of bytes consumed
+func (d *Dummy) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := d.UnmarshalSSZ(buf[:0]); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Dummy object
 func (d *Dummy) SizeSSZ() (size int) {
 	size = 0
 	return
 }
 
+// SizeSSZDummy returns the ssz encoded size in bytes of any Dummy object
+func SizeSSZDummy() int {
+	return 0
+}
+
 // HashTreeRoot ssz hashes the Dummy object
 func (d *Dummy) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -3829,16 +8141,55 @@ func (d *Dummy) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Dummy object with a hasher
 func (d *Dummy) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(0)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the SyncCommittee object
+// MerkleProof returns the merkle branch that proves the field against the root of the Dummy object
+func (d *Dummy) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	err = ssz.ErrUnknownField
+	return
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Dummy object are zero
+func (d *Dummy) IsZeroSSZ() bool {
+
+	return true
+}
+
+// CopyInto copies the Dummy object into dst reusing the memory of dst
+func (d *Dummy) CopyInto(dst *Dummy) {
+
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Dummy object
+func (d *Dummy) SSZSchemaString() string {
+	return "Container()"
+}
+
+// SSZSchema returns the layout of the fields of the Dummy object
+func (d *Dummy) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name:   "Dummy",
+		Fields: []*ssz.SchemaField{},
+	}
+}
+
+// MarshalSSZ ssz marshals the SyncCommittee object to a new buffer owned by the caller
 func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SyncCommittee object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SyncCommittee) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SyncCommittee object to a target array
 func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -3866,6 +8217,11 @@ func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the SyncCommittee object
 func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SyncCommittee object with the memory of the allocator
+func (s *SyncCommittee) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 49920 {
@@ -3873,12 +8229,12 @@ func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, 1024)
+	s.PubKeys = ssz.AllocSlice[[]byte](alloc, 1024)
 	for ii := 0; ii < 1024; ii++ {
 		if cap(s.PubKeys[ii]) == 0 {
-			s.PubKeys[ii] = make([]byte, 0, len(buf[0:49152][ii*48:(ii+1)*48]))
+			s.PubKeys[ii] = ssz.AllocBytes(alloc, len(buf[0:49152][ii*48:(ii+1)*48]))[:0]
 		}
-		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:49152][ii*48:(ii+1)*48]...)
+		s.PubKeys[ii] = append(s.PubKeys[ii][:0], buf[0:49152][ii*48:(ii+1)*48]...)
 	}
 
 	// Field (1) 'PubKeyAggregates'
@@ -3890,12 +8246,29 @@ func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SyncCommittee object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SyncCommittee) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 49920 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:49920]); err != nil {
+		return 0, err
+	}
+	return 49920, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
 func (s *SyncCommittee) SizeSSZ() (size int) {
 	size = 49920
 	return
 }
 
+// SizeSSZSyncCommittee returns the ssz encoded size in bytes of any SyncCommittee object
+func SizeSSZSyncCommittee() int {
+	return 49920
+}
+
 // HashTreeRoot ssz hashes the SyncCommittee object
 func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -3904,6 +8277,7 @@ func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
 func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'PubKeys'
 	{
@@ -3935,11 +8309,119 @@ func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SyncAggregate object
+// MerkleProof returns the merkle branch that proves the field against the root of the SyncCommittee object
+func (s *SyncCommittee) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "PubKeys":
+		leaf = 0
+	case "PubKeyAggregates":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if len(s.PubKeys) != 1024 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		subIndx := hh.Index()
+		for _, i := range s.PubKeyAggregates {
+			hh.PutBytes(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SyncCommittee object are zero
+func (s *SyncCommittee) IsZeroSSZ() bool {
+	// Field (0) 'PubKeys'
+	for ii := range s.PubKeys {
+		for _, elem := range s.PubKeys[ii] {
+			if elem != 0 {
+				return false
+			}
+		}
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := range s.PubKeyAggregates {
+		if s.PubKeyAggregates[ii] != [48]byte{} {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SyncCommittee object into dst reusing the memory of dst
+func (s *SyncCommittee) CopyInto(dst *SyncCommittee) {
+	// Field (0) 'PubKeys'
+	if cap(dst.PubKeys) < len(s.PubKeys) {
+		dst.PubKeys = make([][]byte, len(s.PubKeys))
+	} else {
+		dst.PubKeys = dst.PubKeys[:len(s.PubKeys)]
+	}
+	for ii := range s.PubKeys {
+		dst.PubKeys[ii] = append(dst.PubKeys[ii][:0], s.PubKeys[ii]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	dst.PubKeyAggregates = s.PubKeyAggregates
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SyncCommittee object
+func (s *SyncCommittee) SSZSchemaString() string {
+	return "Container(PubKeys:Vector[Vector[byte,48],1024],PubKeyAggregates:Vector[Vector[byte,48],16])"
+}
+
+// SSZSchema returns the layout of the fields of the SyncCommittee object
+func (s *SyncCommittee) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SyncCommittee",
+		Fields: []*ssz.SchemaField{
+			{Name: "PubKeys", Type: "Vector[Vector[byte,48],1024]", Size: 49152, Fixed: true},
+			{Name: "PubKeyAggregates", Type: "Vector[Vector[byte,48],16]", Size: 768, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SyncAggregate object to a new buffer owned by the caller
 func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SyncAggregate object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SyncAggregate) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SyncAggregate object to a target array
 func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -3959,6 +8441,11 @@ func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the SyncAggregate object
 func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SyncAggregate object with the memory of the allocator
+func (s *SyncAggregate) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 224 {
@@ -3967,9 +8454,9 @@ func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'SyncCommiteeBits'
 	if cap(s.SyncCommiteeBits) == 0 {
-		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:128]))
+		s.SyncCommiteeBits = ssz.AllocBytes(alloc, len(buf[0:128]))[:0]
 	}
-	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:128]...)
+	s.SyncCommiteeBits = append(s.SyncCommiteeBits[:0], buf[0:128]...)
 
 	// Field (1) 'SyncCommiteeSignature'
 	copy(s.SyncCommiteeSignature[:], buf[128:224])
@@ -3977,12 +8464,29 @@ func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SyncAggregate object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SyncAggregate) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 224 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:224]); err != nil {
+		return 0, err
+	}
+	return 224, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
 func (s *SyncAggregate) SizeSSZ() (size int) {
 	size = 224
 	return
 }
 
+// SizeSSZSyncAggregate returns the ssz encoded size in bytes of any SyncAggregate object
+func SizeSSZSyncAggregate() int {
+	return 224
+}
+
 // HashTreeRoot ssz hashes the SyncAggregate object
 func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -3991,6 +8495,7 @@ func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
 func (s *SyncAggregate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'SyncCommiteeBits'
 	if len(s.SyncCommiteeBits) != 128 {
@@ -4006,11 +8511,92 @@ func (s *SyncAggregate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SyncCommitteeMinimal object
+// MerkleProof returns the merkle branch that proves the field against the root of the SyncAggregate object
+func (s *SyncAggregate) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "SyncCommiteeBits":
+		leaf = 0
+	case "SyncCommiteeSignature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if len(s.SyncCommiteeBits) != 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SyncAggregate object are zero
+func (s *SyncAggregate) IsZeroSSZ() bool {
+	// Field (0) 'SyncCommiteeBits'
+	for _, elem := range s.SyncCommiteeBits {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != [96]byte{} {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the SyncAggregate object into dst reusing the memory of dst
+func (s *SyncAggregate) CopyInto(dst *SyncAggregate) {
+	// Field (0) 'SyncCommiteeBits'
+	dst.SyncCommiteeBits = append(dst.SyncCommiteeBits[:0], s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst.SyncCommiteeSignature = s.SyncCommiteeSignature
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SyncAggregate object
+func (s *SyncAggregate) SSZSchemaString() string {
+	return "Container(SyncCommiteeBits:Vector[byte,128],SyncCommiteeSignature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SyncAggregate object
+func (s *SyncAggregate) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SyncAggregate",
+		Fields: []*ssz.SchemaField{
+			{Name: "SyncCommiteeBits", Type: "Vector[byte,128]", Size: 128, Fixed: true},
+			{Name: "SyncCommiteeSignature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SyncCommitteeMinimal object to a new buffer owned by the caller
 func (s *SyncCommitteeMinimal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SyncCommitteeMinimal object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SyncCommitteeMinimal) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SyncCommitteeMinimal object to a target array
 func (s *SyncCommitteeMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -4038,6 +8624,11 @@ func (s *SyncCommitteeMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 // UnmarshalSSZ ssz unmarshals the SyncCommitteeMinimal object
 func (s *SyncCommitteeMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SyncCommitteeMinimal object with the memory of the allocator
+func (s *SyncCommitteeMinimal) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 1632 {
@@ -4045,12 +8636,12 @@ func (s *SyncCommitteeMinimal) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, 32)
+	s.PubKeys = ssz.AllocSlice[[]byte](alloc, 32)
 	for ii := 0; ii < 32; ii++ {
 		if cap(s.PubKeys[ii]) == 0 {
-			s.PubKeys[ii] = make([]byte, 0, len(buf[0:1536][ii*48:(ii+1)*48]))
+			s.PubKeys[ii] = ssz.AllocBytes(alloc, len(buf[0:1536][ii*48:(ii+1)*48]))[:0]
 		}
-		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:1536][ii*48:(ii+1)*48]...)
+		s.PubKeys[ii] = append(s.PubKeys[ii][:0], buf[0:1536][ii*48:(ii+1)*48]...)
 	}
 
 	// Field (1) 'PubKeyAggregates'
@@ -4062,12 +8653,29 @@ func (s *SyncCommitteeMinimal) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SyncCommitteeMinimal object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SyncCommitteeMinimal) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 1632 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:1632]); err != nil {
+		return 0, err
+	}
+	return 1632, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SyncCommitteeMinimal object
 func (s *SyncCommitteeMinimal) SizeSSZ() (size int) {
 	size = 1632
 	return
 }
 
+// SizeSSZSyncCommitteeMinimal returns the ssz encoded size in bytes of any SyncCommitteeMinimal object
+func SizeSSZSyncCommitteeMinimal() int {
+	return 1632
+}
+
 // HashTreeRoot ssz hashes the SyncCommitteeMinimal object
 func (s *SyncCommitteeMinimal) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -4076,6 +8684,7 @@ func (s *SyncCommitteeMinimal) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SyncCommitteeMinimal object with a hasher
 func (s *SyncCommitteeMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'PubKeys'
 	{
@@ -4107,11 +8716,119 @@ func (s *SyncCommitteeMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SyncAggregateMinimal object
+// MerkleProof returns the merkle branch that proves the field against the root of the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "PubKeys":
+		leaf = 0
+	case "PubKeyAggregates":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if len(s.PubKeys) != 32 {
+			err = ssz.ErrVectorLength
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		subIndx := hh.Index()
+		for _, i := range s.PubKeyAggregates {
+			hh.PutBytes(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SyncCommitteeMinimal object are zero
+func (s *SyncCommitteeMinimal) IsZeroSSZ() bool {
+	// Field (0) 'PubKeys'
+	for ii := range s.PubKeys {
+		for _, elem := range s.PubKeys[ii] {
+			if elem != 0 {
+				return false
+			}
+		}
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := range s.PubKeyAggregates {
+		if s.PubKeyAggregates[ii] != [48]byte{} {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SyncCommitteeMinimal object into dst reusing the memory of dst
+func (s *SyncCommitteeMinimal) CopyInto(dst *SyncCommitteeMinimal) {
+	// Field (0) 'PubKeys'
+	if cap(dst.PubKeys) < len(s.PubKeys) {
+		dst.PubKeys = make([][]byte, len(s.PubKeys))
+	} else {
+		dst.PubKeys = dst.PubKeys[:len(s.PubKeys)]
+	}
+	for ii := range s.PubKeys {
+		dst.PubKeys[ii] = append(dst.PubKeys[ii][:0], s.PubKeys[ii]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	dst.PubKeyAggregates = s.PubKeyAggregates
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) SSZSchemaString() string {
+	return "Container(PubKeys:Vector[Vector[byte,48],32],PubKeyAggregates:Vector[Vector[byte,48],2])"
+}
+
+// SSZSchema returns the layout of the fields of the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SyncCommitteeMinimal",
+		Fields: []*ssz.SchemaField{
+			{Name: "PubKeys", Type: "Vector[Vector[byte,48],32]", Size: 1536, Fixed: true},
+			{Name: "PubKeyAggregates", Type: "Vector[Vector[byte,48],2]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SyncAggregateMinimal object to a new buffer owned by the caller
 func (s *SyncAggregateMinimal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SyncAggregateMinimal object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SyncAggregateMinimal) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SyncAggregateMinimal object to a target array
 func (s *SyncAggregateMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -4131,6 +8848,11 @@ func (s *SyncAggregateMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 // UnmarshalSSZ ssz unmarshals the SyncAggregateMinimal object
 func (s *SyncAggregateMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SyncAggregateMinimal object with the memory of the allocator
+func (s *SyncAggregateMinimal) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 100 {
@@ -4139,9 +8861,9 @@ func (s *SyncAggregateMinimal) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'SyncCommiteeBits'
 	if cap(s.SyncCommiteeBits) == 0 {
-		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:4]))
+		s.SyncCommiteeBits = ssz.AllocBytes(alloc, len(buf[0:4]))[:0]
 	}
-	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:4]...)
+	s.SyncCommiteeBits = append(s.SyncCommiteeBits[:0], buf[0:4]...)
 
 	// Field (1) 'SyncCommiteeSignature'
 	copy(s.SyncCommiteeSignature[:], buf[4:100])
@@ -4149,12 +8871,29 @@ func (s *SyncAggregateMinimal) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SyncAggregateMinimal object at the start of the buffer and
+// returns the number of bytes consumed
+func (s *SyncAggregateMinimal) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 100 {
+		return 0, ssz.ErrSize
+	}
+	if err := s.UnmarshalSSZ(buf[:100]); err != nil {
+		return 0, err
+	}
+	return 100, nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SyncAggregateMinimal object
 func (s *SyncAggregateMinimal) SizeSSZ() (size int) {
 	size = 100
 	return
 }
 
+// SizeSSZSyncAggregateMinimal returns the ssz encoded size in bytes of any SyncAggregateMinimal object
+func SizeSSZSyncAggregateMinimal() int {
+	return 100
+}
+
 // HashTreeRoot ssz hashes the SyncAggregateMinimal object
 func (s *SyncAggregateMinimal) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -4163,6 +8902,7 @@ func (s *SyncAggregateMinimal) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SyncAggregateMinimal object with a hasher
 func (s *SyncAggregateMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'SyncCommiteeBits'
 	if len(s.SyncCommiteeBits) != 4 {
@@ -4178,18 +8918,101 @@ func (s *SyncAggregateMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockMinimal object
+// MerkleProof returns the merkle branch that proves the field against the root of the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "SyncCommiteeBits":
+		leaf = 0
+	case "SyncCommiteeSignature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if len(s.SyncCommiteeBits) != 4 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SyncAggregateMinimal object are zero
+func (s *SyncAggregateMinimal) IsZeroSSZ() bool {
+	// Field (0) 'SyncCommiteeBits'
+	for _, elem := range s.SyncCommiteeBits {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != [96]byte{} {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the SyncAggregateMinimal object into dst reusing the memory of dst
+func (s *SyncAggregateMinimal) CopyInto(dst *SyncAggregateMinimal) {
+	// Field (0) 'SyncCommiteeBits'
+	dst.SyncCommiteeBits = append(dst.SyncCommiteeBits[:0], s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst.SyncCommiteeSignature = s.SyncCommiteeSignature
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) SSZSchemaString() string {
+	return "Container(SyncCommiteeBits:Vector[byte,4],SyncCommiteeSignature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SyncAggregateMinimal",
+		Fields: []*ssz.SchemaField{
+			{Name: "SyncCommiteeBits", Type: "Vector[byte,4]", Size: 4, Fixed: true},
+			{Name: "SyncCommiteeSignature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockMinimal object to a new buffer owned by the caller
 func (s *SignedBeaconBlockMinimal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
+// MarshalSSZPooled ssz marshals the SignedBeaconBlockMinimal object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (s *SignedBeaconBlockMinimal) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(s)
+}
+
 // MarshalSSZTo ssz marshals the SignedBeaconBlockMinimal object to a target array
 func (s *SignedBeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(100)
 
 	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if s.Block == nil {
 		s.Block = new(BeaconBlockMinimal)
 	}
@@ -4212,6 +9035,11 @@ func (s *SignedBeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 // UnmarshalSSZ ssz unmarshals the SignedBeaconBlockMinimal object
 func (s *SignedBeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the SignedBeaconBlockMinimal object with the memory of the allocator
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 100 {
@@ -4226,29 +9054,39 @@ func (s *SignedBeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 100 {
+	if o0 != 100 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Signature'
 	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
+		s.Signature = ssz.AllocBytes(alloc, len(buf[4:100]))[:0]
 	}
-	s.Signature = append(s.Signature, buf[4:100]...)
+	s.Signature = append(s.Signature[:0], buf[4:100]...)
 
 	// Field (0) 'Block'
 	{
 		buf = tail[o0:]
 		if s.Block == nil {
-			s.Block = new(BeaconBlockMinimal)
+			s.Block = ssz.AllocNew[BeaconBlockMinimal](alloc)
 		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+		if err = s.Block.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the SignedBeaconBlockMinimal object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := s.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockMinimal object
 func (s *SignedBeaconBlockMinimal) SizeSSZ() (size int) {
 	size = 100
@@ -4270,6 +9108,7 @@ func (s *SignedBeaconBlockMinimal) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the SignedBeaconBlockMinimal object with a hasher
 func (s *SignedBeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Block'
 	if err = s.Block.HashTreeRootWith(hh); err != nil {
@@ -4287,11 +9126,101 @@ func (s *SignedBeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyMinimal object
+// MerkleProof returns the merkle branch that proves the field against the root of the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Block":
+		leaf = 0
+	case "Signature":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if len(s.Signature) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the SignedBeaconBlockMinimal object are zero
+func (s *SignedBeaconBlockMinimal) IsZeroSSZ() bool {
+	// Field (0) 'Block'
+	if s.Block != nil && !s.Block.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Signature'
+	for _, elem := range s.Signature {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the SignedBeaconBlockMinimal object into dst reusing the memory of dst
+func (s *SignedBeaconBlockMinimal) CopyInto(dst *SignedBeaconBlockMinimal) {
+	// Field (0) 'Block'
+	if s.Block == nil {
+		dst.Block = nil
+	} else {
+		if dst.Block == nil {
+			dst.Block = new(BeaconBlockMinimal)
+		}
+		s.Block.CopyInto(dst.Block)
+	}
+
+	// Field (1) 'Signature'
+	dst.Signature = append(dst.Signature[:0], s.Signature...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) SSZSchemaString() string {
+	return "Container(Block:BeaconBlockMinimal,Signature:Vector[byte,96])"
+}
+
+// SSZSchema returns the layout of the fields of the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "SignedBeaconBlockMinimal",
+		Fields: []*ssz.SchemaField{
+			{Name: "Block", Type: "BeaconBlockMinimal"},
+			{Name: "Signature", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+		},
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyMinimal object to a new buffer owned by the caller
 func (b *BeaconBlockBodyMinimal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconBlockBodyMinimal object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconBlockBodyMinimal) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconBlockBodyMinimal object to a target array
 func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -4315,29 +9244,39 @@ func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error
 	dst = append(dst, b.Graffiti[:]...)
 
 	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.ProposerSlashings) * 416
 
 	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
 		offset += 4
 		offset += b.AttesterSlashings[ii].SizeSSZ()
 	}
 
 	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	for ii := 0; ii < len(b.Attestations); ii++ {
 		offset += 4
 		offset += b.Attestations[ii].SizeSSZ()
 	}
 
 	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.Deposits) * 1240
 
 	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(b.VoluntaryExits) * 112
 
 	// Field (8) 'SyncAggregate'
@@ -4366,7 +9305,9 @@ func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error
 	{
 		offset = 4 * len(b.AttesterSlashings)
 		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
 			offset += b.AttesterSlashings[ii].SizeSSZ()
 		}
 	}
@@ -4384,7 +9325,9 @@ func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error
 	{
 		offset = 4 * len(b.Attestations)
 		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
 			offset += b.Attestations[ii].SizeSSZ()
 		}
 	}
@@ -4421,6 +9364,11 @@ func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlockBodyMinimal object
 func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconBlockBodyMinimal object with the memory of the allocator
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 320 {
@@ -4432,15 +9380,15 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		b.RandaoReveal = ssz.AllocBytes(alloc, len(buf[0:96]))[:0]
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	b.RandaoReveal = append(b.RandaoReveal[:0], buf[0:96]...)
 
 	// Field (1) 'Eth1Data'
 	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+		b.Eth1Data = ssz.AllocNew[Eth1Data](alloc)
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+	if err = b.Eth1Data.UnmarshalSSZArena(buf[96:168], alloc); err != nil {
 		return err
 	}
 
@@ -4452,7 +9400,7 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 320 {
+	if o3 != 320 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4478,9 +9426,9 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 
 	// Field (8) 'SyncAggregate'
 	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregateMinimal)
+		b.SyncAggregate = ssz.AllocNew[SyncAggregateMinimal](alloc)
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:320]); err != nil {
+	if err = b.SyncAggregate.UnmarshalSSZArena(buf[220:320], alloc); err != nil {
 		return err
 	}
 
@@ -4491,12 +9439,12 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		b.ProposerSlashings = ssz.AllocExtend(alloc, b.ProposerSlashings, num)
 		for ii := 0; ii < num; ii++ {
 			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
+				b.ProposerSlashings[ii] = ssz.AllocNew[ProposerSlashing](alloc)
 			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+			if err = b.ProposerSlashings[ii].UnmarshalSSZArena(buf[ii*416:(ii+1)*416], alloc); err != nil {
 				return err
 			}
 		}
@@ -4509,12 +9457,12 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		b.AttesterSlashings = ssz.AllocExtend(alloc, b.AttesterSlashings, num)
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
 			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
+				b.AttesterSlashings[indx] = ssz.AllocNew[AttesterSlashing](alloc)
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+			if err = b.AttesterSlashings[indx].UnmarshalSSZArena(buf, alloc); err != nil {
 				return err
 			}
 			return nil
@@ -4531,94 +9479,246 @@ func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
+		b.Attestations = ssz.AllocExtend(alloc, b.Attestations, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = ssz.AllocNew[Attestation](alloc)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZArena(buf, alloc); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = ssz.AllocExtend(alloc, b.Deposits, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = ssz.AllocNew[Deposit](alloc)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZArena(buf[ii*1240:(ii+1)*1240], alloc); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = ssz.AllocExtend(alloc, b.VoluntaryExits, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = ssz.AllocNew[SignedVoluntaryExit](alloc)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZArena(buf[ii*112:(ii+1)*112], alloc); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconBlockBodyMinimal object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) SizeSSZ() (size int) {
+	size = 320
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyMinimal object with a hasher
+func (b *BeaconBlockBodyMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(9)
+
+	// Field (0) 'RandaoReveal'
+	if len(b.RandaoReveal) != 96 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data != nil {
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
 
 	// Field (6) 'Deposits'
 	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
-			return err
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
 		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
 	// Field (7) 'VoluntaryExits'
 	{
-		buf = tail[o7:]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
 		}
-	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) SizeSSZ() (size int) {
-	size = 320
-
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
-
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate != nil {
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
-
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "RandaoReveal":
+		leaf = 0
+	case "Eth1Data":
+		leaf = 1
+	case "Graffiti":
+		leaf = 2
+	case "ProposerSlashings":
+		leaf = 3
+	case "AttesterSlashings":
+		leaf = 4
+	case "Attestations":
+		leaf = 5
+	case "Deposits":
+		leaf = 6
+	case "VoluntaryExits":
+		leaf = 7
+	case "SyncAggregate":
+		leaf = 8
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyMinimal object with a hasher
-func (b *BeaconBlockBodyMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -4725,25 +9825,225 @@ func (b *BeaconBlockBodyMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	hh.Merkleize(indx)
-	return
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconBlockBodyMinimal object are zero
+func (b *BeaconBlockBodyMinimal) IsZeroSSZ() bool {
+	// Field (0) 'RandaoReveal'
+	for _, elem := range b.RandaoReveal {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data != nil && !b.Eth1Data.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (2) 'Graffiti'
+	if b.Graffiti != [32]byte{} {
+		return false
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if len(b.ProposerSlashings) != 0 {
+		return false
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if len(b.AttesterSlashings) != 0 {
+		return false
+	}
+
+	// Field (5) 'Attestations'
+	if len(b.Attestations) != 0 {
+		return false
+	}
+
+	// Field (6) 'Deposits'
+	if len(b.Deposits) != 0 {
+		return false
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if len(b.VoluntaryExits) != 0 {
+		return false
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate != nil && !b.SyncAggregate.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconBlockBodyMinimal object into dst reusing the memory of dst
+func (b *BeaconBlockBodyMinimal) CopyInto(dst *BeaconBlockBodyMinimal) {
+	// Field (0) 'RandaoReveal'
+	dst.RandaoReveal = append(dst.RandaoReveal[:0], b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		dst.Eth1Data = nil
+	} else {
+		if dst.Eth1Data == nil {
+			dst.Eth1Data = new(Eth1Data)
+		}
+		b.Eth1Data.CopyInto(dst.Eth1Data)
+	}
+
+	// Field (2) 'Graffiti'
+	dst.Graffiti = b.Graffiti
+
+	// Field (3) 'ProposerSlashings'
+	if cap(dst.ProposerSlashings) < len(b.ProposerSlashings) {
+		dst.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+	} else {
+		dst.ProposerSlashings = dst.ProposerSlashings[:len(b.ProposerSlashings)]
+	}
+	for ii := range b.ProposerSlashings {
+		if b.ProposerSlashings[ii] == nil {
+			dst.ProposerSlashings[ii] = nil
+		} else {
+			if dst.ProposerSlashings[ii] == nil {
+				dst.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			b.ProposerSlashings[ii].CopyInto(dst.ProposerSlashings[ii])
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if cap(dst.AttesterSlashings) < len(b.AttesterSlashings) {
+		dst.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+	} else {
+		dst.AttesterSlashings = dst.AttesterSlashings[:len(b.AttesterSlashings)]
+	}
+	for ii := range b.AttesterSlashings {
+		if b.AttesterSlashings[ii] == nil {
+			dst.AttesterSlashings[ii] = nil
+		} else {
+			if dst.AttesterSlashings[ii] == nil {
+				dst.AttesterSlashings[ii] = new(AttesterSlashing)
+			}
+			b.AttesterSlashings[ii].CopyInto(dst.AttesterSlashings[ii])
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if cap(dst.Attestations) < len(b.Attestations) {
+		dst.Attestations = make([]*Attestation, len(b.Attestations))
+	} else {
+		dst.Attestations = dst.Attestations[:len(b.Attestations)]
+	}
+	for ii := range b.Attestations {
+		if b.Attestations[ii] == nil {
+			dst.Attestations[ii] = nil
+		} else {
+			if dst.Attestations[ii] == nil {
+				dst.Attestations[ii] = new(Attestation)
+			}
+			b.Attestations[ii].CopyInto(dst.Attestations[ii])
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if cap(dst.Deposits) < len(b.Deposits) {
+		dst.Deposits = make([]*Deposit, len(b.Deposits))
+	} else {
+		dst.Deposits = dst.Deposits[:len(b.Deposits)]
+	}
+	for ii := range b.Deposits {
+		if b.Deposits[ii] == nil {
+			dst.Deposits[ii] = nil
+		} else {
+			if dst.Deposits[ii] == nil {
+				dst.Deposits[ii] = new(Deposit)
+			}
+			b.Deposits[ii].CopyInto(dst.Deposits[ii])
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if cap(dst.VoluntaryExits) < len(b.VoluntaryExits) {
+		dst.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+	} else {
+		dst.VoluntaryExits = dst.VoluntaryExits[:len(b.VoluntaryExits)]
+	}
+	for ii := range b.VoluntaryExits {
+		if b.VoluntaryExits[ii] == nil {
+			dst.VoluntaryExits[ii] = nil
+		} else {
+			if dst.VoluntaryExits[ii] == nil {
+				dst.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			b.VoluntaryExits[ii].CopyInto(dst.VoluntaryExits[ii])
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		dst.SyncAggregate = nil
+	} else {
+		if dst.SyncAggregate == nil {
+			dst.SyncAggregate = new(SyncAggregateMinimal)
+		}
+		b.SyncAggregate.CopyInto(dst.SyncAggregate)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) SSZSchemaString() string {
+	return "Container(RandaoReveal:Vector[byte,96],Eth1Data:Eth1Data,Graffiti:Vector[byte,32],ProposerSlashings:List[ProposerSlashing,16],AttesterSlashings:List[AttesterSlashing,2],Attestations:List[Attestation,128],Deposits:List[Deposit,16],VoluntaryExits:List[SignedVoluntaryExit,16],SyncAggregate:SyncAggregateMinimal)"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconBlockBodyMinimal",
+		Fields: []*ssz.SchemaField{
+			{Name: "RandaoReveal", Type: "Vector[byte,96]", Size: 96, Fixed: true},
+			{Name: "Eth1Data", Type: "Eth1Data", Size: 72, Fixed: true},
+			{Name: "Graffiti", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "ProposerSlashings", Type: "List[ProposerSlashing,16]"},
+			{Name: "AttesterSlashings", Type: "List[AttesterSlashing,2]"},
+			{Name: "Attestations", Type: "List[Attestation,128]"},
+			{Name: "Deposits", Type: "List[Deposit,16]"},
+			{Name: "VoluntaryExits", Type: "List[SignedVoluntaryExit,16]"},
+			{Name: "SyncAggregate", Type: "SyncAggregateMinimal", Size: 100, Fixed: true},
+		},
+	}
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockMinimal object
+// MarshalSSZ ssz marshals the BeaconBlockMinimal object to a new buffer owned by the caller
 func (b *BeaconBlockMinimal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
+// MarshalSSZPooled ssz marshals the BeaconBlockMinimal object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BeaconBlockMinimal) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
 // MarshalSSZTo ssz marshals the BeaconBlockMinimal object to a target array
 func (b *BeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(84)
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	{
+		dst = append(dst, make([]byte, 16)...)
+		fixed := dst[len(dst)-16:]
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+		// Field (0) 'Slot'
+		ssz.PutUint64(fixed[0:8], b.Slot)
+
+		// Field (1) 'ProposerIndex'
+		ssz.PutUint64(fixed[8:16], b.ProposerIndex)
+	}
 
 	// Field (2) 'ParentRoot'
 	if len(b.ParentRoot) != 32 {
@@ -4760,7 +10060,9 @@ func (b *BeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = append(dst, b.StateRoot...)
 
 	// Offset (4) 'Body'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	if b.Body == nil {
 		b.Body = new(BeaconBlockBodyMinimal)
 	}
@@ -4776,6 +10078,11 @@ func (b *BeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlockMinimal object
 func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BeaconBlockMinimal object with the memory of the allocator
+func (b *BeaconBlockMinimal) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 84 {
@@ -4793,22 +10100,22 @@ func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
 
 	// Field (2) 'ParentRoot'
 	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+		b.ParentRoot = ssz.AllocBytes(alloc, len(buf[16:48]))[:0]
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+	b.ParentRoot = append(b.ParentRoot[:0], buf[16:48]...)
 
 	// Field (3) 'StateRoot'
 	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+		b.StateRoot = ssz.AllocBytes(alloc, len(buf[48:80]))[:0]
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+	b.StateRoot = append(b.StateRoot[:0], buf[48:80]...)
 
 	// Offset (4) 'Body'
 	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
+	if o4 != 84 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4816,15 +10123,25 @@ func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o4:]
 		if b.Body == nil {
-			b.Body = new(BeaconBlockBodyMinimal)
+			b.Body = ssz.AllocNew[BeaconBlockBodyMinimal](alloc)
 		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+		if err = b.Body.UnmarshalSSZArena(buf, alloc); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the BeaconBlockMinimal object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BeaconBlockMinimal) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockMinimal object
 func (b *BeaconBlockMinimal) SizeSSZ() (size int) {
 	size = 84
@@ -4846,6 +10163,7 @@ func (b *BeaconBlockMinimal) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the BeaconBlockMinimal object with a hasher
 func (b *BeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(5)
 
 	// Field (0) 'Slot'
 	hh.PutUint64(b.Slot)
@@ -4875,3 +10193,135 @@ func (b *BeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	hh.Merkleize(indx)
 	return
 }
+
+// MerkleProof returns the merkle branch that proves the field against the root of the BeaconBlockMinimal object
+func (b *BeaconBlockMinimal) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Slot":
+		leaf = 0
+	case "ProposerIndex":
+		leaf = 1
+	case "ParentRoot":
+		leaf = 2
+	case "StateRoot":
+		leaf = 3
+	case "Body":
+		leaf = 4
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if len(b.ParentRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if len(b.StateRoot) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BeaconBlockMinimal object are zero
+func (b *BeaconBlockMinimal) IsZeroSSZ() bool {
+	// Field (0) 'Slot'
+	if b.Slot != 0 {
+		return false
+	}
+
+	// Field (1) 'ProposerIndex'
+	if b.ProposerIndex != 0 {
+		return false
+	}
+
+	// Field (2) 'ParentRoot'
+	for _, elem := range b.ParentRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (3) 'StateRoot'
+	for _, elem := range b.StateRoot {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (4) 'Body'
+	if b.Body != nil && !b.Body.IsZeroSSZ() {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BeaconBlockMinimal object into dst reusing the memory of dst
+func (b *BeaconBlockMinimal) CopyInto(dst *BeaconBlockMinimal) {
+	// Field (0) 'Slot'
+	dst.Slot = b.Slot
+
+	// Field (1) 'ProposerIndex'
+	dst.ProposerIndex = b.ProposerIndex
+
+	// Field (2) 'ParentRoot'
+	dst.ParentRoot = append(dst.ParentRoot[:0], b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	dst.StateRoot = append(dst.StateRoot[:0], b.StateRoot...)
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		dst.Body = nil
+	} else {
+		if dst.Body == nil {
+			dst.Body = new(BeaconBlockBodyMinimal)
+		}
+		b.Body.CopyInto(dst.Body)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BeaconBlockMinimal object
+func (b *BeaconBlockMinimal) SSZSchemaString() string {
+	return "Container(Slot:uint64,ProposerIndex:uint64,ParentRoot:Vector[byte,32],StateRoot:Vector[byte,32],Body:BeaconBlockBodyMinimal)"
+}
+
+// SSZSchema returns the layout of the fields of the BeaconBlockMinimal object
+func (b *BeaconBlockMinimal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BeaconBlockMinimal",
+		Fields: []*ssz.SchemaField{
+			{Name: "Slot", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ProposerIndex", Type: "uint64", Size: 8, Fixed: true},
+			{Name: "ParentRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "StateRoot", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "Body", Type: "BeaconBlockBodyMinimal"},
+		},
+	}
+}
@@ -12,9 +12,9 @@ import (
 	"strings"
 	"testing"
 
-	ssz "github.com/ferranbt/fastssz"
-	"github.com/ferranbt/fastssz/fuzz"
 	"github.com/golang/snappy"
+	ssz "github.com/photon-storage/fastssz"
+	"github.com/photon-storage/fastssz/fuzz"
 
 	"gopkg.in/yaml.v2"
 )
@@ -293,7 +293,6 @@ func TestSpecMinimal(t *testing.T) {
 		base, ok := codecs[name]
 		if !ok {
 			continue
-			t.Fatalf("name %s not found", name)
 		}
 
 		t.Logf("Process %s %s", name, f)
@@ -315,7 +314,6 @@ func TestSpecMainnet(t *testing.T) {
 		base, ok := codecs[name]
 		if !ok {
 			continue
-			t.Fatalf("name %s not found", name)
 		}
 
 		t.Logf("Process %s %s", name, f)
@@ -0,0 +1,16 @@
+package main
+
+// interfaceAssertions creates the compile time assertions that the struct implements
+// the ssz interfaces with the generated methods
+func (e *env) interfaceAssertions(name string) string {
+	tmpl := `var (
+		_ ssz.Marshaler        = (*{{.name}})(nil)
+		_ ssz.PooledMarshaler  = (*{{.name}})(nil)
+		_ ssz.Unmarshaler      = (*{{.name}})(nil)
+		_ ssz.ArenaUnmarshaler = (*{{.name}})(nil)
+		_ ssz.HashRoot         = (*{{.name}})(nil)
+	)`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+	})
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// bitfieldList is the kind of the ssz-bitlist tag
+	bitfieldList = "bitlist"
+	// bitfieldVector is the kind of the ssz-bitvector tag
+	bitfieldVector = "bitvector"
+)
+
+// bitfieldTags returns the kind of the ssz-bitlist or ssz-bitvector tag of the field and
+// the tags with the equivalent ssz tags (i.e. 'ssz:"bitlist" ssz-max:"N"'), which are
+// parsed as any other bitlist or bitvector. The kind is empty if the field has neither.
+func bitfieldTags(name, tags string) (string, string, error) {
+	kind, size := "", ""
+	for _, k := range []string{bitfieldList, bitfieldVector} {
+		tag, ok := getTags(tags, "ssz-"+k)
+		if !ok {
+			continue
+		}
+		if kind != "" {
+			return "", "", fmt.Errorf("field %s cannot have both the ssz-bitlist and ssz-bitvector tags", name)
+		}
+		if n, err := strconv.ParseUint(tag, 10, 64); err != nil || n == 0 {
+			return "", "", fmt.Errorf("ssz-%s requires a positive number of bits, field %s", k, name)
+		}
+		kind, size = k, tag
+	}
+	if kind == "" {
+		return "", tags, nil
+	}
+	for _, k := range []string{"ssz", "ssz-size", "ssz-max"} {
+		if _, ok := getTags(tags, k); ok {
+			return "", "", fmt.Errorf("ssz-%s cannot be used with the %s tag, field %s", kind, k, name)
+		}
+	}
+	if kind == bitfieldList {
+		return kind, strings.TrimSuffix(tags, "`") + fmt.Sprintf(` ssz:"bitlist" ssz-max:"%s"`+"`", size), nil
+	}
+	return kind, strings.TrimSuffix(tags, "`") + fmt.Sprintf(` ssz:"bitvector" ssz-size:"%s"`+"`", size), nil
+}
+
+// bitfieldTypes creates the types with the bit accessors and the ssz codec of the bitlist and
+// bitvector fields of the container (ssz-bitlist and ssz-bitvector). The type of the field X
+// of T is TX, the field is a []byte and it is converted to access the bits (i.e. TX(t.X)).
+func (e *env) bitfieldTypes(name string, v *Value) string {
+	out := []string{}
+	for _, f := range v.o {
+		if f.bitfield == "" {
+			continue
+		}
+		var str string
+		if f.t == TypeBitList {
+			str = f.bitlistType(name)
+		} else {
+			str = f.bitvectorType(name)
+		}
+		out = append(out, e.appendObjSignature(str, &Value{name: f.bitfield}))
+	}
+	return strings.Join(out, "\n\n")
+}
+
+func (v *Value) bitlistType(obj string) string {
+	tmpl := `// {{.type}} is the bitlist of up to {{.max}} bits of the field {{.name}} of {{.obj}}.
+	// The last bit set is the length bit that follows the bits of the bitlist.
+	type {{.type}} []byte
+
+	// New{{.type}} returns a {{.type}} of length bits set to zero
+	func New{{.type}}(length uint64) {{.type}} {
+		return ssz.NewBitlist(length)
+	}
+
+	// Len returns the number of bits of the bitlist
+	func (:: {{.type}}) Len() uint64 {
+		return ssz.BitlistLen(::)
+	}
+
+	// Get returns the bit at indx or false if it is beyond the length of the bitlist
+	func (:: {{.type}}) Get(indx uint64) bool {
+		if indx >= ::.Len() {
+			return false
+		}
+		return ssz.BitAt(::, indx)
+	}
+
+	// Set sets the bit at indx, the bits beyond the length of the bitlist are not set
+	func (:: {{.type}}) Set(indx uint64, val bool) {
+		if indx >= ::.Len() {
+			return
+		}
+		ssz.SetBitAt(::, indx, val)
+	}
+
+	// Count returns the number of bits of the bitlist that are set
+	func (:: {{.type}}) Count() uint64 {
+		return ssz.BitlistCount(::)
+	}
+
+	// SizeSSZ returns the ssz encoded size in bytes of the {{.type}} bitlist
+	func (:: {{.type}}) SizeSSZ() int {
+		return len(::)
+	}
+
+	// MarshalSSZ ssz marshals the {{.type}} bitlist to a new buffer owned by the caller
+	func (:: {{.type}}) MarshalSSZ() ([]byte, error) {
+		return ssz.MarshalSSZ(::)
+	}
+
+	// MarshalSSZTo ssz marshals the {{.type}} bitlist to a target array
+	func (:: {{.type}}) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+		dst = buf
+		if err = ssz.ValidateBitlist(::, {{.max}}); err != nil {
+			return
+		}
+		dst = append(dst, ::...)
+		return
+	}
+
+	// UnmarshalSSZ ssz unmarshals the {{.type}} bitlist
+	func (:: *{{.type}}) UnmarshalSSZ(buf []byte) error {
+		if err := ssz.ValidateBitlist(buf, {{.max}}); err != nil {
+			return err
+		}
+		*:: = append((*::)[:0], buf...)
+		return nil
+	}
+
+	// HashTreeRoot ssz hashes the {{.type}} bitlist
+	func (:: {{.type}}) HashTreeRoot() ([32]byte, error) {
+		return ssz.HashWithDefaultHasher(::)
+	}
+
+	// HashTreeRootWith ssz hashes the {{.type}} bitlist with a hasher
+	func (:: {{.type}}) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+		if len(::) == 0 {
+			return ssz.ErrEmptyBitlist
+		}
+		hh.PutBitlist(::, {{.max}})
+		return
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"type": v.bitfield,
+		"obj":  obj,
+		"name": v.name,
+		"max":  v.m,
+	})
+}
+
+func (v *Value) bitvectorType(obj string) string {
+	tmpl := `// {{.type}} is the bitvector of {{.bits}} bits of the field {{.name}} of {{.obj}}
+	type {{.type}} []byte
+
+	// New{{.type}} returns a {{.type}} with all its bits set to zero
+	func New{{.type}}() {{.type}} {
+		return make({{.type}}, {{.size}})
+	}
+
+	// Len returns the number of bits of the bitvector
+	func (:: {{.type}}) Len() uint64 {
+		return {{.bits}}
+	}
+
+	// Get returns the bit at indx or false if it is beyond the length of the bitvector
+	func (:: {{.type}}) Get(indx uint64) bool {
+		if indx >= {{.bits}} || indx/8 >= uint64(len(::)) {
+			return false
+		}
+		return ssz.BitAt(::, indx)
+	}
+
+	// Set sets the bit at indx, the bits beyond the length of the bitvector are not set
+	func (:: {{.type}}) Set(indx uint64, val bool) {
+		if indx >= {{.bits}} || indx/8 >= uint64(len(::)) {
+			return
+		}
+		ssz.SetBitAt(::, indx, val)
+	}
+
+	// Count returns the number of bits of the bitvector that are set
+	func (:: {{.type}}) Count() uint64 {
+		return ssz.CountBits(::)
+	}
+
+	// SizeSSZ returns the ssz encoded size in bytes of the {{.type}} bitvector
+	func (:: {{.type}}) SizeSSZ() int {
+		return {{.size}}
+	}
+
+	// MarshalSSZ ssz marshals the {{.type}} bitvector to a new buffer owned by the caller
+	func (:: {{.type}}) MarshalSSZ() ([]byte, error) {
+		return ssz.MarshalSSZ(::)
+	}
+
+	// MarshalSSZTo ssz marshals the {{.type}} bitvector to a target array
+	func (:: {{.type}}) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+		dst = buf
+		if len(::) != {{.size}} {
+			err = ssz.ErrBytesLength
+			return
+		}
+		{{if .trailing}}if ::[{{.last}}]>>{{.trailing}} != 0 {
+			err = ssz.ErrInvalidBitvector
+			return
+		}
+		{{end}}dst = append(dst, ::...)
+		return
+	}
+
+	// UnmarshalSSZ ssz unmarshals the {{.type}} bitvector
+	func (:: *{{.type}}) UnmarshalSSZ(buf []byte) error {
+		if len(buf) != {{.size}} {
+			return ssz.ErrSize
+		}
+		{{if .trailing}}if buf[{{.last}}]>>{{.trailing}} != 0 {
+			return ssz.ErrInvalidBitvector
+		}
+		{{end}}*:: = append((*::)[:0], buf...)
+		return nil
+	}
+
+	// HashTreeRoot ssz hashes the {{.type}} bitvector
+	func (:: {{.type}}) HashTreeRoot() ([32]byte, error) {
+		return ssz.HashWithDefaultHasher(::)
+	}
+
+	// HashTreeRootWith ssz hashes the {{.type}} bitvector with a hasher
+	func (:: {{.type}}) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+		if len(::) != {{.size}} {
+			return ssz.ErrBytesLength
+		}
+		hh.PutBytes(::)
+		return
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"type":     v.bitfield,
+		"obj":      obj,
+		"name":     v.name,
+		"bits":     v.bits,
+		"size":     v.s,
+		"last":     v.s - 1,
+		"trailing": v.bits % 8,
+	})
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/build/constraint"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// matchBuildTags returns true if the build constraints of the file (and the GOOS and
+// GOARCH suffixes of its name) are satisfied with the given build tags
+func matchBuildTags(name string, tags []string) (bool, error) {
+	ctx := build.Default
+	ctx.BuildTags = tags
+	return ctx.MatchFile(filepath.Dir(name), filepath.Base(name))
+}
+
+// readConstraint returns the build constraint of the Go file or nil if it does not have one.
+// The old '// +build' lines are only used if the file does not have a '//go:build' line.
+func readConstraint(name string) (constraint.Expr, error) {
+	src, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var plusBuild constraint.Expr
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "//") {
+			// the constraints are only in the comments before the package clause
+			break
+		}
+		if constraint.IsGoBuild(line) {
+			expr, err := constraint.Parse(line)
+			if err != nil {
+				return nil, fmt.Errorf("bad build constraint in %s: %v", name, err)
+			}
+			return expr, nil
+		}
+		if constraint.IsPlusBuild(line) {
+			expr, err := constraint.Parse(line)
+			if err != nil {
+				return nil, fmt.Errorf("bad build constraint in %s: %v", name, err)
+			}
+			plusBuild = andConstraint(plusBuild, expr)
+		}
+	}
+	return plusBuild, scanner.Err()
+}
+
+// readConstraints returns the build constraints of the files that have one
+func readConstraints(files ...map[string]*ast.File) (map[string]constraint.Expr, error) {
+	constraints := map[string]constraint.Expr{}
+	for _, parsed := range files {
+		for name := range parsed {
+			if name == stdinName {
+				continue
+			}
+			expr, err := readConstraint(name)
+			if err != nil {
+				return nil, err
+			}
+			if expr != nil {
+				constraints[name] = expr
+			}
+		}
+	}
+	return constraints, nil
+}
+
+func andConstraint(x, y constraint.Expr) constraint.Expr {
+	if x == nil {
+		return y
+	}
+	if x.String() == y.String() {
+		return x
+	}
+	return &constraint.AndExpr{X: x, Y: y}
+}
+
+// buildConstraint returns the '//go:build' line of the file generated for the objects, which
+// requires the constraints of all the files that declare them so that the methods of different
+// variants of the same type (i.e. '//go:build mainnet' and '//go:build minimal') do not collide.
+func (e *env) buildConstraint(order []string) string {
+	objs := map[string]bool{}
+	for _, name := range order {
+		objs[name] = true
+	}
+	files := make([]string, 0, len(e.order))
+	for file, names := range e.order {
+		for _, name := range names {
+			if objs[name] {
+				files = append(files, file)
+				break
+			}
+		}
+	}
+	// the constraints are joined in the same order at each run
+	sort.Strings(files)
+
+	var expr constraint.Expr
+	seen := map[string]bool{}
+	for _, file := range files {
+		if c, ok := e.constraints[file]; ok && !seen[c.String()] {
+			seen[c.String()] = true
+			expr = andConstraint(expr, c)
+		}
+	}
+	if expr == nil {
+		return ""
+	}
+	return "//go:build " + expr.String()
+}
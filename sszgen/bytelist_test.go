@@ -1 +1,28 @@
 package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestByteListHashTreeRoot(t *testing.T) {
+	e := newTestEnv(t, `package test
+	type Obj struct {
+		Data []byte `+"`ssz-max:\"1000\"`"+`
+	}`)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	str := e.hashTreeRoot("Obj", e.objs["Obj"])
+
+	// the bytes are packed in ceil(1000/32) chunks (not merkleized twice with PutBytes)
+	// and the length in bytes is mixed in
+	for _, expected := range []string{
+		"hh.AppendBytes32(o.Data)",
+		"hh.MerkleizeWithMixin(elemIndx, byteLen, (1000+31)/32)",
+	} {
+		if !strings.Contains(str, expected) {
+			t.Fatalf("expected %s in the generated code", expected)
+		}
+	}
+}
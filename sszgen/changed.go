@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// absPath returns the absolute path of the file to compare the paths of the
+// changed files with the ones of the parsed files
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	return abs
+}
+
+// isChanged returns true if the output of the objects of the file has to be generated,
+// either because the file or the file of any of the local objects they use has changed.
+// All the outputs are generated if the changed files are not set.
+func (e *env) isChanged(file string, order []string) bool {
+	if e.changed == nil {
+		return true
+	}
+	if e.changed[absPath(file)] {
+		return true
+	}
+
+	// the files where the local objects are declared
+	files := map[string]string{}
+	for name, objs := range e.order {
+		for _, obj := range objs {
+			files[obj] = name
+		}
+	}
+	for _, name := range order {
+		obj, ok := e.objs[name]
+		if !ok {
+			continue
+		}
+		for _, dep := range obj.localObjs() {
+			if depFile, ok := files[dep]; ok && e.changed[absPath(depFile)] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// localObjs returns the local objects used by the value, including the nested ones
+func (v *Value) localObjs() []string {
+	objs := []string{}
+	if v.obj != "" && v.ref == "" {
+		objs = append(objs, v.obj)
+	}
+	if v.wrapper != "" {
+		objs = append(objs, v.wrapper)
+	}
+	for _, i := range v.o {
+		objs = append(objs, i.localObjs()...)
+	}
+	if v.e != nil {
+		objs = append(objs, v.e.localObjs()...)
+	}
+	return objs
+}
+
+// skipOutput returns true if the output is not generated because the files
+// it derives from have not changed and it already exists
+func (e *env) skipOutput(output, file string, order []string) bool {
+	if e.isChanged(file, order) {
+		return false
+	}
+	_, err := os.Stat(output)
+	return err == nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+)
+
+// compatTestPrefix is the suffix of the generated file with the compatibility tests
+const compatTestPrefix = "_compat_test.go"
+
+// printCompatTests creates a test file that compares the encoding and the root of random
+// objects with the ones of a reference library. The package of the reference library
+// (or an adapter of it) must have the API of go-ssz:
+//
+//	func Marshal(obj interface{}) ([]byte, error)
+//	func HashTreeRoot(obj interface{}) ([32]byte, error)
+//
+// The objects are populated with the same functions as the test vectors, which are
+// only included if the test vectors are not generated.
+func (e *env) printCompatTests(order []string) (string, bool, error) {
+	hash, err := e.hashSource()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to hash files: %v", err)
+	}
+
+	tmpl := `// Code generated by fastssz. DO NOT EDIT.
+	// Hash: {{.hash}}
+	{{if .build}}{{.build}}
+
+	{{end}}{{if .nolint}}{{.nolint}}
+	{{end}}package {{.package}}
+
+	import (
+		"math/rand"
+		"testing"
+
+		ssz "github.com/photon-storage/fastssz"
+		compat "{{.compat}}" {{ if .imports }}{{ range $value := .imports }}
+			{{ $value }} {{ end }}
+		{{ end }}
+	)
+
+	{{ range .objs }}
+		{{ . }}
+	{{ end }}
+	`
+
+	data := map[string]interface{}{
+		"package": e.packName,
+		"hash":    hash,
+		"nolint":  e.nolint,
+		"build":   e.buildConstraint(order),
+		"compat":  e.compatTest,
+	}
+
+	objs := []string{}
+	imports := []string{}
+	for _, name := range order {
+		obj, ok := e.printableObj(name)
+		if !ok {
+			continue
+		}
+		imports = appendWithoutRepeated(imports, detectImports(obj))
+		objs = append(objs, e.compatTestObj(name, obj))
+	}
+	if len(objs) == 0 {
+		return "", false, nil
+	}
+	data["objs"] = objs
+
+	importsStr, err := e.buildImports(imports)
+	if err != nil {
+		return "", false, err
+	}
+	if len(importsStr) != 0 {
+		data["imports"] = importsStr
+	}
+	return execTmpl(tmpl, data), true, nil
+}
+
+func (e *env) compatTestObj(name string, v *Value) string {
+	tmpl := `// TestSSZCompat{{.name}} compares the encoding and the root of random {{.name}}
+	// objects with the ones of the reference library
+	func TestSSZCompat{{.name}}(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < {{.cases}}; i++ {
+			obj := new({{.name}})
+			fill{{.name}}SSZ(obj, rnd)
+			if err := ssz.CompareReference(obj, compat.Marshal, compat.HashTreeRoot); err != nil {
+				t.Fatalf("case %d: %v", i, err)
+			}
+		}
+	}
+
+	{{if .fill}}{{.fill}}{{end}}`
+
+	fill := ""
+	if !e.testVectors {
+		fill = e.fillObj(name, v)
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"cases": testVectorsCases,
+		"fill":  fill,
+	})
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// copyInto creates a function that copies the struct into an existing one reusing
+// the memory of the destination. The slices are truncated and appended to (which
+// reuses their capacity) and the pointers are only allocated if they are nil.
+func (e *env) copyInto(name string, v *Value) string {
+	tmpl := `// CopyInto copies the {{.name}} object into dst reusing the memory of dst
+	func (:: *{{.name}}) CopyInto(dst *{{.name}}) {
+		{{.copy}}
+	}`
+
+	out := []string{}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, i.name, i.copyInto(0)))
+	}
+	if v.ext != "" {
+		out = append(out, fmt.Sprintf("// Extension '%s'\ndst.%s = append(dst.%s[:0], ::.%s...)", v.ext, v.ext, v.ext, v.ext))
+	}
+	if v.treeCache != "" {
+		// the tree of dst does not match the copied fields
+		out = append(out, fmt.Sprintf("// Cached tree\ndst.%s = nil", v.treeCache))
+	}
+	if len(v.transient) != 0 {
+		// the fields that are not encoded are populated again in dst
+		hooks := []string{"// Transient fields"}
+		for _, hook := range v.transient {
+			hooks = append(hooks, fmt.Sprintf("dst.%s()", hook))
+		}
+		out = append(out, strings.Join(hooks, "\n"))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+		"copy": strings.Join(out, "\n\n"),
+	})
+	return e.appendObjSignature(str, v)
+}
+
+// copyInto returns the code to copy the value from the receiver into dst.
+// depth is used to name the index of nested collections.
+func (v *Value) copyInto(depth int) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.copyIntoContainer()
+
+	case TypeUint, TypeBool:
+		return fmt.Sprintf("dst.%s = ::.%s", v.name, v.name)
+
+	case TypePackedBools:
+		out := []string{}
+		for _, i := range v.o {
+			out = append(out, i.copyInto(depth))
+		}
+		return strings.Join(out, "\n")
+
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("dst.%s = ::.%s", v.name, v.name)
+		}
+		return fmt.Sprintf("dst.%s = append(dst.%s[:0], ::.%s...)", v.name, v.name, v.name)
+
+	case TypeVector, TypeList:
+		if v.e.isFlat() {
+			if v.c {
+				return fmt.Sprintf("dst.%s = ::.%s", v.name, v.name)
+			}
+			return fmt.Sprintf("dst.%s = append(dst.%s[:0], ::.%s...)", v.name, v.name, v.name)
+		}
+
+		indx := strings.Repeat("i", depth+2)
+		v.e.name = fmt.Sprintf("%s[%s]", v.name, indx)
+
+		tmpl := `{{if .resize}}if cap(dst.{{.name}}) < len(::.{{.name}}) {
+			dst.{{.name}} = make({{.type}}, len(::.{{.name}}))
+		} else {
+			dst.{{.name}} = dst.{{.name}}[:len(::.{{.name}})]
+		}
+		{{end}}for {{.indx}} := range ::.{{.name}} {
+			{{.copy}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":   v.name,
+			"resize": !v.c,
+			"type":   v.goType(),
+			"indx":   indx,
+			"copy":   v.e.copyInto(depth + 1),
+		})
+
+	case TypeCustom:
+		// the Go type of the field is not known, it is copied through its encoding
+		// and assigned if the encoding cannot be decoded
+		tmpl := `if err := dst.unmarshalField{{.name}}(::.marshalField{{.name}}(nil)); err != nil {
+			dst.{{.name}} = ::.{{.name}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+
+	case TypeReserved:
+		// the reserved bytes are not stored
+		return ""
+
+	case TypeUnion:
+		// the option is copied through its encoding and assigned if it cannot be encoded
+		tmpl := `if buf, err := ::.marshalUnion{{.name}}(nil); err != nil || dst.unmarshalUnion{{.name}}(buf, nil) != nil {
+			dst.{{.name}} = ::.{{.name}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+
+	default:
+		panic(fmt.Errorf("copy into not implemented for type %s", v.t.String()))
+	}
+}
+
+// isFlat returns true if the value does not hold any pointers or slices
+// and it can be copied with an assignment.
+func (v *Value) isFlat() bool {
+	switch v.t {
+	case TypeUint, TypeBool:
+		return true
+	case TypeBytes, TypeBitList:
+		return v.c
+	case TypeVector:
+		return v.c && v.e.isFlat()
+	default:
+		return false
+	}
+}
+
+func (v *Value) copyIntoContainer() string {
+	// only the structs of this package are known to have the CopyInto method,
+	// any other struct is copied with its own CopyInto if it has one.
+	if v.iface {
+		tmpl := `if obj, ok := ::.{{.name}}.(*{{.obj}}); ok && obj != nil {
+			dstObj, ok := dst.{{.name}}.(*{{.obj}})
+			if !ok || dstObj == nil {
+				dstObj = new({{.obj}})
+				dst.{{.name}} = dstObj
+			}
+			{{.copy}}
+		} else {
+			dst.{{.name}} = ::.{{.name}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"obj":  v.objRef(),
+			"copy": v.copyIntoObj("obj", "dstObj"),
+		})
+	}
+	if v.noPtr {
+		return v.copyIntoObj("&::."+v.name, "&dst."+v.name)
+	}
+
+	tmpl := `if ::.{{.name}} == nil {
+		dst.{{.name}} = nil
+	} else {
+		if dst.{{.name}} == nil {
+			dst.{{.name}} = new({{.obj}})
+		}
+		{{.copy}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": v.name,
+		"obj":  v.objRef(),
+		"copy": v.copyIntoObj("::."+v.name, "dst."+v.name),
+	})
+}
+
+// copyIntoObj copies the pointer src into the pointer dst. The structs without a
+// CopyInto method are copied with an assignment which shares their slices.
+func (v *Value) copyIntoObj(src, dst string) string {
+	if v.t == TypeContainer && v.ref == "" {
+		return fmt.Sprintf("%s.CopyInto(%s)", src, dst)
+	}
+	tmpl := `if obj, ok := interface{}({{.src}}).(interface{ CopyInto(*{{.obj}}) }); ok {
+		obj.CopyInto({{.dst}})
+	} else {
+		{{.dstValue}} = {{.srcValue}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"src":      src,
+		"dst":      dst,
+		"srcValue": deref(src),
+		"dstValue": deref(dst),
+		"obj":      v.objRef(),
+	})
+}
+
+// deref returns the value of the pointer expression (i.e. '&x.A' is 'x.A')
+func deref(ptr string) string {
+	if strings.HasPrefix(ptr, "&") {
+		return strings.TrimPrefix(ptr, "&")
+	}
+	return "*" + ptr
+}
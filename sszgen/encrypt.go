@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hasEncryptedFields returns true if any field of the struct has the 'ssz-encrypt' tag
+func (v *Value) hasEncryptedFields() bool {
+	for _, i := range v.o {
+		if i.encrypt {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalEncrypted creates the functions to encode and decode the struct with the fields
+// tagged with 'ssz-encrypt' encrypted by a ssz.Cipher. The fields are written in order,
+// the dynamic fields are prefixed with their length and the encrypted fields are replaced
+// with their ciphertext prefixed with its length. Note that this is not ssz.
+func (e *env) marshalEncrypted(name string, v *Value) string {
+	if !v.hasEncryptedFields() {
+		return ""
+	}
+
+	tmpl := `// MarshalSSZEncrypted marshals the {{.name}} object with the encrypted fields
+	func (:: *{{.name}}) MarshalSSZEncrypted(cipher ssz.Cipher) ([]byte, error) {
+		return ::.MarshalSSZEncryptedTo(nil, cipher)
+	}
+
+	// MarshalSSZEncryptedTo marshals the {{.name}} object with the encrypted fields to a target array
+	func (:: *{{.name}}) MarshalSSZEncryptedTo(buf []byte, cipher ssz.Cipher) (dst []byte, err error) {
+		dst = buf
+		{{.marshal}}
+		return
+	}
+
+	// UnmarshalSSZEncrypted unmarshals the {{.name}} object encoded with MarshalSSZEncrypted
+	func (:: *{{.name}}) UnmarshalSSZEncrypted(data []byte, cipher ssz.Cipher) error {
+		var err error
+		{{if .alloc}}var alloc ssz.Allocator
+		{{end}}{{.unmarshal}}
+		if len(data) != 0 {
+			return ssz.ErrSize
+		}
+		{{if .transient}}{{.transient}}
+		{{end}}return err
+	}`
+
+	marshal := []string{}
+	unmarshal := []string{}
+	for indx, i := range v.o {
+		if i.encrypt {
+			marshal = append(marshal, fmt.Sprintf("// Field (%d) '%s' (encrypted)\n%s\n", indx, i.name, i.marshalEncryptedField()))
+			unmarshal = append(unmarshal, fmt.Sprintf("// Field (%d) '%s' (encrypted)\n%s\n", indx, i.name, i.unmarshalEncryptedField()))
+		} else {
+			marshal = append(marshal, fmt.Sprintf("// Field (%d) '%s'\n{\n%s\n}\n", indx, i.name, i.marshalField()))
+			unmarshal = append(unmarshal, fmt.Sprintf("// Field (%d) '%s'\n{\n%s\n}\n", indx, i.name, i.unmarshalField()))
+		}
+	}
+	if v.ext != "" {
+		// the extension holds the plain bytes after the known fields
+		marshal = append(marshal, fmt.Sprintf("// Extension '%s'\ndst = append(dst, ::.%s...)\n", v.ext, v.ext))
+		unmarshal = append(unmarshal, fmt.Sprintf("// Extension '%s'\n::.%s = append(::.%s[:0], data...)\ndata = nil\n", v.ext, v.ext, v.ext))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"marshal":   strings.Join(marshal, "\n"),
+		"unmarshal": strings.Join(unmarshal, "\n"),
+		"transient": v.unmarshalTransient(),
+		// the fields are decoded with the default allocator
+		"alloc": allocRegexp.MatchString(strings.Join(unmarshal, "\n")),
+	})
+	return e.appendObjSignature(str, v)
+}
+
+func (v *Value) marshalEncryptedField() string {
+	// the plaintext is written after the space for the length of the ciphertext
+	tmpl := `{
+		start := len(dst)
+		dst = append(dst, 0, 0, 0, 0)
+		{{.marshal}}
+		if dst, err = ssz.EncryptField(dst, start, cipher); err != nil {
+			return
+		}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"marshal": v.marshal(),
+	})
+}
+
+func (v *Value) unmarshalEncryptedField() string {
+	tmpl := `{
+		var buf []byte
+		if buf, data, err = ssz.DecryptField(data, cipher); err != nil {
+			return err
+		}
+		{{if .fixed}}if len(buf) != {{.size}} {
+			return ssz.ErrSize
+		}
+		{{end}}{{.unmarshal}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"fixed":     v.isFixed(),
+		"size":      v.fixedSize(),
+		"unmarshal": v.unmarshal("buf"),
+	})
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// allocRegexp matches the uses of the allocator in the unmarshal code
+var allocRegexp = regexp.MustCompile(`\balloc\b`)
+
+// marshalFields creates the functions to encode and decode a subset of the fields of the struct.
+// The encoding starts with a bitvector where the bit i is set if the field i is present
+// followed by the present fields in order. Dynamic fields are prefixed with their length.
+func (e *env) marshalFields(name string, v *Value) string {
+	tmpl := `// MarshalFieldsSSZ ssz marshals the given fields of the {{.name}} object
+	func (:: *{{.name}}) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+		return ::.MarshalFieldsSSZTo(nil, fields...)
+	}
+
+	// MarshalFieldsSSZTo ssz marshals the given fields of the {{.name}} object to a target array
+	func (:: *{{.name}}) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+		present := make([]byte, {{.bitvectorSize}})
+		for _, field := range fields {
+			switch field {
+			{{.cases}}
+			default:
+				err = ssz.ErrUnknownField
+				return
+			}
+		}
+		dst = append(buf, present...)
+
+		{{.marshal}}
+		return
+	}
+
+	// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the {{.name}} object.
+	// The fields that are not present in the encoding are not modified.
+	func (:: *{{.name}}) UnmarshalFieldsSSZ(data []byte) error {
+		var err error
+		{{if .alloc}}var alloc ssz.Allocator
+		{{end}}		{{if .bitvectorSize}}if len(data) < {{.bitvectorSize}} {
+			return ssz.ErrSize
+		}
+		present := data[:{{.bitvectorSize}}]
+		data = data[{{.bitvectorSize}}:]
+		{{if .trailing}}
+		if present[{{.last}}]>>{{.trailing}} != 0 {
+			return ssz.ErrUnknownField
+		}
+		{{end}}
+		{{.unmarshal}}
+		{{end}}if len(data) != 0 {
+			return ssz.ErrSize
+		}
+		{{if .transient}}{{.transient}}
+		{{end}}return err
+	}`
+
+	num := len(v.o)
+	cases := []string{}
+	marshal := []string{}
+	unmarshal := []string{}
+	for indx, i := range v.o {
+		bit := fmt.Sprintf("present[%d]&(1<<%d) != 0", indx/8, indx%8)
+
+		for _, j := range i.packedFields() {
+			cases = append(cases, fmt.Sprintf("case \"%s\":\npresent[%d] |= 1 << %d", j.name, indx/8, indx%8))
+		}
+		marshal = append(marshal, fmt.Sprintf("// Field (%d) '%s'\nif %s {\n%s\n}\n", indx, i.name, bit, i.marshalField()))
+		unmarshal = append(unmarshal, fmt.Sprintf("// Field (%d) '%s'\nif %s {\n%s\n}\n", indx, i.name, bit, i.unmarshalField()))
+	}
+
+	data := map[string]interface{}{
+		"name":          name,
+		"bitvectorSize": (num + 7) / 8,
+		"last":          (num+7)/8 - 1,
+		"trailing":      num % 8,
+		"cases":         strings.Join(cases, "\n"),
+		"marshal":       strings.Join(marshal, "\n"),
+		"unmarshal":     strings.Join(unmarshal, "\n"),
+		"transient":     v.unmarshalTransient(),
+		// the fields are decoded with the default allocator
+		"alloc": allocRegexp.MatchString(strings.Join(unmarshal, "\n")),
+	}
+	str := execTmpl(tmpl, data)
+	return e.appendObjSignature(str, v)
+}
+
+func (v *Value) marshalField() string {
+	if v.isFixed() {
+		return v.marshal()
+	}
+	// dynamic fields are prefixed with their length
+	tmpl := `offset := 0
+	{{.size}}
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	{{.marshal}}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"size":    v.size("offset"),
+		"marshal": v.marshal(),
+	})
+}
+
+func (v *Value) unmarshalField() string {
+	tmpl := `{{if .fixed}}if len(data) < {{.size}} {
+		return ssz.ErrSize
+	}
+	buf := data[:{{.size}}]
+	data = data[{{.size}}:]
+	{{else}}if len(data) < 4 {
+		return ssz.ErrSize
+	}
+	size := ssz.ReadOffset(data)
+	if data = data[4:]; size > uint64(len(data)) {
+		return ssz.ErrOffset
+	}
+	buf := data[:size]
+	data = data[size:]
+	{{end}}{{.unmarshal}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"fixed":     v.isFixed(),
+		"size":      v.fixedSize(),
+		"unmarshal": v.unmarshal("buf"),
+	})
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// hasOffset returns true if the field is encoded with an offset in the fixed part of the
+// container. The framed fields (ssz-length-from) are dynamic but they are encoded in place
+// with the length of their length field.
+func (v *Value) hasOffset() bool {
+	return !v.isFixed() && v.lengthFrom == ""
+}
+
+// hasOffsets returns true if any field of the container is encoded with an offset
+func (v *Value) hasOffsets() bool {
+	for _, f := range v.o {
+		if f.hasOffset() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFramed checks the pairs of length (ssz-length-of) and framed (ssz-length-from) fields
+// of the container. The framed field is a []byte whose bytes are written in place, sized by
+// the length field that precedes it. Since its position is not known from the fixed part,
+// the container cannot have other dynamic fields.
+func (v *Value) checkFramed() error {
+	framed := ""
+	for indx, f := range v.o {
+		if f.lengthOf != "" {
+			if f.t != TypeUint {
+				return fmt.Errorf("ssz-length-of requires a uint field, field %s of %s", f.name, v.name)
+			}
+			target := v.fieldByName(f.lengthOf)
+			if target == nil {
+				return fmt.Errorf("the field %s referenced by the length field %s does not exist in %s", f.lengthOf, f.name, v.name)
+			}
+			if target.lengthFrom != f.name {
+				return fmt.Errorf("the field %s of %s requires the tag ssz-length-from:\"%s\"", target.name, v.name, f.name)
+			}
+		}
+		if f.lengthFrom == "" {
+			continue
+		}
+		if f.t != TypeBytes || f.isFixed() {
+			return fmt.Errorf("ssz-length-from requires a []byte field with ssz-max, field %s of %s", f.name, v.name)
+		}
+		if framed != "" {
+			return fmt.Errorf("%s has more than one ssz-length-from field", v.name)
+		}
+		framed = f.name
+		length := v.fieldByName(f.lengthFrom)
+		if length == nil {
+			return fmt.Errorf("the length field %s of %s does not exist in %s", f.lengthFrom, f.name, v.name)
+		}
+		if length.t != TypeUint {
+			return fmt.Errorf("the length field %s of %s must be a uint but it is a %s", length.name, f.name, length.t)
+		}
+		if length.lengthOf != f.name {
+			return fmt.Errorf("the length field %s of %s requires the tag ssz-length-of:\"%s\"", length.name, v.name, f.name)
+		}
+		if v.fieldIndex(length.name) > indx {
+			return fmt.Errorf("the length field %s must precede the framed field %s in %s", length.name, f.name, v.name)
+		}
+		if length.s < 8 && f.m > math.MaxUint64>>(64-8*length.s) {
+			return fmt.Errorf("the length field %s (uint%d) of %s cannot hold its maximum length %d", length.name, length.s*8, f.name, f.m)
+		}
+		if f.encrypt || length.encrypt {
+			return fmt.Errorf("ssz-encrypt is not supported with the framed field %s of %s", f.name, v.name)
+		}
+	}
+	if framed == "" {
+		return nil
+	}
+	for _, f := range v.o {
+		if f.hasOffset() {
+			return fmt.Errorf("the framed field %s cannot be used with the dynamic field %s of %s", framed, f.name, v.name)
+		}
+	}
+	return nil
+}
+
+func (v *Value) fieldByName(name string) *Value {
+	if indx := v.fieldIndex(name); indx != -1 {
+		return v.o[indx]
+	}
+	return nil
+}
+
+func (v *Value) fieldIndex(name string) int {
+	for indx, f := range v.o {
+		if f.name == name {
+			return indx
+		}
+	}
+	return -1
+}
+
+// marshalFramed writes the bytes of the framed field in place once its length field
+// is checked. The length field is not derived from the bytes since it is also hashed.
+func (v *Value) marshalFramed() string {
+	tmpl := `if uint64(len(::.{{.name}})) != uint64(::.{{.length}}) {
+		err = ssz.ErrLengthField
+		return
+	}
+	{{.marshal}}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"length":  v.lengthFrom,
+		"marshal": v.marshal(),
+	})
+}
+
+// unmarshalFramed reads the framed field at pos of a container whose fixed fields have
+// fixed bytes. The framed bytes are the ones of the buffer beyond the fixed fields, which
+// must be the value of the length field. The buffer is advanced past them so that the
+// fields after the framed field are at the positions of the fixed part.
+func (v *Value) unmarshalFramed(pos, fixed uint64) string {
+	tmpl := `if uint64(::.{{.length}}) != size-{{.fixed}} {
+		return ssz.ErrLengthField
+	}
+	{{.unmarshal}}{{if .shift}}
+	buf = buf[size-{{.fixed}}:]{{end}}`
+	dst := fmt.Sprintf("buf[%d:]", pos)
+	if rest := fixed - pos; rest != 0 {
+		dst = fmt.Sprintf("buf[%d:size-%d]", pos, rest)
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"length":    v.lengthFrom,
+		"fixed":     fixed,
+		"unmarshal": v.unmarshal(dst),
+		"shift":     fixed != pos,
+	})
+}
+
+// fillFramed returns the code to set the length field of the container to the length
+// of its framed field, which is populated with a known number of bytes
+func (v *Value) fillFramed(length *Value, populate bool) string {
+	framed := v.fieldByName(length.lengthOf)
+	return fmt.Sprintf("::.%s = %s(%d)", length.name, length.goType(), framed.fillLength(populate))
+}
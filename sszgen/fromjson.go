@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	ssz "github.com/photon-storage/fastssz"
+)
+
+// schemaDim is a dimension of a collection in the ssz-size and ssz-max tags
+type schemaDim struct {
+	size, max string
+}
+
+// typesFromJSON writes the Go structs described by the schemas of the json file (the
+// ssz.Schema objects returned by SSZSchema) to the output file, with the ssz tags of their
+// fields, so that their methods are generated from it as from any other source file.
+func typesFromJSON(path, output, packageName string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var schemas []*ssz.Schema
+	if trimmed := bytes.TrimSpace(data); len(trimmed) != 0 && trimmed[0] == '{' {
+		// a single schema
+		schemas = []*ssz.Schema{{}}
+		err = json.Unmarshal(data, schemas[0])
+	} else {
+		err = json.Unmarshal(data, &schemas)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode the schemas of %s: %v", path, err)
+	}
+	if packageName == "" {
+		// the package is named after the directory of the output
+		abs, err := filepath.Abs(output)
+		if err != nil {
+			return err
+		}
+		packageName = filepath.Base(filepath.Dir(abs))
+	}
+	if !token.IsIdentifier(packageName) {
+		return fmt.Errorf("'%s' is not a valid package name, use the package flag", packageName)
+	}
+
+	src, err := schemasSource(filepath.Base(path), packageName, schemas)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(output, src, 0644)
+}
+
+// schemasSource returns the Go file with the structs of the schemas
+func schemasSource(name, packageName string, schemas []*ssz.Schema) ([]byte, error) {
+	names := map[string]bool{}
+	for _, s := range schemas {
+		if !token.IsIdentifier(s.Name) || !isExportedField(s.Name) {
+			return nil, fmt.Errorf("'%s' is not a valid name of an exported struct", s.Name)
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("two schemas share the same name %s", s.Name)
+		}
+		names[s.Name] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by fastssz from %s. DO NOT EDIT.\npackage %s\n", name, packageName)
+	for _, s := range schemas {
+		fmt.Fprintf(&buf, "\n// %s is generated from its ssz schema %s\ntype %s struct {\n", s.Name, s.String(), s.Name)
+		for _, f := range s.Fields {
+			if !token.IsIdentifier(f.Name) || !isExportedField(f.Name) {
+				return nil, fmt.Errorf("'%s' is not a valid name of an exported field in %s", f.Name, s.Name)
+			}
+			goType, dims, tag, err := schemaGoType(f.Type, names)
+			if err != nil {
+				return nil, fmt.Errorf("field %s of %s: %v", f.Name, s.Name, err)
+			}
+			if tags := schemaTags(dims, tag); tags != "" {
+				fmt.Fprintf(&buf, "%s %s `%s`\n", f.Name, goType, tags)
+			} else {
+				fmt.Fprintf(&buf, "%s %s\n", f.Name, goType)
+			}
+		}
+		buf.WriteString("}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+// schemaGoType returns the Go type of the canonical ssz type, the dimensions of its
+// collections and the tag that sets its kind (i.e. ssz:"bitlist")
+func schemaGoType(typ string, names map[string]bool) (string, []schemaDim, string, error) {
+	switch typ {
+	case "bool", "uint8", "uint16", "uint32", "uint64":
+		return typ, nil, "", nil
+	case "byte":
+		return "byte", nil, "", nil
+	}
+	if inner, ok := schemaArgs(typ, "Optional"); ok {
+		if !names[inner] {
+			return "", nil, "", fmt.Errorf("the optional type %s is not a struct of the schemas", inner)
+		}
+		return "*" + inner, nil, `ssz-optional:"true"`, nil
+	}
+	if bits, ok := schemaArgs(typ, "Bitvector"); ok {
+		if _, err := strconv.ParseUint(bits, 10, 64); err != nil {
+			return "", nil, "", fmt.Errorf("bad length of %s", typ)
+		}
+		return "[]byte", []schemaDim{{size: bits, max: "?"}}, `ssz:"bitvector"`, nil
+	}
+	if max, ok := schemaArgs(typ, "Bitlist"); ok {
+		if _, err := strconv.ParseUint(max, 10, 64); err != nil {
+			return "", nil, "", fmt.Errorf("bad limit of %s", typ)
+		}
+		return "[]byte", []schemaDim{{size: "?", max: max}}, `ssz:"bitlist"`, nil
+	}
+	for _, kind := range []string{"Vector", "List"} {
+		args, ok := schemaArgs(typ, kind)
+		if !ok {
+			continue
+		}
+		// the length is after the last comma since the element may have its own
+		indx := strings.LastIndex(args, ",")
+		if indx == -1 {
+			return "", nil, "", fmt.Errorf("%s does not have a length", typ)
+		}
+		length := args[indx+1:]
+		if _, err := strconv.ParseUint(length, 10, 64); err != nil {
+			return "", nil, "", fmt.Errorf("bad length of %s", typ)
+		}
+		elem, dims, tag, err := schemaGoType(args[:indx], names)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if tag != "" {
+			return "", nil, "", fmt.Errorf("%s cannot be the element of a collection", args[:indx])
+		}
+		dim := schemaDim{size: length, max: "?"}
+		if kind == "List" {
+			dim = schemaDim{size: "?", max: length}
+		}
+		return "[]" + elem, append([]schemaDim{dim}, dims...), "", nil
+	}
+	if strings.HasPrefix(typ, "Custom") {
+		return "", nil, "", fmt.Errorf("the custom fields are encoded by hand-written methods and cannot be generated")
+	}
+	if names[typ] {
+		return "*" + typ, nil, "", nil
+	}
+	return "", nil, "", fmt.Errorf("unknown type %s", typ)
+}
+
+// schemaArgs returns the arguments of a parametrized type (i.e. uint64,8 of List[uint64,8])
+func schemaArgs(typ, kind string) (string, bool) {
+	if !strings.HasPrefix(typ, kind+"[") || !strings.HasSuffix(typ, "]") {
+		return "", false
+	}
+	return typ[len(kind)+1 : len(typ)-1], true
+}
+
+// schemaTags returns the ssz-size and ssz-max tags of the dimensions with the kind tag
+func schemaTags(dims []schemaDim, tag string) string {
+	sizes, maxes := []string{}, []string{}
+	hasSize := false
+	for _, dim := range dims {
+		sizes = append(sizes, dim.size)
+		maxes = append(maxes, dim.max)
+		hasSize = hasSize || dim.size != "?"
+	}
+	// the inner dimensions without limit are implicit
+	for len(maxes) != 0 && maxes[len(maxes)-1] == "?" {
+		maxes = maxes[:len(maxes)-1]
+	}
+
+	tags := []string{}
+	if tag != "" {
+		tags = append(tags, tag)
+	}
+	if hasSize {
+		tags = append(tags, fmt.Sprintf("ssz-size:\"%s\"", strings.Join(sizes, ",")))
+	}
+	if len(maxes) != 0 {
+		tags = append(tags, fmt.Sprintf("ssz-max:\"%s\"", strings.Join(maxes, ",")))
+	}
+	return strings.Join(tags, " ")
+}
@@ -14,16 +14,22 @@ func (e *env) hashTreeRoot(name string, v *Value) string {
 
 	// HashTreeRootWith ssz hashes the {{.name}} object with a hasher
 	func (:: *{{.name}}) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-		{{.hashTreeRoot}}
+		{{if .treeCache}}if ::.{{.treeCache}} != nil {
+			// the root of the cached tree
+			hh.AppendBytes32(::.{{.treeCache}}.Hash())
+			return
+		}
+		{{end}}{{.hashTreeRoot}}
 		return
 	}`
 
 	data := map[string]interface{}{
 		"name":         name,
+		"treeCache":    v.treeCache,
 		"hashTreeRoot": v.hashTreeRootContainer(true),
 	}
 	str := execTmpl(tmpl, data)
-	return appendObjSignature(str, v)
+	return e.appendObjSignature(str, v)
 }
 
 func (v *Value) hashRoots(isList bool, elem Type) string {
@@ -69,14 +75,30 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 			"listSize": v.s,
 			"elemSize": elemSize,
 		})
-
-		// when doing []uint64 we need to round up the Hasher bytes to 32
-		if elem == TypeUint {
-			merkleize = "hh.FillUpTo32()\n" + merkleize
-		}
 	} else {
 		merkleize = "hh.Merkleize(subIndx)"
 	}
+	// the uints of the lists and vectors are packed in chunks, the last one is
+	// padded with zeros since Merkleize only hashes full chunks
+	if elem == TypeUint {
+		merkleize = "hh.FillUpTo32()\n" + merkleize
+	}
+
+	if elem == TypeUint && v.e.obj == "" && v.e.s != 1 {
+		// []uint64 (but not the aliases) are appended at once
+		tmpl := `{
+			{{.outer}}subIndx := hh.Index()
+			hh.{{.appendFn}}Array(::.{{.name}}{{if .array}}[:]{{end}})
+			{{.merkleize}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"outer":     v.validate(),
+			"name":      v.name,
+			"array":     v.c,
+			"appendFn":  appendFn,
+			"merkleize": merkleize,
+		})
+	}
 
 	tmpl := `{
 		{{.outer}}subIndx := hh.Index()
@@ -99,13 +121,10 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 // ie within a for loop for a list, the we want to refer to "elem" w/o a receiver variable
 // when not specified, name will be set to "::." + v.name. In the final templating pass,
 // the output formatter replaces all instances of "::" with the receiver variable for the container.
-// appendBytes is a control variable which changes the fastssz.Hasher method used to handle byte slices
-// when it is false, the default behavior is to call PutBytes, which merkleizes the buffer after appending
-// the bytes. when true, the generated code calls AppendBytes32, which appends the bytes to the buffer
-// with padding and leaves the merkleization for a following step. This is because in the case of ByteLists,
-// the length of the list needs to be mixed in as part of the merkleization process, which happens in a separate
-// call to MerkleizeWithMixin.
-func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
+// ByteLists are appended with AppendBytes32, which pads the bytes to 32 bytes chunks without merkleizing
+// them, since the chunks are merkleized up to the limit of ceil(max/32) chunks with the length (in bytes)
+// mixed in by MerkleizeWithMixin. PutBytes would merkleize the bytes longer than 32 bytes twice.
+func (v *Value) hashTreeRoot(name string) string {
 	if name == "" {
 		name = "::." + v.name
 	}
@@ -126,10 +145,6 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 			})
 		} else {
 			// dynamic bytes require special handling, need length mixed in
-			hMethod := "PutBytes"
-			if appendBytes {
-				hMethod = "AppendBytes32"
-			}
 			tmpl := `{
 	elemIndx := hh.Index()
 	byteLen := uint64(len({{.name}}))
@@ -137,13 +152,17 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		err = ssz.ErrIncorrectListSize
 		return
     }
-	hh.{{.hashMethod}}({{.name}})
+	{{if .min}}if byteLen < {{.min}} {
+		err = ssz.ErrListTooSmall
+		return
+	}
+	{{end}}hh.AppendBytes32({{.name}})
 	hh.MerkleizeWithMixin(elemIndx, byteLen, ({{.maxLen}}+31)/32)
 }`
 			return execTmpl(tmpl, map[string]interface{}{
-				"hashMethod": hMethod,
-				"name":       name,
-				"maxLen":     v.m,
+				"name":   name,
+				"maxLen": v.m,
+				"min":    v.min,
 			})
 		}
 
@@ -170,7 +189,26 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	case TypeBool:
 		return fmt.Sprintf("hh.PutBool(%s)", name)
 
+	case TypePackedBools:
+		return v.hashTreeRootPackedBools()
+
 	case TypeVector:
+		if v.e.t == TypeContainer || v.e.t == TypeReference {
+			// the roots of the containers are merkleized without a length
+			tmpl := `{
+			{{.validate}}subIndx := hh.Index()
+			for _, elem := range {{.name}} {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.Merkleize(subIndx)
+		}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"validate": v.validate(),
+				"name":     name,
+			})
+		}
 		return v.hashRoots(false, v.e.t)
 
 	case TypeList:
@@ -187,7 +225,11 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 				err = ssz.ErrIncorrectListSize
 				return
 			}
-			for _, elem := range {{.name}} {
+			{{if .min}}if num < {{.min}} {
+				err = ssz.ErrListTooSmall
+				return
+			}
+			{{end}}for _, elem := range {{.name}} {
 {{.htrCall}}
 			}
 			hh.MerkleizeWithMixin(subIndx, num, {{.num}})
@@ -196,7 +238,7 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		if v.e.t == TypeBytes {
 			eName := "elem"
 			// ByteLists should be represented as Value with TypeBytes and .m set instead of .s (isFixed == true)
-			htrCall = v.e.hashTreeRoot(eName, true)
+			htrCall = v.e.hashTreeRoot(eName)
 		} else {
 			htrCall = execTmpl(`if err = elem.HashTreeRootWith(hh); err != nil {
 	return
@@ -206,15 +248,58 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		return execTmpl(tmpl, map[string]interface{}{
 			"name":    name,
 			"num":     v.m,
+			"min":     v.min,
 			"htrCall": htrCall,
 		})
 
+	case TypeCustom:
+		return fmt.Sprintf("if err = ::.hashTreeRootField%s(hh); err != nil {\nreturn\n}", v.name)
+
+	case TypeReserved:
+		return v.hashTreeRootReserved()
+
+	case TypeUnion:
+		return fmt.Sprintf("if err = ::.hashTreeRootUnion%s(hh); err != nil {\nreturn\n}", v.name)
+
 	default:
 		panic(fmt.Errorf("hash not implemented for type %s", v.t.String()))
 	}
 }
 
 func (v *Value) hashTreeRootContainer(start bool) string {
+	if !start && v.iface {
+		tmpl := `{
+			obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				err = ssz.ErrConcreteType
+				return
+			}
+			if err = obj.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"obj":  v.objRef(),
+		})
+	}
+	if !start && v.optional {
+		// the optional is hashed as a list with at most one element
+		tmpl := `{
+			subIndx := hh.Index()
+			num := uint64(0)
+			if ::.{{.name}} != nil {
+				if err = ::.{{.name}}.HashTreeRootWith(hh); err != nil {
+					return
+				}
+				num = 1
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+	}
 	if !start {
 		check := v.isFixed()
 		if v.isListElem() {
@@ -241,25 +326,70 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		})
 	}
 
+	// the leaves of the fields are known, the buffer is only extended once for them
+	tmpl := `indx := hh.Index()
+	hh.Reserve({{.leaves}})
+
+	{{.fields}}
+
+	hh.Merkleize(indx)`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"leaves": len(v.o),
+		"fields": v.hashTreeRootFields(),
+	})
+}
+
+// hashTreeRootFields appends the root of each field of the container to the hasher
+func (v *Value) hashTreeRootFields() string {
 	out := []string{}
 	for indx, i := range v.o {
-		// the call to hashTreeRoot below is ugly because it's currently hacked to support ByteLists
-		// the first argument allows the element name to be overriden when calling .HashTreeRoot on it
+		// the argument allows the element name to be overriden when calling .HashTreeRoot on it
 		// used to specify the name "elem" when called as part of a for loop iteration. when the string
 		// is empty, it defaults to the .name parameter of the value
-		// the second field tells the code generator to specifically generate a call to AppendBytes32
-		// this is used by List[List[byte, N]] so that lists of lists of bytes are not double-merkleized.
-		str := fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.hashTreeRoot("", false))
+		str := fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.hashTreeRoot(""))
 		out = append(out, str)
 	}
+	return strings.Join(out, "\n")
+}
 
-	tmpl := `indx := hh.Index()
+// merkleProof creates a function that returns the merkle branch of a field of the struct
+func (e *env) merkleProof(name string, v *Value) string {
+	tmpl := `// MerkleProof returns the merkle branch that proves the field against the root of the {{.name}} object
+	func (:: *{{.name}}) MerkleProof(field string) (proof [][32]byte, err error) {
+		{{if not .cases}}
+		err = ssz.ErrUnknownField
+		return
+		{{else}}
+		var leaf int
+		switch field {
+		{{.cases}}
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
 
-	{{.fields}}
+		hh := ssz.DefaultHasherPool.Get()
+		defer ssz.DefaultHasherPool.Put(hh)
 
-	hh.Merkleize(indx)`
+		indx := hh.Index()
 
-	return execTmpl(tmpl, map[string]interface{}{
-		"fields": strings.Join(out, "\n"),
+		{{.fields}}
+
+		return hh.Branch(indx, leaf)
+		{{end}}
+	}`
+
+	cases := []string{}
+	for indx, i := range v.o {
+		for _, j := range i.packedFields() {
+			cases = append(cases, fmt.Sprintf("case \"%s\":\nleaf = %d", j.name, indx))
+		}
+	}
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"cases":  strings.Join(cases, "\n"),
+		"fields": v.hashTreeRootFields(),
 	})
+	return e.appendObjSignature(str, v)
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// incremental creates the helpers to compute the root of the lists tagged with
+// 'ssz-incremental' without hashing the whole list every time an element is appended.
+func (e *env) incremental(name string, v *Value) string {
+	out := []string{}
+	for _, i := range v.o {
+		if !i.incremental {
+			continue
+		}
+		out = append(out, i.incrementalList(name))
+	}
+	if len(out) == 0 {
+		return ""
+	}
+	str := strings.Join(out, "\n\n")
+	return e.appendObjSignature(str, v)
+}
+
+func (v *Value) incrementalList(name string) string {
+	tmpl := `// {{.field}}Accumulator returns an accumulator with the roots of the {{.field}} list of the {{.name}} object
+	func (:: *{{.name}}) {{.field}}Accumulator() (*ssz.ListAccumulator, error) {
+		acc := ssz.NewListAccumulator({{.size}})
+		for _, elem := range ::.{{.field}} {
+			{{.leaf}}
+			if err := acc.Append(leaf); err != nil {
+				return nil, err
+			}
+		}
+		return acc, nil
+	}
+
+	// Append{{.field}}AndRoot appends elem to the {{.field}} list of the {{.name}} object and returns
+	// the new root of the list. The accumulator must hold the roots of the list (see {{.field}}Accumulator)
+	func (:: *{{.name}}) Append{{.field}}AndRoot(acc *ssz.ListAccumulator, elem {{.elem}}) ([32]byte, error) {
+		if acc.Len() != uint64(len(::.{{.field}})) {
+			return [32]byte{}, ssz.ErrAccumulatorLength
+		}
+		{{.appendLeaf}}
+		if err := acc.Append(leaf); err != nil {
+			return [32]byte{}, err
+		}
+		::.{{.field}} = append(::.{{.field}}, elem)
+		return acc.Root(), nil
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":       name,
+		"field":      v.name,
+		"size":       v.s,
+		"elem":       v.e.goType(),
+		"leaf":       v.e.incrementalLeaf("nil"),
+		"appendLeaf": v.e.incrementalLeaf("[32]byte{}"),
+	})
+}
+
+// incrementalLeaf returns the code to compute the leaf of the list element 'elem'
+func (v *Value) incrementalLeaf(zero string) string {
+	if v.t == TypeBytes {
+		if v.c {
+			return "leaf := elem"
+		}
+		tmpl := `if len(elem) != 32 {
+			return {{.zero}}, ssz.ErrBytesLength
+		}
+		var leaf [32]byte
+		copy(leaf[:], elem)`
+		return execTmpl(tmpl, map[string]interface{}{
+			"zero": zero,
+		})
+	}
+	tmpl := `leaf, err := elem.HashTreeRoot()
+	if err != nil {
+		return {{.zero}}, err
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"zero": zero,
+	})
+}
+
+// validateIncremental checks that the roots of the list can be computed incrementally.
+// Each element of the list must be a single leaf of the tree.
+func (v *Value) validateIncremental(name string) error {
+	if v.t != TypeList || v.e == nil {
+		return fmt.Errorf("ssz-incremental is only supported for lists, field %s", name)
+	}
+	switch v.e.t {
+	case TypeContainer, TypeReference:
+		return nil
+	case TypeBytes:
+		if v.e.fixed && v.e.s == 32 {
+			return nil
+		}
+	}
+	return fmt.Errorf("ssz-incremental requires a list of containers or 32 bytes roots, field %s", name)
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const (
+	// inplaceBegin is the line that starts the methods generated in the source file
+	inplaceBegin = "// Begin of the code generated by fastssz. DO NOT EDIT."
+	// inplaceEnd is the line that ends the methods generated in the source file
+	inplaceEnd = "// End of the code generated by fastssz."
+)
+
+// stripInplace removes the methods generated in place from the source so that
+// they are not parsed as hand-written methods when the file is generated again
+func stripInplace(src []byte) []byte {
+	begin := bytes.Index(src, []byte(inplaceBegin))
+	if begin == -1 {
+		return src
+	}
+	res := append([]byte{}, bytes.TrimRight(src[:begin], "\n")...)
+	res = append(res, '\n')
+	if end := bytes.Index(src[begin:], []byte(inplaceEnd)); end != -1 {
+		res = append(res, src[begin+end+len(inplaceEnd):]...)
+	}
+	return res
+}
+
+// parseSource parses the Go file without the methods generated in place
+func parseSource(fset *token.FileSet, name string, mode parser.Mode) (*ast.File, []byte, error) {
+	src, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	src = stripInplace(src)
+	file, err := parser.ParseFile(fset, name, src, mode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, src, nil
+}
+
+// withoutImports returns a copy of the file without the import declarations
+func withoutImports(file *ast.File) *ast.File {
+	res := *file
+	res.Imports = nil
+	res.Decls = nil
+	for _, decl := range file.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			continue
+		}
+		res.Decls = append(res.Decls, decl)
+	}
+	return &res
+}
+
+// printInplace returns the source file with the methods of the objects appended
+// after its declarations
+func (e *env) printInplace(name string, order []string, experimental bool) (string, bool, error) {
+	generated, ok, err := e.print(order, experimental)
+	if err != nil || !ok {
+		return "", ok, err
+	}
+	hash, err := e.hashSource()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to hash files: %v", err)
+	}
+	res, err := appendGenerated(name, generated, hash)
+	if err != nil {
+		return "", false, err
+	}
+	return res, true, nil
+}
+
+// appendGenerated returns the source file with the generated code appended. The
+// generated code is a complete Go file whose imports are merged with the ones of
+// the source, the rest of the source is preserved.
+func appendGenerated(name, generated, hash string) (string, error) {
+	fset := token.NewFileSet()
+	file, _, err := parseSource(fset, name, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	genFset := token.NewFileSet()
+	gen, err := parser.ParseFile(genFset, name, generated, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the generated code of %s: %v", name, err)
+	}
+	for _, spec := range gen.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", err
+		}
+		alias := ""
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		astutil.AddNamedImport(fset, file, alias, path)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return "", err
+	}
+
+	// the generated declarations start after the imports
+	start := gen.Name.End()
+	for _, decl := range gen.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			start = d.End()
+		}
+	}
+	body := strings.TrimSpace(generated[genFset.Position(start).Offset:])
+
+	buf.WriteString("\n" + inplaceBegin + "\n")
+	buf.WriteString("// Hash: " + hash + "\n\n")
+	buf.WriteString(body + "\n\n")
+	buf.WriteString(inplaceEnd + "\n")
+	return buf.String(), nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// isZero creates a function that returns whether all the fields of the struct
+// are zero. Both nil and empty slices are zero and so are nil pointers. The fixed
+// size slices are also zero if all their elements are zero.
+func (e *env) isZero(name string, v *Value) string {
+	tmpl := `// IsZeroSSZ returns true if all the fields of the {{.name}} object are zero
+	func (:: *{{.name}}) IsZeroSSZ() bool {
+		{{.isZero}}
+		return true
+	}`
+
+	data := map[string]interface{}{
+		"name":   name,
+		"isZero": v.isZeroContainer(true),
+	}
+	str := execTmpl(tmpl, data)
+	return e.appendObjSignature(str, v)
+}
+
+func (v *Value) isZero() string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.isZeroContainer(false)
+
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("if ::.%s != [%d]byte{} {\nreturn false\n}", v.name, v.s)
+		}
+		if v.isFixed() {
+			// fixed size slices are zero if all the bytes are zero, as the arrays
+			tmpl := `for _, elem := range ::.{{.name}} {
+				if elem != 0 {
+					return false
+				}
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name": v.name,
+			})
+		}
+		return fmt.Sprintf("if len(::.%s) != 0 {\nreturn false\n}", v.name)
+
+	case TypeUint:
+		return fmt.Sprintf("if ::.%s != 0 {\nreturn false\n}", v.name)
+
+	case TypeBool:
+		return fmt.Sprintf("if ::.%s {\nreturn false\n}", v.name)
+
+	case TypePackedBools:
+		out := []string{}
+		for _, i := range v.o {
+			out = append(out, i.isZero())
+		}
+		return strings.Join(out, "\n")
+
+	case TypeVector, TypeList:
+		if v.t == TypeList {
+			return fmt.Sprintf("if len(::.%s) != 0 {\nreturn false\n}", v.name)
+		}
+		// vectors (either arrays or slices) are zero only if all the elements are zero
+		v.e.name = v.name + "[ii]"
+
+		tmpl := `for ii := range ::.{{.name}} {
+			{{.isZero}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":   v.name,
+			"isZero": v.e.isZero(),
+		})
+
+	case TypeCustom:
+		// the custom field is zero if its encoding is all zeros
+		tmpl := `for _, b := range ::.marshalField{{.name}}(nil) {
+			if b != 0 {
+				return false
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+
+	case TypeReserved:
+		// the reserved bytes are always zero
+		return ""
+
+	case TypeUnion:
+		// the union is zero if it is nil (the selector 0 without a value)
+		return fmt.Sprintf("if ::.%s != nil {\nreturn false\n}", v.name)
+
+	default:
+		panic(fmt.Errorf("is zero not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) isZeroContainer(start bool) string {
+	if !start && v.iface {
+		isZero := "obj.IsZeroSSZ()"
+		if v.t == TypeReference {
+			isZero = "ssz.IsZero(obj)"
+		}
+		tmpl := `if ::.{{.name}} != nil {
+			if obj, ok := ::.{{.name}}.(*{{.obj}}); !ok || !{{.isZero}} {
+				return false
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":   v.name,
+			"obj":    v.objRef(),
+			"isZero": isZero,
+		})
+	}
+	if !start && v.optional {
+		// a present value is not zero even if the value is empty
+		return fmt.Sprintf("if ::.%s != nil {\nreturn false\n}", v.name)
+	}
+	if !start {
+		if v.t == TypeReference {
+			// the methods are written by hand, we can only check the encoding
+			if v.noPtr {
+				return fmt.Sprintf("if !ssz.IsZero(&::.%s) {\nreturn false\n}", v.name)
+			}
+			return fmt.Sprintf("if ::.%s != nil && !ssz.IsZero(::.%s) {\nreturn false\n}", v.name, v.name)
+		}
+		if v.noPtr {
+			return fmt.Sprintf("if !::.%s.IsZeroSSZ() {\nreturn false\n}", v.name)
+		}
+		return fmt.Sprintf("if ::.%s != nil && !::.%s.IsZeroSSZ() {\nreturn false\n}", v.name, v.name)
+	}
+
+	out := []string{}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.isZero()))
+	}
+	if v.ext != "" {
+		out = append(out, fmt.Sprintf("// Extension '%s'\nif len(::.%s) != 0 {\nreturn false\n}\n", v.ext, v.ext))
+	}
+	return strings.Join(out, "\n")
+}
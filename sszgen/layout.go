@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+// checkLayout checks that the layout of the container is consistent once it is parsed. The
+// size, marshal and unmarshal templates derive the offsets from isFixed and fixedSize
+// separately, so a field whose fixed part does not match isFixed would shift the offsets
+// of the next fields without failing at generation time.
+func (v *Value) checkLayout() error {
+	if v.t != TypeContainer {
+		return nil
+	}
+	var fixed uint64
+	var dynamic int
+	for _, f := range v.o {
+		if err := f.checkFieldLayout(); err != nil {
+			return fmt.Errorf("inconsistent layout of %s: %v", v.name, err)
+		}
+		if f.isFixed() {
+			fixed += f.fixedSize()
+		} else {
+			if f.hasOffset() {
+				// the fixed part of a dynamic field is its offset
+				fixed += bytesPerLengthOffset
+			}
+			dynamic++
+		}
+	}
+	if size := v.fixedSize(); size != fixed {
+		return fmt.Errorf("inconsistent layout of %s: the fixed part of the fields has %d bytes but the container has %d", v.name, fixed, size)
+	}
+	if v.isFixed() && dynamic != 0 {
+		return fmt.Errorf("inconsistent layout of %s: the container is fixed but it has %d dynamic fields", v.name, dynamic)
+	}
+	if !v.isFixed() && dynamic == 0 && v.ext == "" {
+		return fmt.Errorf("inconsistent layout of %s: the container is dynamic but all its fields are fixed", v.name)
+	}
+	return nil
+}
+
+// checkFieldLayout checks that the field and its elements have a fixed part that is
+// consistent with isFixed
+func (v *Value) checkFieldLayout() error {
+	// only the empty containers (and the vectors of them) have no bytes
+	if v.isFixed() && v.fixedSize() == 0 && v.t != TypeContainer && v.t != TypeVector {
+		return fmt.Errorf("the fixed field %s (%s) has no bytes", v.name, v.t)
+	}
+	switch v.t {
+	case TypeVector, TypeList:
+		if v.e == nil {
+			return fmt.Errorf("the %s %s has no element", v.t, v.name)
+		}
+		if v.t == TypeVector && !v.optional && v.isFixed() != v.e.isFixed() {
+			return fmt.Errorf("the vector %s and its element %s do not agree on being fixed", v.name, v.e.t)
+		}
+		if v.t == TypeList && v.e.isFixed() && v.e.fixedSize() == 0 {
+			// the number of elements is the length of the buffer divided by their size
+			return fmt.Errorf("the elements of %s (%s) are fixed but they have no bytes", v.name, v.e.t)
+		}
+		return v.e.checkFieldLayout()
+	}
+	return nil
+}
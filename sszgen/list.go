@@ -0,0 +1,69 @@
+package main
+
+// marshalList creates the functions that encode and decode a slice of the structs
+// as a ssz list with a limit given at runtime (-list-helpers).
+func (e *env) marshalList(name string, v *Value) string {
+	if v.t != TypeContainer || (v.isFixed() && v.fixedSize() == 0) {
+		// only the structs with some bytes can be decoded from a list
+		return ""
+	}
+
+	tmpl := `// Marshal{{.name}}List ssz marshals the items as a list of at most max {{.name}} objects
+	func Marshal{{.name}}List(items []*{{.name}}, max uint64) (dst []byte, err error) {
+		if uint64(len(items)) > max {
+			return nil, ssz.ErrListTooBig
+		}
+		size := {{if .fixed}}len(items) * {{.size}}{{else}}len(items) * 4
+		for _, item := range items {
+			size += item.SizeSSZ()
+		}{{end}}
+		dst = make([]byte, 0, size)
+		{{if not .fixed}}
+		offset := len(items) * 4
+		for _, item := range items {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return nil, err
+			}
+			offset += item.SizeSSZ()
+		}
+		{{end}}for _, item := range items {
+			if dst, err = item.MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	}
+
+	// Unmarshal{{.name}}List ssz unmarshals a list of at most max {{.name}} objects
+	func Unmarshal{{.name}}List(buf []byte, max uint64) ([]*{{.name}}, error) {
+		{{if .fixed}}num, err := ssz.DivideInt2(len(buf), {{.size}}, max)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]*{{.name}}, num)
+		for ii := 0; ii < num; ii++ {
+			items[ii] = new({{.name}})
+			if err = items[ii].UnmarshalSSZ(buf[ii*{{.size}}:(ii+1)*{{.size}}]); err != nil {
+				return nil, err
+			}
+		}{{else}}num, err := ssz.DecodeDynamicLength(buf, max)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]*{{.name}}, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) error {
+			items[indx] = new({{.name}})
+			return items[indx].UnmarshalSSZ(buf)
+		})
+		if err != nil {
+			return nil, err
+		}{{end}}
+		return items, nil
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"fixed": v.isFixed(),
+		"size":  v.fixedSize(),
+	})
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// logLevel is the level of the diagnostics printed during the generation
+type logLevel int
+
+const (
+	// levelWarn only prints the warnings about the input (default)
+	levelWarn logLevel = iota
+	// levelInfo also prints the phases of the generation
+	levelInfo
+	// levelDebug also prints the details of each type
+	levelDebug
+)
+
+// verbosity is the maximum level of the printed diagnostics
+var verbosity = levelWarn
+
+// logOutput is where the diagnostics are written
+var logOutput io.Writer = os.Stderr
+
+// verbosityFlag is the '-v' flag. It works as a bool flag ('-v' prints the phases
+// of the generation) or with a level ('-v=2' also prints the details of each type).
+type verbosityFlag struct {
+	level *logLevel
+}
+
+func (f verbosityFlag) String() string {
+	if f.level == nil {
+		return "0"
+	}
+	return strconv.Itoa(int(*f.level))
+}
+
+func (f verbosityFlag) Set(s string) error {
+	switch s {
+	case "true":
+		*f.level = levelInfo
+	case "false":
+		*f.level = levelWarn
+	default:
+		level, err := strconv.Atoi(s)
+		if err != nil || level < int(levelWarn) || level > int(levelDebug) {
+			return fmt.Errorf("the level must be between %d and %d", levelWarn, levelDebug)
+		}
+		*f.level = logLevel(level)
+	}
+	return nil
+}
+
+func (f verbosityFlag) IsBoolFlag() bool {
+	return true
+}
+
+func logf(level logLevel, prefix, format string, args ...interface{}) {
+	if level > verbosity {
+		return
+	}
+	fmt.Fprintf(logOutput, "["+prefix+"]: "+format+"\n", args...)
+}
+
+// warn prints a warning about the input that does not stop the generation
+func warn(format string, args ...interface{}) {
+	logf(levelWarn, "WARN", format, args...)
+}
+
+// infof prints a phase of the generation
+func infof(format string, args ...interface{}) {
+	logf(levelInfo, "INFO", format, args...)
+}
+
+// debugf prints a detail of the generation
+func debugf(format string, args ...interface{}) {
+	logf(levelDebug, "DEBUG", format, args...)
+}
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/format"
 	"go/parser"
 	"go/token"
+	"go/types"
+	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -18,10 +23,26 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+
+	"golang.org/x/tools/imports"
 )
 
 const bytesPerLengthOffset = 4
 
+// stdio is the path that reads the source from stdin (-path) or
+// writes the generated code to stdout (-output)
+const stdio = "-"
+
+// stdinName is the name of the file read from stdin
+const stdinName = "<stdin>"
+
+var (
+	// stdin is where the source is read from with '-path -'
+	stdin io.Reader = os.Stdin
+	// stdout is where the generated code is written to with '-output -'
+	stdout io.Writer = os.Stdout
+)
+
 func main() {
 	var source string
 	var objsStr string
@@ -29,24 +50,135 @@ func main() {
 	var include string
 	var experimental bool
 	var excludeObjs string
-
-	flag.StringVar(&source, "path", "", "")
+	var excludeFieldsStr string
+	var testVectors bool
+	var packageName string
+	var interfaceChecks bool
+	var receiver string
+	var goimports bool
+	var localPrefix string
+	var maxDepth int
+	var changed string
+	var nilEmptyLists bool
+	var format string
+	var noFormat bool
+	var runtimeSchema bool
+	var compatTest string
+	var maxErrors int
+	var populate bool
+	var inplace bool
+	var listHelpers bool
+	var marshalFields bool
+	var offsets bool
+	var nolint string
+	var buildTags string
+	var strictTags bool
+	var typesJSON string
+	var recursive bool
+
+	flag.StringVar(&source, "path", "", "Path of the source file or directory ('-' reads the source from stdin)")
 	flag.StringVar(&objsStr, "objs", "", "")
 	flag.StringVar(&excludeObjs, "exclude-objs", "", "Comma-separated list of types to exclude from output")
-	flag.StringVar(&output, "output", "", "")
+	flag.StringVar(&excludeFieldsStr, "exclude-fields", "", "Comma-separated list of fields (Type.Field) that are not encoded, as if they had the ssz:\"-\" tag")
+	flag.StringVar(&output, "output", "", "Path of the single generated file ('-' writes it to stdout)")
 	flag.StringVar(&include, "include", "", "")
+	flag.BoolVar(&recursive, "recursive", false, "Generate the files of each package in the tree of the path directory, the references between the packages of the tree are resolved")
+	flag.StringVar(&typesJSON, "types-from-json", "", "Path of a json file with the schemas (ssz.Schema) of the structs, which are written to the output file before their methods are generated")
 	flag.BoolVar(&experimental, "experimental", false, "")
+	flag.BoolVar(&inplace, "inplace", false, "Append the generated methods to the source files instead of writing them in separate files")
+	flag.StringVar(&packageName, "package", "", "Name of the package of the structs written with types-from-json (defaults to the directory of the output), otherwise it must be the package of the source files")
+	flag.BoolVar(&testVectors, "test-vectors", false, "Generate a test file that writes random test vectors in the consensus spec tests format")
+	flag.BoolVar(&populate, "populate", false, "Generate a test file with the PopulateSSZ methods that fill the objects with random values up to their limits")
+	flag.BoolVar(&listHelpers, "list-helpers", false, "Generate the MarshalTList and UnmarshalTList functions that encode a slice of the structs as a ssz list")
+	flag.BoolVar(&marshalFields, "marshal-fields", false, "Generate the MarshalFieldsSSZ and UnmarshalFieldsSSZ methods that encode a subset of the fields in a non-ssz delta format")
+	flag.BoolVar(&offsets, "offsets", false, "Generate the OffsetsSSZ methods that return the offsets of the dynamic fields for debugging")
+	flag.BoolVar(&interfaceChecks, "interface-checks", false, "Generate compile time assertions that the types implement the ssz interfaces")
+	flag.StringVar(&compatTest, "compat-test", "", "Import path of a reference library (with the go-ssz API) to generate tests that compare the encodings with it")
+	flag.BoolVar(&runtimeSchema, "runtime-schema", false, "Register the schemas of the types in ssz.SchemaRegistry")
+	flag.BoolVar(&goimports, "goimports", false, "Run goimports on the generated files")
+	flag.BoolVar(&noFormat, "no-format", false, "Write the generated files without formatting them (faster)")
+	flag.StringVar(&localPrefix, "local", "", "Comma-separated list of import prefixes grouped after the 3rd-party packages by goimports")
+	flag.BoolVar(&nilEmptyLists, "nil-empty-lists", false, "Decode the empty lists to nil instead of to empty slices")
+	flag.StringVar(&changed, "changed", "", "Comma-separated list of changed files, only their outputs (and the ones that depend on them) are generated")
+	flag.StringVar(&format, "format", "", "Additional format generated with the ssz methods (varint)")
+	flag.IntVar(&maxDepth, "max-depth", defaultMaxDepth, "Maximum nesting of the types (0 disables the limit)")
+	flag.IntVar(&maxErrors, "max-errors", 1, "Maximum number of parsing errors reported at once (-1 reports all of them)")
+	flag.Var(verbosityFlag{&verbosity}, "v", "Print the phases of the generation (-v=2 also prints the details of each type)")
+	flag.Var(nolintFlag{&nolint}, "nolint", "Add a directive before the package clause of the generated files to skip them in the linters ('-nolint' adds //nolint:all, '-nolint=gocyclo,funlen' only the given linters and '-nolint=//lint:file-ignore ...' the given directive)")
+	flag.StringVar(&buildTags, "tags", "", "Comma-separated list of build tags, the files of the source and include directories whose build constraints are not satisfied are skipped")
+	flag.BoolVar(&strictTags, "strict-tags", false, "Fail on the unknown struct tags that start with ssz (i.e. a misspelled ssz-max) instead of warning about them")
+	flag.StringVar(&receiver, "receiver", "", "Name of the receiver of the generated methods (defaults to the first letter of the type in lower case)")
 
 	flag.Parse()
 
+	if typesJSON != "" {
+		if source != "" || output == "" || output == stdio {
+			fmt.Printf("[ERR]: the types-from-json flag requires the output flag with the file of the structs instead of the path flag\n")
+			os.Exit(1)
+		}
+		if err := typesFromJSON(typesJSON, output, packageName); err != nil {
+			fmt.Printf("[ERR]: %v\n", err)
+			os.Exit(1)
+		}
+		// the methods are generated from the file of the structs as from any other source
+		source, output, packageName = output, "", ""
+	}
+
 	targets := decodeList(objsStr)
 	includeList := decodeList(include)
 	excludeTypeNames := make(map[string]bool)
 	for _, name := range decodeList(excludeObjs) {
 		excludeTypeNames[name] = true
 	}
+	excludeFields := make(map[string]bool)
+	for _, name := range decodeList(excludeFieldsStr) {
+		excludeFields[name] = true
+	}
 
-	if err := encode(source, targets, output, includeList, excludeTypeNames, experimental); err != nil {
+	opts := encodeOptions{
+		targets:          targets,
+		output:           output,
+		excludeTypeNames: excludeTypeNames,
+		excludeFields:    excludeFields,
+		experimental:     experimental,
+		testVectors:      testVectors,
+		packageName:      packageName,
+		interfaceChecks:  interfaceChecks,
+		receiver:         receiver,
+		goimports:        goimports,
+		localPrefix:      localPrefix,
+		maxDepth:         maxDepth,
+		changed:          decodeList(changed),
+		nilEmptyLists:    nilEmptyLists,
+		format:           format,
+		noFormat:         noFormat,
+		runtimeSchema:    runtimeSchema,
+		compatTest:       compatTest,
+		maxErrors:        maxErrors,
+		populate:         populate,
+		inplace:          inplace,
+		listHelpers:      listHelpers,
+		fieldsEncoding:   marshalFields,
+		offsets:          offsets,
+		nolint:           nolint,
+		buildTags:        decodeList(buildTags),
+		strictTags:       strictTags,
+	}
+	encodeDir := func(source string, includeList []string) error {
+		opts := opts
+		opts.source, opts.includePaths = source, includeList
+		return encode(opts)
+	}
+
+	var err error
+	if recursive {
+		if err = checkRecursive(source, output, packageName, targets); err == nil {
+			err = encodeRecursive(source, includeList, encodeDir)
+		}
+	} else {
+		err = encodeDir(source, includeList)
+	}
+	if err != nil {
 		fmt.Printf("[ERR]: %v\n", err)
 		os.Exit(1)
 	}
@@ -59,22 +191,121 @@ func decodeList(input string) []string {
 	return strings.Split(strings.TrimSpace(input), ",")
 }
 
+// defaultNolint is the directive of the '-nolint' flag without a value
+const defaultNolint = "//nolint:all"
+
+// nolintFlag is the '-nolint' flag. It works as a bool flag ('-nolint' adds //nolint:all),
+// with the linters to skip ('-nolint=gocyclo,funlen') or with the whole directive.
+type nolintFlag struct {
+	directive *string
+}
+
+func (f nolintFlag) String() string {
+	if f.directive == nil {
+		return ""
+	}
+	return *f.directive
+}
+
+func (f nolintFlag) Set(s string) error {
+	switch {
+	case strings.Contains(s, "\n"):
+		return fmt.Errorf("the nolint directive must be a single line")
+	case s == "true":
+		*f.directive = defaultNolint
+	case s == "false":
+		*f.directive = ""
+	case strings.HasPrefix(s, "//"):
+		*f.directive = s
+	case s == "" || strings.Contains(s, " "):
+		return fmt.Errorf("the nolint flag expects a comma-separated list of linters or a directive starting with //")
+	default:
+		*f.directive = "//nolint:" + s
+	}
+	return nil
+}
+
+func (f nolintFlag) IsBoolFlag() bool {
+	return true
+}
+
 // The SSZ code generation works in three steps:
 // 1. Parse the Go input with the go/parser library to generate an AST representation.
 // 2. Convert the AST into an Internal Representation (IR) to describe the structs and fields
 // using the Value object.
 // 3. Use the IR to print the encoding functions
 
-func encode(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, experimental bool) error {
-	files, err := parseInput(source) // 1.
+// encodeOptions are the options of the generation set by the flags
+type encodeOptions struct {
+	// source is the file or directory with the structs
+	source string
+	// targets are the structs to generate (all of them if empty)
+	targets []string
+	// output is the single generated file (one file per source file if empty)
+	output string
+	// includePaths are the files or directories with the referenced structs
+	includePaths     []string
+	excludeTypeNames map[string]bool
+	excludeFields    map[string]bool
+	experimental     bool
+	testVectors      bool
+	packageName      string
+	interfaceChecks  bool
+	receiver         string
+	goimports        bool
+	localPrefix      string
+	maxDepth         int
+	changed          []string
+	nilEmptyLists    bool
+	format           string
+	noFormat         bool
+	runtimeSchema    bool
+	compatTest       string
+	maxErrors        int
+	populate         bool
+	inplace          bool
+	listHelpers      bool
+	fieldsEncoding   bool
+	offsets          bool
+	nolint           string
+	buildTags        []string
+	strictTags       bool
+}
+
+func encode(opts encodeOptions) error {
+	if opts.format != "" && opts.format != formatVarint {
+		return fmt.Errorf("unknown format '%s'", opts.format)
+	}
+	if opts.noFormat && opts.goimports {
+		return fmt.Errorf("the goimports and no-format flags cannot be used together")
+	}
+	if opts.inplace && (opts.output != "" || opts.packageName != "") {
+		return fmt.Errorf("the inplace flag cannot be used with the output or package flags")
+	}
+	if opts.inplace && opts.nolint != "" {
+		return fmt.Errorf("the nolint flag cannot be used with the inplace flag since it would skip the hand-written code of the source files")
+	}
+	if opts.source == stdio && opts.output == "" {
+		return fmt.Errorf("reading the source from stdin requires the output flag")
+	}
+	if opts.output == stdio && (opts.testVectors || opts.populate || opts.compatTest != "") {
+		return fmt.Errorf("the test-vectors, populate and compat-test flags write additional files and cannot be used with the output to stdout")
+	}
+	for name := range opts.excludeFields {
+		if parts := strings.Split(name, "."); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("the exclude-fields flag expects fields as Type.Field but found '%s'", name)
+		}
+	}
+
+	files, err := parseInput(opts.source, opts.buildTags) // 1.
 	if err != nil {
 		return err
 	}
 
 	// parse all the include paths as well
 	include := map[string]*ast.File{}
-	for _, i := range includePaths {
-		files, err := parseInput(i)
+	for _, i := range opts.includePaths {
+		files, err := parseInput(i, opts.buildTags)
 		if err != nil {
 			return err
 		}
@@ -83,33 +314,79 @@ func encode(source string, targets []string, output string, includePaths []strin
 		}
 	}
 
+	// the generated files have the build constraints of their source files
+	constraints, err := readConstraints(files, include)
+	if err != nil {
+		return err
+	}
+
 	// read package
 	var packName string
 	for _, file := range files {
 		packName = file.Name.Name
 	}
+	if opts.packageName != "" && opts.packageName != packName {
+		// the types are not written to the output as with the types-from-json flag
+		return fmt.Errorf("the package flag '%s' is not the package '%s' of the source files, the methods can only be declared in the package of their types", opts.packageName, packName)
+	}
 
 	e := &env{
 		include:          include,
-		source:           source,
+		source:           opts.source,
 		files:            files,
 		objs:             map[string]*Value{},
 		packName:         packName,
-		targets:          targets,
-		excludeTypeNames: excludeTypeNames,
+		targets:          opts.targets,
+		excludeTypeNames: opts.excludeTypeNames,
+		excludeFields:    opts.excludeFields,
+		excludedFields:   map[string]bool{},
+		testVectors:      opts.testVectors,
+		interfaceChecks:  opts.interfaceChecks,
+		runtimeSchema:    opts.runtimeSchema,
+		compatTest:       opts.compatTest,
+		populate:         opts.populate,
+		inplace:          opts.inplace,
+		listHelpers:      opts.listHelpers,
+		fieldsEncoding:   opts.fieldsEncoding,
+		offsets:          opts.offsets,
+		nolint:           opts.nolint,
+		constraints:      constraints,
+		strictTags:       opts.strictTags,
+		receiver:         opts.receiver,
+		maxDepth:         opts.maxDepth,
+		maxErrors:        opts.maxErrors,
+		nilEmptyLists:    opts.nilEmptyLists,
+		format:           opts.format,
+	}
+	if len(opts.changed) != 0 {
+		e.changed = map[string]bool{}
+		for _, file := range opts.changed {
+			e.changed[absPath(file)] = true
+		}
 	}
 
 	if err := e.generateIR(); err != nil { // 2.
 		return err
 	}
+	for name := range opts.excludeFields {
+		if !e.excludedFields[name] {
+			warn("the excluded field %s was not found", name)
+		}
+	}
+	e.logObjs()
+	if opts.receiver != "" {
+		if err := e.validateReceiver(opts.receiver); err != nil {
+			return err
+		}
+	}
 
 	// 3.
 	var out map[string]string
-	if output == "" {
-		out, err = e.generateEncodings(experimental)
+	if opts.output == "" {
+		out, err = e.generateEncodings(opts.experimental)
 	} else {
 		// output to a specific path
-		out, err = e.generateOutputEncodings(output, experimental)
+		out, err = e.generateOutputEncodings(opts.output, opts.experimental)
 	}
 	if err != nil {
 		panic(err)
@@ -122,17 +399,46 @@ func encode(source string, targets []string, output string, includePaths []strin
 	for name, str := range out {
 		output := []byte(str)
 
-		output, err = format.Source(output)
+		if opts.noFormat {
+			err = checkSource(name, output)
+		} else {
+			output, err = formatSource(name, output, opts.goimports, opts.localPrefix)
+		}
 		if err != nil {
 			return err
 		}
+		if name == stdio {
+			if _, err := stdout.Write(output); err != nil {
+				return err
+			}
+			infof("wrote the output to stdout")
+			continue
+		}
 		if err := ioutil.WriteFile(name, output, 0644); err != nil {
 			return err
 		}
+		infof("wrote %s", name)
 	}
 	return nil
 }
 
+// formatSource formats the generated file. With goimports, it also sorts and groups
+// the imports (with the local prefix after the 3rd-party packages) and removes the unused ones.
+func formatSource(name string, src []byte, goimports bool, localPrefix string) ([]byte, error) {
+	if !goimports {
+		return format.Source(src)
+	}
+	imports.LocalPrefix = localPrefix
+	return imports.Process(name, src, nil)
+}
+
+// checkSource checks that the unformatted generated file is valid Go code, which is
+// much faster than formatting it.
+func checkSource(name string, src []byte) error {
+	_, err := parser.ParseFile(token.NewFileSet(), name, src, parser.SkipObjectResolution)
+	return err
+}
+
 func isDir(path string) (bool, error) {
 	fileInfo, err := os.Stat(path)
 	if err != nil {
@@ -141,33 +447,81 @@ func isDir(path string) (bool, error) {
 	return fileInfo.IsDir(), nil
 }
 
-func parseInput(source string) (map[string]*ast.File, error) {
+// parseInput parses the source file or the files of the source directory whose build
+// constraints are satisfied with the build tags
+func parseInput(source string, buildTags []string) (map[string]*ast.File, error) {
 	files := map[string]*ast.File{}
 
+	if source == stdio {
+		// the source is piped from another generator
+		src, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		astfile, err := parser.ParseFile(token.NewFileSet(), stdinName, stripInplace(src), parser.AllErrors)
+		if err != nil {
+			return nil, err
+		}
+		files[stdinName] = astfile
+		infof("parsed the source from stdin")
+		return files, nil
+	}
+
 	ok, err := isDir(source)
 	if err != nil {
 		return nil, err
 	}
 	if ok {
 		// dir
-		astFiles, err := parser.ParseDir(token.NewFileSet(), source, nil, parser.AllErrors)
+		var matchErr error
+		filter := func(info fs.FileInfo) bool {
+			ok, err := matchBuildTags(filepath.Join(source, info.Name()), buildTags)
+			if err != nil && matchErr == nil {
+				matchErr = err
+			}
+			if !ok {
+				debugf("skipped file %s by its build constraints", info.Name())
+			}
+			return ok
+		}
+		astFiles, err := parser.ParseDir(token.NewFileSet(), source, filter, parser.AllErrors)
 		if err != nil {
 			return nil, err
 		}
+		if matchErr != nil {
+			return nil, matchErr
+		}
 		for _, v := range astFiles {
 			if strings.HasSuffix(v.Name, "_test") || v.Name == "ignore" {
 				continue
 			}
 			files = v.Files
 		}
+		// the files with methods generated in place are parsed without them
+		for name := range files {
+			src, err := ioutil.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Contains(src, []byte(inplaceBegin)) {
+				continue
+			}
+			if files[name], err = parser.ParseFile(token.NewFileSet(), name, stripInplace(src), parser.AllErrors); err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		// single file
-		astfile, err := parser.ParseFile(token.NewFileSet(), source, nil, parser.AllErrors)
+		astfile, _, err := parseSource(token.NewFileSet(), source, parser.AllErrors)
 		if err != nil {
 			return nil, err
 		}
 		files[source] = astfile
 	}
+	for name := range files {
+		debugf("parsed file %s", name)
+	}
+	infof("parsed %d files from %s", len(files), source)
 	return files, nil
 }
 
@@ -197,6 +551,44 @@ type Value struct {
 	noPtr bool
 	// isFixed allows us to explicitly mark fixed at parse time
 	fixed bool
+	// iface is set if the Go field is an interface that holds a pointer
+	// to the concrete type described by this value
+	iface bool
+	// incremental is set if the root of the list can be updated incrementally
+	incremental bool
+	// nilEmpty decodes the empty list to nil instead of to an empty slice
+	nilEmpty bool
+	// min is the minimum number of elements of a list (ssz-min)
+	min uint64
+	// ext is the name of the []byte field of an extensible container that holds
+	// the bytes beyond the known fields
+	ext string
+	// encrypt is set if the field is encrypted by MarshalSSZEncrypted (ssz-encrypt)
+	encrypt bool
+	// transient are the methods of a container that populate the fields which are not
+	// encoded (ssz-transient), called in order after the container is decoded
+	transient []string
+	// bits is the number of bits of a bitvector encoded as fixed bytes, the bits
+	// of the last byte beyond it must be zero
+	bits uint64
+	// treeCache is the name of the *ssz.Node field that caches the tree of the
+	// container (ssz-tree-cache), which is not encoded
+	treeCache string
+	// optional is set if the pointer to the struct is encoded as an Optional[T]
+	// (ssz-optional), a nil pointer is absent and encoded with zero bytes
+	optional bool
+	// wrapper is the wrapper struct of the option of a union, the value is the
+	// single field of the wrapper
+	wrapper string
+	// lengthOf is the name of the framed field whose length is the value of
+	// this uint field (ssz-length-of)
+	lengthOf string
+	// lengthFrom is the name of the uint field with the length of this framed
+	// []byte field (ssz-length-from), which is encoded in place without an offset
+	lengthFrom string
+	// bitfield is the name of the type generated with the bit accessors of the
+	// bitlist or bitvector field (ssz-bitlist or ssz-bitvector)
+	bitfield string
 }
 
 func (v *Value) isListElem() bool {
@@ -212,6 +604,39 @@ func (v *Value) objRef() string {
 	return v.ref + "." + v.obj
 }
 
+// goType returns the Go type of the value
+func (v *Value) goType() string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			return v.objRef()
+		}
+		return "*" + v.objRef()
+	}
+	if v.obj != "" {
+		// alias of a basic type or collection
+		return v.objRef()
+	}
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("uint%d", v.s*8)
+	case TypeBool:
+		return "bool"
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("[%d]byte", v.s)
+		}
+		return "[]byte"
+	case TypeVector, TypeList:
+		if v.c {
+			return fmt.Sprintf("[%d]%s", v.s, v.e.goType())
+		}
+		return "[]" + v.e.goType()
+	default:
+		panic(fmt.Errorf("go type not implemented for type %s", v.t.String()))
+	}
+}
+
 func (v *Value) copy() *Value {
 	vv := new(Value)
 	*vv = *v
@@ -247,6 +672,14 @@ const (
 	TypeContainer
 	// TypeReference is a SSZ reference
 	TypeReference
+	// TypePackedBools is a SSZ bitvector with the bool fields of a container
+	TypePackedBools
+	// TypeCustom is a field encoded by the hand-written methods of its container
+	TypeCustom
+	// TypeReserved is a blank field with reserved bytes that are always zero
+	TypeReserved
+	// TypeUnion is a SSZ union of the wrapper types of a protobuf oneof field
+	TypeUnion
 )
 
 func (t Type) String() string {
@@ -269,6 +702,14 @@ func (t Type) String() string {
 		return "container"
 	case TypeReference:
 		return "reference"
+	case TypePackedBools:
+		return "packed bools"
+	case TypeCustom:
+		return "custom"
+	case TypeReserved:
+		return "reserved"
+	case TypeUnion:
+		return "union"
 	default:
 		panic("not found")
 	}
@@ -294,8 +735,68 @@ type env struct {
 	imports []*astImport
 	// excludeTypeNames is a map of type names to leave out of output
 	excludeTypeNames map[string]bool
+	// excludeFields is a map of the fields (Type.Field) that are not encoded
+	excludeFields map[string]bool
+	// excludedFields are the fields of excludeFields found in the parsed structs
+	excludedFields map[string]bool
+	// oneofs are the wrapper structs of the protobuf oneof interfaces of the package
+	oneofs map[string][]string
+	// testVectors generates the test files that write random test vectors
+	testVectors bool
+	// interfaceChecks generates compile time assertions of the ssz interfaces
+	interfaceChecks bool
+	// runtimeSchema registers the schemas of the types in ssz.SchemaRegistry
+	runtimeSchema bool
+	// compatTest is the import path of the reference library of the compatibility tests
+	compatTest string
+	// populate generates the test files with the PopulateSSZ methods
+	populate bool
+	// inplace appends the generated methods to the source files
+	inplace bool
+	// listHelpers generates the functions that encode the slices of the structs as lists
+	listHelpers bool
+	// fieldsEncoding generates the methods that encode a subset of the fields
+	fieldsEncoding bool
+	// offsets generates the methods that return the offsets of the dynamic fields
+	offsets bool
+	// nolint is the linter directive written before the package clause of the generated files
+	nolint string
+	// constraints are the build constraints of the source files, copied to the generated files
+	constraints map[string]constraint.Expr
+	// strictTags fails on the unknown ssz tags instead of warning about them
+	strictTags bool
+	// receiver is the name of the receiver of the generated methods
+	receiver string
+	// maxDepth is the maximum nesting of the types (0 if there is no limit)
+	maxDepth int
+	// depth is the current nesting of the parsed type
+	depth int
+	// maxReached is the deepest nesting reached while parsing the current type
+	maxReached int
+	// nesting is the nesting of the fields of the parsed objects
+	nesting map[string]int
+	// parsing are the objects that are being parsed
+	parsing map[string]bool
+	// maxErrors is the maximum number of parsing errors reported at once,
+	// a negative value reports all of them and 0 or 1 only the first one
+	maxErrors int
+	// errs are the parsing errors collected when more than one is reported
+	errs errorList
+	// failed are the objects that could not be parsed while collecting the errors
+	failed map[string]bool
+	// changed are the absolute paths of the changed files (nil if all the files changed)
+	changed map[string]bool
+	// nilEmptyLists decodes the empty lists to nil
+	nilEmptyLists bool
+	// format is the additional format generated with the ssz methods
+	format string
 }
 
+// defaultMaxDepth is the default maximum nesting of the types. The generated
+// code recurses as deep as the types are nested, so this also bounds the
+// stack used to decode untrusted input.
+const defaultMaxDepth = 64
+
 const encodingPrefix = "_encoding.go"
 
 func (e *env) generateOutputEncodings(output string, experimental bool) (map[string]string, error) {
@@ -308,8 +809,14 @@ func (e *env) generateOutputEncodings(output string, experimental bool) (map[str
 	sort.Strings(keys)
 
 	orders := []string{}
+	changed := false
 	for _, k := range keys {
 		orders = append(orders, e.order[k]...)
+		changed = changed || e.isChanged(k, e.order[k])
+	}
+	if _, err := os.Stat(output); err == nil && !changed && output != stdio {
+		// none of the files changed
+		return out, nil
 	}
 
 	res, ok, err := e.print(orders, experimental)
@@ -320,34 +827,115 @@ func (e *env) generateOutputEncodings(output string, experimental bool) (map[str
 		return nil, nil
 	}
 	out[output] = res
+
+	if e.testVectors {
+		res, ok, err := e.printTestVectors(orders)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out[strings.TrimSuffix(output, filepath.Ext(output))+testVectorsPrefix] = res
+		}
+	}
+	if e.compatTest != "" {
+		res, ok, err := e.printCompatTests(orders)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out[strings.TrimSuffix(output, filepath.Ext(output))+compatTestPrefix] = res
+		}
+	}
+	if e.populate {
+		res, ok, err := e.printPopulate(orders)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out[strings.TrimSuffix(output, filepath.Ext(output))+populatePrefix] = res
+		}
+	}
 	return out, nil
 }
 
 func (e *env) generateEncodings(experimental bool) (map[string]string, error) {
 	outs := map[string]string{}
 
-	for name, order := range e.order {
+	for file, order := range e.order {
 		// remove .go prefix and replace if with our own
-		ext := filepath.Ext(name)
-		name = strings.TrimSuffix(name, ext)
-		name += encodingPrefix
+		ext := filepath.Ext(file)
+		name := strings.TrimSuffix(file, ext)
 
-		vvv, ok, err := e.print(order, experimental)
-		if err != nil {
-			return nil, err
+		if e.inplace {
+			if !e.skipOutput(file, file, order) {
+				vvv, ok, err := e.printInplace(file, order, experimental)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					outs[file] = vvv
+				}
+			}
+		} else if !e.skipOutput(name+encodingPrefix, file, order) {
+			vvv, ok, err := e.print(order, experimental)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				outs[name+encodingPrefix] = vvv
+			}
 		}
-		if ok {
-			outs[name] = vvv
+
+		if e.testVectors && !e.skipOutput(name+testVectorsPrefix, file, order) {
+			vvv, ok, err := e.printTestVectors(order)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				outs[name+testVectorsPrefix] = vvv
+			}
+		}
+
+		if e.compatTest != "" && !e.skipOutput(name+compatTestPrefix, file, order) {
+			vvv, ok, err := e.printCompatTests(order)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				outs[name+compatTestPrefix] = vvv
+			}
+		}
+
+		if e.populate && !e.skipOutput(name+populatePrefix, file, order) {
+			vvv, ok, err := e.printPopulate(order)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				outs[name+populatePrefix] = vvv
+			}
 		}
 	}
 	return outs, nil
 }
 
 func (e *env) hashSource() (string, error) {
+	// the files are hashed in order since the map is not
+	names := make([]string, 0, len(e.files))
+	for name := range e.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	content := ""
-	for _, f := range e.files {
+	for _, name := range names {
+		file := e.files[name]
+		if e.inplace {
+			// the imports of the generated code are added to the source files
+			file = withoutImports(file)
+		}
 		var buf bytes.Buffer
-		if err := format.Node(&buf, token.NewFileSet(), f); err != nil {
+		if err := format.Node(&buf, token.NewFileSet(), file); err != nil {
 			return "", err
 		}
 		content += buf.String()
@@ -365,7 +953,10 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 
 	tmpl := `// Code generated by fastssz. DO NOT EDIT.
 	// Hash: {{.hash}}
-	package {{.package}}
+	{{if .build}}{{.build}}
+
+	{{end}}{{if .nolint}}{{.nolint}}
+	{{end}}package {{.package}}
 
 	import (
 		ssz "github.com/photon-storage/fastssz" {{ if .imports }}{{ range $value := .imports }}
@@ -376,19 +967,35 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 	{{ range .objs }}
 		{{ .Marshal }}
 		{{ .Unmarshal }}
+		{{ .MarshalFields }}
+		{{ .Varint }}
+		{{ .Encrypted }}
 		{{ .Size }}
+		{{ .Offsets }}
+		{{ .Unions }}
+		{{ .Bitfields }}
 		{{ .HashTreeRoot }}
+		{{ .MerkleProof }}
+		{{ .IsZero }}
+		{{ .CopyInto }}
+		{{ .ListHelpers }}
+		{{ .SchemaString }}
+		{{ .Incremental }}
 		{{ .GetTree }}
+		{{ .InterfaceChecks }}
+		{{ .RuntimeSchema }}
 	{{ end }}
 	`
 
 	data := map[string]interface{}{
 		"package": e.packName,
 		"hash":    hash,
+		"nolint":  e.nolint,
+		"build":   e.buildConstraint(order),
 	}
 
 	type Obj struct {
-		Size, Marshal, Unmarshal, HashTreeRoot, GetTree string
+		Size, Offsets, Unions, Bitfields, Marshal, Unmarshal, MarshalFields, Varint, Encrypted, HashTreeRoot, MerkleProof, IsZero, CopyInto, ListHelpers, SchemaString, Incremental, GetTree, InterfaceChecks, RuntimeSchema string
 	}
 
 	objs := []*Obj{}
@@ -408,22 +1015,59 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 		refs := detectImports(obj)
 		imports = appendWithoutRepeated(imports, refs)
 
-		if obj.isFixed() && isBasicType(obj) {
-			// we have an alias of a basic type (uint, bool). These objects
-			// will be encoded/decoded inside their parent container and do not
-			// require the sszgen functions.
+		if _, ok := e.printableObj(name); !ok {
 			continue
 		}
 		getTree := ""
 		if experimental {
 			getTree = e.getTree(name, obj)
+		} else if obj.treeCache != "" {
+			return "", false, fmt.Errorf("the ssz-tree-cache field of %s requires the experimental flag", name)
+		}
+		varint := ""
+		if e.format == formatVarint {
+			varint = e.marshalVarint(name, obj)
+		}
+		interfaceChecks := ""
+		if e.interfaceChecks {
+			interfaceChecks = e.interfaceAssertions(name)
+		}
+		listHelpers := ""
+		if e.listHelpers {
+			listHelpers = e.marshalList(name, obj)
+		}
+		marshalFields := ""
+		if e.fieldsEncoding {
+			marshalFields = e.marshalFields(name, obj)
+		}
+		offsets := ""
+		if e.offsets {
+			offsets = e.offsetsMethod(name, obj)
+		}
+		runtimeSchema := ""
+		if e.runtimeSchema {
+			runtimeSchema = e.registerSchema(name)
 		}
 		objs = append(objs, &Obj{
-			HashTreeRoot: e.hashTreeRoot(name, obj),
-			GetTree:      getTree,
-			Marshal:      e.marshal(name, obj),
-			Unmarshal:    e.unmarshal(name, obj),
-			Size:         e.size(name, obj),
+			HashTreeRoot:    e.hashTreeRoot(name, obj),
+			MerkleProof:     e.merkleProof(name, obj),
+			IsZero:          e.isZero(name, obj),
+			CopyInto:        e.copyInto(name, obj),
+			ListHelpers:     listHelpers,
+			SchemaString:    e.schemaString(name, obj),
+			Incremental:     e.incremental(name, obj),
+			GetTree:         getTree,
+			InterfaceChecks: interfaceChecks,
+			RuntimeSchema:   runtimeSchema,
+			Marshal:         e.marshal(name, obj),
+			Unmarshal:       e.unmarshal(name, obj),
+			MarshalFields:   marshalFields,
+			Varint:          varint,
+			Encrypted:       e.marshalEncrypted(name, obj),
+			Size:            e.size(name, obj),
+			Offsets:         offsets,
+			Unions:          e.unionMethods(name, obj),
+			Bitfields:       e.bitfieldTypes(name, obj),
 		})
 	}
 	if len(objs) == 0 {
@@ -438,12 +1082,31 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 		return "", false, err
 	}
 	if len(importsStr) != 0 {
+		debugf("detected the imports %s", strings.Join(importsStr, ", "))
 		data["imports"] = importsStr
 	}
 
 	return execTmpl(tmpl, data), true, nil
 }
 
+// printableObj returns the object if the sszgen functions are generated for it
+func (e *env) printableObj(name string) (*Value, bool) {
+	if exclude := e.excludeTypeNames[name]; exclude {
+		return nil, false
+	}
+	obj, ok := e.objs[name]
+	if !ok {
+		return nil, false
+	}
+	if obj.isFixed() && isBasicType(obj) {
+		// we have an alias of a basic type (uint, bool). These objects
+		// will be encoded/decoded inside their parent container and do not
+		// require the sszgen functions.
+		return nil, false
+	}
+	return obj, true
+}
+
 func isBasicType(v *Value) bool {
 	return v.t == TypeUint || v.t == TypeBool || v.t == TypeBytes
 }
@@ -493,6 +1156,9 @@ func detectImports(v *Value) []string {
 			ref = i.ref
 		case TypeList, TypeVector:
 			ref = i.e.ref
+		case TypeUnion:
+			// the options are the fields of the union
+			refs = append(refs, detectImports(i)...)
 		default:
 			ref = i.ref
 		}
@@ -506,10 +1172,67 @@ func detectImports(v *Value) []string {
 // All the generated functions use the '::' string to represent the pointer receiver
 // of the struct method (i.e 'm' in func(m *Method) XX()) for convenience.
 // This function replaces the '::' string with a valid one that corresponds
-// to the first letter of the method in lower case.
-func appendObjSignature(str string, v *Value) string {
+// to the receiver name of the object.
+func (e *env) appendObjSignature(str string, v *Value) string {
+	return strings.Replace(str, "::", e.receiverName(v), -1)
+}
+
+// defaultReceiver is the receiver used when the first letter of the
+// object collides with an identifier of the generated code
+const defaultReceiver = "x"
+
+// reservedNames are the identifiers declared by the generated code
+// that cannot be used as the receiver of the methods
+var reservedNames = map[string]bool{
+	"acc": true, "alloc": true, "buf": true, "cipher": true, "data": true, "dst": true, "elem": true, "err": true,
+	"field": true, "fields": true, "fixed": true, "hh": true, "i": true, "ii": true, "indx": true,
+	"leaf": true, "n": true, "num": true, "numItems": true, "obj": true, "offset": true,
+	"offsets": true, "ok": true, "present": true, "proof": true, "rnd": true, "selector": true, "size": true, "subIdx": true,
+	"src": true, "subIndx": true, "tail": true, "val": true, "w": true,
+	// packages imported by the generated code
+	"ssz": true, "fmt": true, "rand": true, "os": true, "filepath": true, "testing": true,
+}
+
+// receiverName returns the receiver of the generated methods of the object. By default,
+// it is the first letter of the object in lower case.
+func (e *env) receiverName(v *Value) string {
+	if e.receiver != "" {
+		return e.receiver
+	}
 	sig := strings.ToLower(string(v.name[0]))
-	return strings.Replace(str, "::", sig, -1)
+	if e.isReservedName(sig) {
+		return defaultReceiver
+	}
+	return sig
+}
+
+func (e *env) isReservedName(name string) bool {
+	if reservedNames[name] {
+		return true
+	}
+	if strings.HasPrefix(name, "o") {
+		// offsets of the dynamic fields (i.e. o1, o2)
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			return true
+		}
+	}
+	for _, imp := range e.imports {
+		if imp.match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateReceiver checks that the name can be used as the receiver of the generated methods
+func (e *env) validateReceiver(name string) error {
+	if !token.IsIdentifier(name) || name == "_" {
+		return fmt.Errorf("receiver '%s' is not a valid identifier", name)
+	}
+	if e.isReservedName(name) {
+		return fmt.Errorf("receiver '%s' collides with an identifier of the generated code", name)
+	}
+	return nil
 }
 
 type astStruct struct {
@@ -519,34 +1242,75 @@ type astStruct struct {
 	typ      ast.Expr
 	implFunc bool
 	isRef    bool
+	// alias is set if the type is an alias declaration (type T = U)
+	alias bool
+	// hooks are the methods without arguments and results (i.e. func (t *T) Hook())
+	hooks map[string]bool
+	// fieldFuncs are the methods that encode a single field (i.e. func (t *T) marshalFieldX(dst []byte) []byte)
+	// and whether their signature is the expected one
+	fieldFuncs map[string]bool
+}
+
+// isNamedSlice returns true if the type is a named slice (i.e. type Blob []byte), whose
+// length is given by the ssz-size and ssz-max tags of the fields of its type
+func (a *astStruct) isNamedSlice() bool {
+	if a.alias || a.obj != nil || a.implFunc {
+		return false
+	}
+	typ, ok := a.typ.(*ast.ArrayType)
+	return ok && typ.Len == nil
 }
 
 type astResult struct {
-	objs     []*astStruct
-	funcs    []string
+	objs []*astStruct
+	// funcs are the ssz methods implemented by hand for each object
+	funcs map[string][]string
+	// hooks are the methods without arguments and results of each object
+	hooks map[string][]string
+	// fieldFuncs are the methods that encode a single field of each object
+	fieldFuncs map[string]map[string]bool
+	// oneofs are the wrapper structs of each protobuf oneof interface
+	oneofs   map[string][]string
 	packName string
 }
 
+// generatedHeader is the first line of the files generated by fastssz
+const generatedHeader = "// Code generated by fastssz. DO NOT EDIT."
+
+// isGeneratedFile returns true if the file was generated by fastssz
+func isGeneratedFile(file *ast.File) bool {
+	return len(file.Comments) != 0 && len(file.Comments[0].List) != 0 && file.Comments[0].List[0].Text == generatedHeader
+}
+
 func decodeASTStruct(file *ast.File) *astResult {
 	packName := file.Name.String()
 
 	res := &astResult{
-		objs:     []*astStruct{},
-		funcs:    []string{},
-		packName: packName,
+		objs:       []*astStruct{},
+		funcs:      map[string][]string{},
+		hooks:      map[string][]string{},
+		fieldFuncs: map[string]map[string]bool{},
+		packName:   packName,
 	}
 
-	funcRefs := map[string]int{}
+	// the types declared by the generated files (i.e. the bitfield types) and their
+	// methods are created again with the types that declare them
+	generated := map[string]bool{}
 	for _, dec := range file.Decls {
 		if genDecl, ok := dec.(*ast.GenDecl); ok {
 			for _, spec := range genDecl.Specs {
 				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					if isGeneratedFile(file) {
+						generated[typeSpec.Name.Name] = true
+						continue
+					}
 					obj := &astStruct{
 						name:     typeSpec.Name.Name,
 						packName: packName,
+						alias:    typeSpec.Assign.IsValid(),
 					}
 					structType, ok := typeSpec.Type.(*ast.StructType)
-					if ok {
+					if ok && !obj.alias {
 						// type is a struct
 						obj.obj = structType
 					} else {
@@ -567,24 +1331,32 @@ func decodeASTStruct(file *ast.File) *astResult {
 			}
 			if expr, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr); ok {
 				// only allow pointer functions
-				if i, ok := expr.X.(*ast.Ident); ok {
+				if i, ok := expr.X.(*ast.Ident); ok && !generated[i.Name] {
 					objName := i.Name
 					if ok := isFuncDecl(funcDecl); ok {
-						funcRefs[objName]++
+						res.funcs[objName] = append(res.funcs[objName], funcDecl.Name.Name)
+					}
+					if funcDecl.Type.Params.NumFields() == 0 && funcDecl.Type.Results.NumFields() == 0 {
+						res.hooks[objName] = append(res.hooks[objName], funcDecl.Name.Name)
+					}
+					if _, _, ok := parseFieldFunc(funcDecl.Name.Name); ok {
+						if res.fieldFuncs[objName] == nil {
+							res.fieldFuncs[objName] = map[string]bool{}
+						}
+						res.fieldFuncs[objName][funcDecl.Name.Name] = isFieldFunc(funcDecl)
 					}
 				}
 			}
 		}
 	}
-	for name, count := range funcRefs {
-		if count == 4 {
-			// it implements all the interface functions
-			res.funcs = append(res.funcs, name)
-		}
-	}
+	res.oneofs = decodeOneofs(file, res)
 	return res
 }
 
+// sszMethods are the methods that an object has to implement by hand
+// to be used as a reference instead of generating its encoding
+var sszMethods = []string{"MarshalSSZTo", "UnmarshalSSZ", "SizeSSZ", "HashTreeRootWith"}
+
 func isSpecificFunc(funcDecl *ast.FuncDecl, in, out []string) bool {
 	check := func(types *ast.FieldList, args []string) bool {
 		list := types.List
@@ -617,6 +1389,44 @@ func isSpecificFunc(funcDecl *ast.FuncDecl, in, out []string) bool {
 	return true
 }
 
+// fieldMethods are the methods of a container that encode one of its fields by hand
+// instead of the generated code, the name of the field follows the prefix (i.e. marshalFieldSlot).
+// The signature of each method is given with the types of its arguments and results.
+var fieldMethods = []struct {
+	prefix    string
+	signature string
+	in, out   []string
+}{
+	{"marshalField", "(dst []byte) []byte", []string{"[]byte"}, []string{"[]byte"}},
+	{"unmarshalField", "(buf []byte) error", []string{"[]byte"}, []string{"error"}},
+	{"sizeField", "() int", []string{}, []string{"int"}},
+	{"hashTreeRootField", "(hh *ssz.Hasher) error", []string{"*ssz.Hasher"}, []string{"error"}},
+}
+
+// parseFieldFunc returns the prefix and the field of the name of a field method
+func parseFieldFunc(name string) (string, string, bool) {
+	for _, m := range fieldMethods {
+		if field := strings.TrimPrefix(name, m.prefix); field != name && isExportedField(field) {
+			return m.prefix, field, true
+		}
+	}
+	return "", "", false
+}
+
+// isFieldFunc returns true if the field method has the expected signature
+func isFieldFunc(funcDecl *ast.FuncDecl) bool {
+	prefix, _, ok := parseFieldFunc(funcDecl.Name.Name)
+	if !ok {
+		return false
+	}
+	for _, m := range fieldMethods {
+		if m.prefix == prefix {
+			return isSpecificFunc(funcDecl, m.in, m.out)
+		}
+	}
+	return false
+}
+
 func isFuncDecl(funcDecl *ast.FuncDecl) bool {
 	name := funcDecl.Name.Name
 	if name == "SizeSSZ" {
@@ -712,18 +1522,43 @@ func (e *env) generateIR() error {
 			}
 			i.isRef = isRef
 			e.addRawItem(i)
+			debugf("found struct %s of package %s (included: %v)", i.name, i.packName, isRef)
 		}
 		return nil
 	}
 
-	checkImplFunc := func(res *astResult) error {
-		// include all the functions that implement the interfaces
-		for _, name := range res.funcs {
-			v, ok := checkObjByPackage(res.packName, name)
-			if !ok {
-				return fmt.Errorf("cannot find %s struct", name)
+	checkImplFunc := func(results []*astResult) error {
+		// the methods of an object can be declared in different files
+		methods := map[*astStruct]map[string]bool{}
+		for _, res := range results {
+			for name, funcs := range res.funcs {
+				v, ok := checkObjByPackage(res.packName, name)
+				if !ok {
+					return fmt.Errorf("cannot find %s struct", name)
+				}
+				if methods[v] == nil {
+					methods[v] = map[string]bool{}
+				}
+				for _, f := range funcs {
+					methods[v][f] = true
+				}
+			}
+		}
+		// include all the objects that implement all the interface functions
+		for _, v := range e.raw {
+			found, missing := []string{}, []string{}
+			for _, f := range sszMethods {
+				if methods[v][f] {
+					found = append(found, f)
+				} else {
+					missing = append(missing, f)
+				}
+			}
+			if len(missing) == 0 {
+				v.implFunc = true
+			} else if len(found) != 0 && !v.isRef {
+				warn("%s implements %s by hand but not %s. The generated methods will conflict with the hand-written ones", v.name, strings.Join(found, ", "), strings.Join(missing, ", "))
 			}
-			v.implFunc = true
 		}
 		return nil
 	}
@@ -759,8 +1594,10 @@ func (e *env) generateIR() error {
 	astResults := []*astResult{}
 
 	// decode the structs from the input path
+	var packName string
 	for name, file := range e.files {
 		res := decodeASTStruct(file)
+		packName = res.packName
 		if err := addStructs(res, false); err != nil {
 			return err
 		}
@@ -779,43 +1616,179 @@ func (e *env) generateIR() error {
 	// decode the structs from the include path but ONLY include them on 'raw' not in 'order'.
 	// If the structs are in raw they can be used as a reference at compilation time and since they are
 	// not in 'order' they cannot be used to marshal/unmarshal encodings
-	for _, file := range e.include {
+	for name, file := range e.include {
 		res := decodeASTStruct(file)
 		if err := addStructs(res, true); err != nil {
 			return err
 		}
 
 		astResults = append(astResults, res)
-	}
-
-	for _, res := range astResults {
-		if err := checkImplFunc(res); err != nil {
-			return err
-		}
-	}
-
-	for _, obj := range e.raw {
-		name := obj.name
 
-		var valid bool
-		if e.targets == nil || len(e.targets) == 0 {
-			valid = true
-		} else {
-			valid = contains(name, e.targets)
-		}
-		if valid {
-			if obj.isRef {
-				// do not process imported elements
+		// the included structs listed explicitly in the targets are also generated,
+		// in the output of the file that declares them.
+		promoted := []string{}
+		for _, i := range res.objs {
+			if !contains(i.name, e.targets) {
 				continue
 			}
-			if _, err := e.encodeItem(name, ""); err != nil {
-				return err
+			if res.packName != packName {
+				return fmt.Errorf("cannot generate the included struct %s of package %s, methods can only be declared in the package %s", i.name, res.packName, packName)
 			}
+			i.isRef = false
+			promoted = append(promoted, i.name)
 		}
+		if len(promoted) != 0 {
+			e.order[name] = promoted
+		}
+	}
+
+	if err := checkImplFunc(astResults); err != nil {
+		return err
+	}
+	e.oneofs = map[string][]string{}
+	for _, res := range astResults {
+		// the oneof interfaces are unexported, only the ones of the package can be used
+		if res.packName != packName {
+			continue
+		}
+		for iface, wrappers := range res.oneofs {
+			e.oneofs[iface] = wrappers
+		}
+	}
+	for _, res := range astResults {
+		for name, hooks := range res.hooks {
+			if v, ok := checkObjByPackage(res.packName, name); ok {
+				if v.hooks == nil {
+					v.hooks = map[string]bool{}
+				}
+				for _, hook := range hooks {
+					v.hooks[hook] = true
+				}
+			}
+		}
+		for name, funcs := range res.fieldFuncs {
+			if v, ok := checkObjByPackage(res.packName, name); ok {
+				if v.fieldFuncs == nil {
+					v.fieldFuncs = map[string]bool{}
+				}
+				for fn, valid := range funcs {
+					v.fieldFuncs[fn] = valid
+				}
+			}
+		}
+	}
+	if len(e.targets) == 0 {
+		infof("generating all the structs")
+	} else {
+		infof("generating the structs %s", strings.Join(e.targets, ", "))
+	}
+
+	for _, obj := range e.raw {
+		name := obj.name
+
+		var valid bool
+		if e.targets == nil || len(e.targets) == 0 {
+			valid = true
+		} else {
+			valid = contains(name, e.targets)
+		}
+		if valid {
+			if obj.isRef {
+				// do not process imported elements
+				continue
+			}
+			if obj.alias {
+				// an alias does not have methods, it resolves to the encoding of the
+				// aliased type which is generated if it is a struct in this package.
+				if aliased, ok := obj.typ.(*ast.Ident); ok {
+					if raw, ok := e.getRawItemByName(aliased.Name); ok && !raw.isRef && raw.obj != nil {
+						if _, err := e.encodeItem(aliased.Name, ""); err != nil {
+							if err := e.reportError(err); err != nil {
+								return err
+							}
+						}
+					}
+				}
+				continue
+			}
+			if obj.isNamedSlice() {
+				// a named slice does not have methods, it is encoded with the tags of the fields
+				continue
+			}
+			if e.isOneofWrapper(name) {
+				// the wrapper of a oneof option is encoded by the container of the oneof
+				continue
+			}
+			if _, err := e.encodeItem(name, ""); err != nil {
+				if err := e.reportError(err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(e.errs) != 0 {
+		return e.errs
 	}
 	return nil
 }
 
+// errCollected is returned by the objects whose errors were collected in env.errs
+var errCollected = errors.New("the errors were collected")
+
+// errorList is the list of the parsing errors reported at once
+type errorList []error
+
+func (l errorList) Error() string {
+	if len(l) == 1 {
+		return l[0].Error()
+	}
+	msgs := []string{fmt.Sprintf("%d errors:", len(l))}
+	for _, err := range l {
+		msgs = append(msgs, "  "+err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// reportError collects the error of an object and returns the error that stops the
+// parsing, which is the error itself if the errors are not collected or the collected
+// ones once the maximum number of errors is reached.
+func (e *env) reportError(err error) error {
+	if !e.collecting() {
+		return err
+	}
+	if !errors.Is(err, errCollected) {
+		e.errs = append(e.errs, err)
+	}
+	if e.maxErrors > 0 && len(e.errs) >= e.maxErrors {
+		return e.errs[:e.maxErrors]
+	}
+	return nil
+}
+
+// collecting returns true if the parsing errors are collected in env.errs
+// instead of returning the first one
+func (e *env) collecting() bool {
+	return e.maxErrors < 0 || e.maxErrors > 1
+}
+
+// logObjs prints the fixed or dynamic size of the parsed objects
+func (e *env) logObjs() {
+	names := make([]string, 0, len(e.objs))
+	for name := range e.objs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		obj := e.objs[name]
+		if obj.isFixed() {
+			debugf("%s is fixed with %d bytes", name, obj.fixedSize())
+		} else {
+			debugf("%s is dynamic with %d fixed bytes", name, obj.fixedSize())
+		}
+	}
+}
+
 func contains(i string, j []string) bool {
 	for _, a := range j {
 		if a == i {
@@ -825,28 +1798,108 @@ func contains(i string, j []string) bool {
 	return false
 }
 
+// checkDepth returns an error if the type at the current depth with the
+// given nesting exceeds the max depth
+func (e *env) checkDepth(name string, nesting int) error {
+	depth := e.depth + nesting
+	if depth > e.maxReached {
+		e.maxReached = depth
+	}
+	if e.maxDepth != 0 && depth > e.maxDepth {
+		return fmt.Errorf("the nesting of %s exceeds the max depth %d", name, e.maxDepth)
+	}
+	return nil
+}
+
 func (e *env) encodeItem(name, tags string) (*Value, error) {
+	if e.nesting == nil {
+		e.nesting = map[string]int{}
+		e.parsing = map[string]bool{}
+		e.failed = map[string]bool{}
+	}
 	v, ok := e.objs[name]
-	if !ok {
+	if ok {
+		// the depth does not depend on the order in which the objects are parsed
+		if err := e.checkDepth(name, e.nesting[name]); err != nil {
+			return nil, err
+		}
+	} else {
 		var err error
 		raw, ok := e.getRawItemByName(name)
 		if !ok {
-			return nil, fmt.Errorf("could not find struct with name '%s'", name)
+			return nil, &typeError{fmt.Sprintf("could not find struct with name '%s'", name)}
+		}
+		if e.failed[name] {
+			return nil, errCollected
+		}
+		if e.parsing[name] {
+			return nil, fmt.Errorf("recursive type %s is not supported", name)
+		}
+		e.parsing[name] = true
+		defer delete(e.parsing, name)
+
+		if raw.alias {
+			// the alias is the same type as the aliased one and it is not stored
+			// since it cannot have its own methods
+			v, err := e.parseASTFieldType(name, tags, raw.typ)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode alias %s: %v", name, err)
+			}
+			return v, nil
+		}
+		if raw.isNamedSlice() {
+			// the dimensions of the named slice are given by the tags of each field of
+			// its type, so it is not stored and it is parsed again for each field
+			v, err := e.parseASTFieldType(name, tags, raw.typ)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %v", name, err)
+			}
+			v.obj = name
+			return v, nil
 		}
+
+		// track the nesting of the object to check it when it is referenced again
+		outer := e.maxReached
+		e.maxReached = e.depth
 		if raw.implFunc {
-			size, _ := getTagsInt(tags, "ssz-size")
+			size, ok := getTagsInt(tags, "ssz-size")
+			if !ok && raw.obj != nil {
+				size = e.referenceSize(name, raw.obj)
+			}
 			v = &Value{t: TypeReference, s: size, noPtr: raw.obj == nil}
 		} else if raw.obj != nil {
-			v, err = e.parseASTStructType(name, raw.obj)
+			if v, err = e.parseASTStructType(name, raw.obj); err == nil {
+				for _, hook := range v.transient {
+					if !raw.hooks[hook] {
+						err = fmt.Errorf("the ssz-transient method %s must be declared as func (x *%s) %s()", hook, name, hook)
+						break
+					}
+				}
+			}
 		} else {
 			v, err = e.parseASTFieldType(name, tags, raw.typ)
 		}
+		if err == nil {
+			err = v.checkLayout()
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to encode %s: %v", name, err)
+			if !e.collecting() {
+				return nil, fmt.Errorf("failed to encode %s: %v", name, err)
+			}
+			if !errors.Is(err, errCollected) {
+				e.errs = append(e.errs, fmt.Errorf("failed to encode %s: %v", name, err))
+			}
+			// the object is not parsed again when it is referenced by other objects
+			e.failed[name] = true
+			return nil, errCollected
 		}
 		v.name = name
 		v.obj = name
 		e.objs[name] = v
+		e.nesting[name] = e.maxReached - e.depth
+		if outer > e.maxReached {
+			e.maxReached = outer
+		}
 	}
 	return v.copy(), nil
 }
@@ -859,43 +1912,327 @@ func (e *env) parseASTStructType(name string, typ *ast.StructType) (*Value, erro
 		o:    []*Value{},
 	}
 
+	var packBools bool
+	var numFields uint64
+	var hasNumFields bool
+	// the fields are still parsed after an error when the errors are collected
+	var fieldsFailed bool
 	for _, f := range typ.Fields.List {
 		if len(f.Names) != 1 {
 			continue
 		}
 		name := f.Names[0].Name
+		if f.Tag != nil {
+			if err := e.checkTags(v.name, name, f.Tag.Value); err != nil {
+				return nil, err
+			}
+		}
+		if name == "_" && f.Tag != nil {
+			if _, ok := getTags(f.Tag.Value, "ssz-reserved"); ok {
+				reserved, err := reservedField(v.name, f.Tag.Value, f.Type)
+				if err != nil {
+					return nil, err
+				}
+				v.o = append(v.o, reserved)
+				continue
+			}
+			// blank fields hold the tags of the struct
+			if tag, ok := getTags(f.Tag.Value, "ssz-pack-bools"); ok {
+				if tag != "true" {
+					return nil, fmt.Errorf("ssz-pack-bools only accepts the value 'true' in %s", v.name)
+				}
+				packBools = true
+			}
+			if num, ok := getTagsInt(f.Tag.Value, "ssz-fields"); ok {
+				numFields, hasNumFields = num, true
+			} else if _, ok := getTags(f.Tag.Value, "ssz-fields"); ok {
+				return nil, fmt.Errorf("ssz-fields must be a number in %s", v.name)
+			}
+			continue
+		}
+		if f.Tag != nil {
+			if tag, ok := getTags(f.Tag.Value, "ssz-tree-cache"); ok {
+				// the field is not encoded but holds the tree built by GetTree
+				if tag != "true" {
+					return nil, fmt.Errorf("ssz-tree-cache only accepts the value 'true' in %s", name)
+				}
+				if !isNodePointer(f.Type) {
+					return nil, fmt.Errorf("ssz-tree-cache requires a *ssz.Node field, field %s", name)
+				}
+				if v.treeCache != "" {
+					return nil, fmt.Errorf("%s has more than one ssz-tree-cache field", v.name)
+				}
+				v.treeCache = name
+				continue
+			}
+			if hook, ok := getTags(f.Tag.Value, "ssz-transient"); ok {
+				// the field is not encoded but populated by the method after decoding
+				if hook == "" {
+					return nil, fmt.Errorf("ssz-transient requires the name of a method in field %s", name)
+				}
+				if !contains(hook, v.transient) {
+					v.transient = append(v.transient, hook)
+				}
+				continue
+			}
+		}
 		if !isExportedField(name) {
 			continue
 		}
+		if field := v.name + "." + name; e.excludeFields[field] {
+			// the encoding is not the one of the source struct
+			if !e.excludedFields[field] {
+				e.excludedFields[field] = true
+				warn("field %s is excluded by the exclude-fields flag, the encoding of %s is not compatible with the types that encode this field", field, v.name)
+			}
+			continue
+		}
 		if strings.HasPrefix(name, "XXX_") {
 			// skip protobuf methods
 			continue
 		}
+		if v.ext != "" {
+			return nil, fmt.Errorf("extension field %s must be the last field of %s", v.ext, v.name)
+		}
 		var tags string
 		if f.Tag != nil {
 			tags = f.Tag.Value
 		}
+		custom, err := e.customField(v.name, name, tags)
+		if err != nil {
+			return nil, err
+		}
+		if custom != nil {
+			v.o = append(v.o, custom)
+			continue
+		}
+		if tag, ok := getTags(tags, "ssz-inline"); ok {
+			if tag != "true" {
+				return nil, fmt.Errorf("ssz-inline only accepts the value 'true' in %s", name)
+			}
+			fields, err := e.inlineFields(name, tags, f.Type)
+			if err != nil {
+				return nil, err
+			}
+			v.o = append(v.o, fields...)
+			continue
+		}
+		if tag, ok := getTags(tags, "ssz-extensible"); ok {
+			if tag != "true" {
+				return nil, fmt.Errorf("ssz-extensible only accepts the value 'true' in %s", name)
+			}
+			if !isByteSlice(f.Type) {
+				return nil, fmt.Errorf("extension field %s must be a []byte", name)
+			}
+			v.ext = name
+			continue
+		}
 
-		elem, err := e.parseASTFieldType(name, tags, f.Type)
+		bitfield, tags, err := bitfieldTags(name, tags)
 		if err != nil {
 			return nil, err
 		}
+		if bitfield != "" && !isByteSlice(f.Type) {
+			return nil, fmt.Errorf("ssz-%s requires a []byte, field %s", bitfield, name)
+		}
+		elem, err := e.parseASTFieldType(name, tags, f.Type)
+		if err != nil {
+			var typeErr *typeError
+			if errors.As(err, &typeErr) {
+				// i.e. a sync.Mutex field
+				err = fmt.Errorf("%v. Use the ssz:\"-\" tag to skip the field %s if it is not serialized", err, name)
+			}
+			if !e.collecting() {
+				return nil, err
+			}
+			if !errors.Is(err, errCollected) {
+				e.errs = append(e.errs, fmt.Errorf("failed to encode %s.%s: %v", v.name, name, err))
+			}
+			fieldsFailed = true
+			continue
+		}
 		if elem == nil {
 			continue
 		}
 		elem.name = name
+		if bitfield != "" {
+			elem.bitfield = v.name + name
+			if _, ok := e.getRawItemByName(elem.bitfield); ok {
+				return nil, fmt.Errorf("the type %s of the %s field %s is already declared", elem.bitfield, bitfield, name)
+			}
+		}
+		if tag, ok := getTags(tags, "ssz-encrypt"); ok {
+			if tag != "true" {
+				return nil, fmt.Errorf("ssz-encrypt only accepts the value 'true' in %s", name)
+			}
+			elem.encrypt = true
+		}
+		if tag, ok := getTags(tags, "ssz-optional"); ok {
+			if tag != "true" {
+				return nil, fmt.Errorf("ssz-optional only accepts the value 'true' in %s", name)
+			}
+			if _, isPtr := f.Type.(*ast.StarExpr); !isPtr || elem.iface || elem.noPtr || (elem.t != TypeContainer && elem.t != TypeReference) {
+				return nil, fmt.Errorf("ssz-optional requires a pointer to a struct, field %s", name)
+			}
+			elem.optional = true
+		}
+		if tag, ok := getTags(tags, "ssz-length-of"); ok {
+			if tag == "" {
+				return nil, fmt.Errorf("ssz-length-of requires the name of a field in field %s", name)
+			}
+			elem.lengthOf = tag
+		}
+		if tag, ok := getTags(tags, "ssz-length-from"); ok {
+			if tag == "" {
+				return nil, fmt.Errorf("ssz-length-from requires the name of a field in field %s", name)
+			}
+			elem.lengthFrom = tag
+		}
 		v.o = append(v.o, elem)
 	}
 
+	if fieldsFailed {
+		return nil, errCollected
+	}
+	if hasNumFields && uint64(len(v.o)) != numFields {
+		return nil, fmt.Errorf("%s has %d ssz fields but ssz-fields expects %d", v.name, len(v.o), numFields)
+	}
+	if packBools {
+		if v.hasEncryptedFields() {
+			return nil, fmt.Errorf("ssz-encrypt is not supported with ssz-pack-bools in %s", v.name)
+		}
+		if err := v.packBools(); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.checkFramed(); err != nil {
+		return nil, err
+	}
+	if v.ext != "" {
+		// the extension is delimited by the end of the known fields
+		for _, f := range v.o {
+			if !f.isFixed() {
+				return nil, fmt.Errorf("ssz-extensible requires fixed size fields but %s is dynamic in %s", f.name, v.name)
+			}
+		}
+	}
 	return v, nil
 }
 
+// inlineFields returns the fields of the struct of a field tagged with ssz-inline, which are
+// encoded as if they were fields of the parent. Their names are the path from the parent
+// (i.e. Timing.Slot) so that the generated code reaches them through the struct value.
+func (e *env) inlineFields(name, tags string, expr ast.Expr) ([]*Value, error) {
+	if _, ok := expr.(*ast.Ident); !ok {
+		return nil, fmt.Errorf("ssz-inline requires a struct value of the same package, field %s", name)
+	}
+	elem, err := e.parseASTFieldType(name, tags, expr)
+	if err != nil {
+		return nil, err
+	}
+	if elem == nil || elem.t != TypeContainer || elem.ref != "" {
+		return nil, fmt.Errorf("ssz-inline requires a struct value of the same package, field %s", name)
+	}
+	if elem.ext != "" || elem.treeCache != "" || len(elem.transient) != 0 {
+		return nil, fmt.Errorf("ssz-inline cannot flatten %s into the parent since it has extensible, transient or tree cache fields, field %s", elem.obj, name)
+	}
+	fields := make([]*Value, 0, len(elem.o))
+	for _, f := range elem.o {
+		if f.t == TypeCustom || f.t == TypePackedBools || f.t == TypeUnion {
+			return nil, fmt.Errorf("ssz-inline cannot flatten %s into the parent since its packed bools, custom and oneof fields are encoded by %s, field %s", elem.obj, elem.obj, name)
+		}
+		f.name = name + "." + f.name
+		// the incremental helpers are declared by the struct of the inlined field
+		f.incremental = false
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// customField returns the value of a field encoded by the hand-written methods of the
+// container (i.e. marshalFieldX for the field X) or nil if the container does not declare them.
+// The field is dynamic unless its size is given with the ssz-size tag.
+func (e *env) customField(obj, name, tags string) (*Value, error) {
+	if tag, ok := getTags(tags, "ssz"); ok && tag == "-" {
+		return nil, nil
+	}
+	raw, ok := e.getRawItemByName(obj)
+	if !ok {
+		return nil, nil
+	}
+	found := false
+	missing := []string{}
+	for _, m := range fieldMethods {
+		valid, ok := raw.fieldFuncs[m.prefix+name]
+		found = found || ok
+		if !valid {
+			missing = append(missing, fmt.Sprintf("func (x *%s) %s%s%s", obj, m.prefix, name, m.signature))
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+	if len(missing) != 0 {
+		return nil, fmt.Errorf("field %s of %s is encoded by the methods of the container but it does not declare %s", name, obj, strings.Join(missing, ", "))
+	}
+	v := &Value{name: name, t: TypeCustom}
+	if size, ok := getTagsInt(tags, "ssz-size"); ok {
+		v.s, v.fixed = size, true
+	}
+	return v, nil
+}
+
+// isNodePointer returns true if the type is a pointer to the Node of the ssz package
+func isNodePointer(expr ast.Expr) bool {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Node"
+}
+
+// validateListMax rejects a list with ssz-max 0 since it can only be empty,
+// which is almost always a tagging error, unless the ssz-allow-empty-max tag is set.
+func validateListMax(name, tags string, max uint64) error {
+	if max != 0 {
+		return nil
+	}
+	if tag, ok := getTags(tags, "ssz-allow-empty-max"); ok && tag == "true" {
+		return nil
+	}
+	return fmt.Errorf("field %s has ssz-max 0 and can only be empty. Use the ssz-allow-empty-max:\"true\" tag if this is intended", name)
+}
+
+// isByteSlice returns true if the expression is a []byte or a []uint8
+func isByteSlice(expr ast.Expr) bool {
+	arr, ok := expr.(*ast.ArrayType)
+	if !ok || arr.Len != nil {
+		return false
+	}
+	ident, ok := arr.Elt.(*ast.Ident)
+	return ok && isByteIdent(ident.Name)
+}
+
 // parse the Go AST field
 func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error) {
+	e.depth++
+	defer func() {
+		e.depth--
+	}()
+	if err := e.checkDepth(name, 0); err != nil {
+		return nil, err
+	}
+
 	if tag, ok := getTags(tags, "ssz"); ok && tag == "-" {
 		// omit value
 		return nil, nil
 	}
+	if concrete, ok := getTags(tags, "ssz-concrete"); ok {
+		if _, isArray := expr.(*ast.ArrayType); !isArray {
+			return e.parseConcreteType(name, tags, concrete)
+		}
+	}
 
 	switch obj := expr.(type) {
 	case *ast.StarExpr:
@@ -903,27 +2240,57 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		switch elem := obj.X.(type) {
 		case *ast.Ident:
 			// reference to a local package
-			return e.encodeItem(elem.Name, tags)
+			v, err := e.encodeItem(elem.Name, tags)
+			if err != nil {
+				return nil, err
+			}
+			if v.t == TypeContainer {
+				// alias of an external struct (type T = ext.T) referenced as a pointer
+				v.noPtr = false
+			}
+			return v, nil
 
 		case *ast.SelectorExpr:
 			// reference of the external package
-			ref := elem.X.(*ast.Ident).Name
+			ref, err := selectorPackage(name, elem)
+			if err != nil {
+				return nil, err
+			}
 			// reference to a struct from another package
 			v, err := e.encodeItem(elem.Sel.Name, tags)
 			if err != nil {
 				return nil, err
 			}
+			if err := checkExternalMethods(name, ref, v); err != nil {
+				return nil, err
+			}
 			v.ref = ref
 			return v, nil
 
 		default:
-			return nil, fmt.Errorf("cannot handle %s", elem)
+			return nil, &typeError{fmt.Sprintf("field %s has an unsupported pointer to %s type '%s'", name, describeExpr(elem), types.ExprString(obj))}
 		}
 
 	case *ast.ArrayType:
-		dims, err := extractSSZDimensions(tags)
+		depth, isByte := arrayDepth(obj)
+		dims, ok := fixedArrayDimensions(obj, tags)
+		if !ok {
+			var err error
+			if dims, err = extractSSZDimensions(tags, depth); err != nil {
+				return nil, err
+			}
+		}
+		// every dimension of a byte array must be sized by the tags
+		if isByte && depth != len(dims) {
+			return nil, fmt.Errorf("field %s has %d Go array dimensions but ssz-size/ssz-max specify %d", name, depth, len(dims))
+		}
+		// explicit kind of the byte collection (i.e. 'ssz:"list"')
+		byteKind, err := byteCollectionKind(tags)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to parse field %s: %v", name, err)
+		}
+		if byteKind == byteKindBitvector && obj.Len != nil {
+			return nil, fmt.Errorf("ssz:\"bitvector\" requires a []byte, field %s", name)
 		}
 
 		collectionExpr := obj
@@ -932,12 +2299,15 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		for _, dim := range dims {
 			if dim.IsVector() {
 				collection.t = TypeVector
-				collection.s = uint64(dim.VectorLen())
+				collection.s = dim.VectorLen()
 			}
 			if dim.IsList() {
+				if err := validateListMax(name, tags, dim.ListLen()); err != nil {
+					return nil, err
+				}
 				collection.t = TypeList
-				collection.m = uint64(dim.ListLen())
-				collection.s = uint64(dim.ListLen())
+				collection.m = dim.ListLen()
+				collection.s = dim.ListLen()
 			}
 
 			// If we're looking at a fixed-size array, attempt to grab the parsed size value. from go/ast
@@ -977,9 +2347,16 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 				collection = collection.e
 				continue
 			case *ast.Ident:
+				if raw, ok := e.getRawItemByName(eeType.Name); ok && raw.isNamedSlice() {
+					// the named slice (i.e. []Blob) is the next dimension of the tags
+					collectionExpr = raw.typ.(*ast.ArrayType)
+					collection.e = &Value{obj: eeType.Name}
+					collection = collection.e
+					continue
+				}
 				// this condition is preserving the special nesting of byte,
 				// because byte has special handling in the code generator templates.
-				if eeType.Name == "byte" {
+				if isByteIdent(eeType.Name) {
 					// note that we are overwriting the list/vector types and replacing them with TypeBytes
 					// TypeBytes can either be a list or vector (determined by looking at the isFixed result)
 					collection.t = TypeBytes
@@ -991,6 +2368,15 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 					if dim.IsBitlist() {
 						collection.t = TypeBitList
 					}
+					if err := validateByteKind(byteKind, dim); err != nil {
+						return nil, fmt.Errorf("failed to parse field %s: %v", name, err)
+					}
+					if byteKind == byteKindBitvector {
+						// the size of the bitvector is in bits
+						collection.bits = collection.s
+						collection.s = (collection.bits + 7) / 8
+					}
+					byteKind = ""
 					continue
 				} else {
 					// anything else should recurse to the basic *ast.Ident case defined just below this ArrayType case
@@ -1008,6 +2394,43 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 				collection.e = element
 			}
 		}
+		if byteKind != "" {
+			return nil, fmt.Errorf("ssz:\"%s\" is only supported for byte collections, field %s", byteKind, name)
+		}
+		if collection.e != nil && collection.e.iface {
+			return nil, fmt.Errorf("ssz-concrete is not supported for the elements of the collection %s", name)
+		}
+		if collection.e != nil && collection.e.t == TypeUnion {
+			return nil, fmt.Errorf("oneof fields are not supported as the elements of the collection %s", name)
+		}
+		for c := outer; c.e != nil; c = c.e {
+			if c.e.t == TypeList || c.e.t == TypeVector {
+				// only the byte collections can be nested (i.e. [][]byte or [][32]byte)
+				return nil, fmt.Errorf("field %s is a %s of %ss, nested collections are only supported for bytes", name, c.t, c.e.t)
+			}
+		}
+		if elem := collection.e; collection.t == TypeList && elem != nil && elem.t == TypeContainer && elem.isFixed() && elem.fixedSize() == 0 {
+			// the number of elements of the list cannot be decoded from zero bytes
+			return nil, fmt.Errorf("field %s is a list of the empty container %s, which has no bytes to count its elements", name, elem.obj)
+		}
+		if tag, ok := getTags(tags, "ssz-incremental"); ok && tag == "true" {
+			if err := outer.validateIncremental(name); err != nil {
+				return nil, err
+			}
+			outer.incremental = true
+		}
+		if tag, ok := getTags(tags, "ssz-min"); ok {
+			if err := outer.parseMin(name, tag); err != nil {
+				return nil, err
+			}
+		}
+		if e.nilEmptyLists {
+			for c := outer; c != nil; c = c.e {
+				if c.t == TypeList || (c.t == TypeBytes && !c.isFixed()) {
+					c.nilEmpty = true
+				}
+			}
+		}
 		return outer, nil
 	case *ast.Ident:
 		// basic type
@@ -1024,17 +2447,24 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		case "bool":
 			v = &Value{t: TypeBool, s: 1}
 		default:
+			if _, ok := e.oneofs[obj.Name]; ok {
+				// protobuf oneof
+				return e.parseUnion(name, obj.Name)
+			}
 			// try to resolve as an alias
 			vv, err := e.encodeItem(obj.Name, tags)
 			if err != nil {
-				return nil, fmt.Errorf("type %s not found", obj.Name)
+				return nil, &typeError{fmt.Sprintf("type %s not found", obj.Name)}
 			}
 			return vv, nil
 		}
 		return v, nil
 
 	case *ast.SelectorExpr:
-		name := obj.X.(*ast.Ident).Name
+		pkg, err := selectorPackage(name, obj)
+		if err != nil {
+			return nil, err
+		}
 		sel := obj.Sel.Name
 
 		if sel == "Bitlist" {
@@ -1043,10 +2473,13 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			if !ok {
 				return nil, fmt.Errorf("bitlist %s does not have ssz-max tag", name)
 			}
+			if err := validateListMax(name, tags, maxSize); err != nil {
+				return nil, err
+			}
 			return &Value{t: TypeBitList, m: maxSize, s: maxSize}, nil
 		} else if strings.HasPrefix(sel, "Bitvector") {
 			// go-bitfield/Bitvector, fixed bytes
-			dims, err := extractSSZDimensions(tags)
+			dims, err := extractSSZDimensions(tags, 0)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse ssz-size tag for bitvector %s, err=%s", name, err)
 			}
@@ -1057,22 +2490,149 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			if !tailDim.IsVector() {
 				return nil, fmt.Errorf("bitvector tag parse failed (no ssz-size for last dim) %s, err=%s", name, err)
 			}
-			return &Value{t: TypeBytes, fixed: true, s: uint64(tailDim.VectorLen())}, nil
+			v := &Value{t: TypeBytes, fixed: true, s: tailDim.VectorLen()}
+			// the number of bits is the suffix of the type (i.e. Bitvector4)
+			if bits, err := strconv.ParseUint(strings.TrimPrefix(sel, "Bitvector"), 10, 64); err == nil {
+				if (bits+7)/8 != v.s {
+					return nil, fmt.Errorf("bitvector %s of %d bits has ssz-size %d instead of %d bytes", name, bits, v.s, (bits+7)/8)
+				}
+				v.bits = bits
+			}
+			return v, nil
 		}
 		// external reference
 		vv, err := e.encodeItem(sel, tags)
 		if err != nil {
 			return nil, err
 		}
-		vv.ref = name
+		if err := checkExternalMethods(name, pkg, vv); err != nil {
+			return nil, err
+		}
+		vv.ref = pkg
 		vv.noPtr = true
 		return vv, nil
 
 	default:
-		panic(fmt.Errorf("ast type '%s' not expected", reflect.TypeOf(expr)))
+		return nil, &typeError{fmt.Sprintf("field %s has an unsupported %s type '%s'", name, describeExpr(expr), types.ExprString(expr))}
 	}
 }
 
+// parseMin sets the minimum length of the list from the 'ssz-min' tag
+func (v *Value) parseMin(name, tag string) error {
+	min, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse ssz-min tag of field %s: %v", name, err)
+	}
+	var max uint64
+	switch {
+	case v.t == TypeList:
+		max = v.s
+	case v.t == TypeBytes && !v.isFixed():
+		max = v.m
+	default:
+		return fmt.Errorf("ssz-min is only supported for lists, field %s", name)
+	}
+	if min > max {
+		return fmt.Errorf("ssz-min %d is higher than ssz-max %d in field %s", min, max, name)
+	}
+	v.min = min
+	return nil
+}
+
+// referenceSize returns the size of a struct with hand-written methods if it is fixed
+// (i.e. used by value in another struct). The size is known from its fields if they are
+// encoded as the generated code would do, otherwise the struct is dynamic unless it has
+// a ssz-size tag.
+func (e *env) referenceSize(name string, obj *ast.StructType) uint64 {
+	// the fields are only parsed to know the size, the objects they reference are not generated
+	objs := make(map[string]*Value, len(e.objs))
+	for k, v := range e.objs {
+		objs[k] = v
+	}
+	// the errors of the fields are not reported since the methods are hand-written
+	maxErrors := e.maxErrors
+	e.maxErrors = 1
+	defer func() {
+		e.objs = objs
+		e.maxErrors = maxErrors
+	}()
+
+	v, err := e.parseASTStructType(name, obj)
+	if err != nil || !v.isFixed() {
+		debugf("%s has hand-written methods and is dynamic", name)
+		return 0
+	}
+	debugf("%s has hand-written methods and is fixed with %d bytes", name, v.fixedSize())
+	return v.fixedSize()
+}
+
+// checkExternalMethods returns an error if the value is a struct of another package
+// that does not implement the ssz methods, since the generated code calls them. The
+// methods of the included structs are only found if their generated files are included.
+func checkExternalMethods(name, pkg string, v *Value) error {
+	if v.t != TypeContainer {
+		return nil
+	}
+	return fmt.Errorf("field %s references the struct %s.%s which does not implement the ssz methods. Generate them in the package %s and include the package with its generated files", name, pkg, v.obj, pkg)
+}
+
+// typeError is the error of a field with a type that cannot be encoded
+type typeError struct {
+	msg string
+}
+
+func (t *typeError) Error() string {
+	return t.msg
+}
+
+// describeExpr returns a human readable description of the kind of type of the expression
+func describeExpr(expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.FuncType:
+		return "function"
+	case *ast.ChanType:
+		return "channel"
+	case *ast.MapType:
+		return "map"
+	case *ast.InterfaceType:
+		return "interface"
+	case *ast.StructType:
+		return "anonymous struct"
+	default:
+		return "Go"
+	}
+}
+
+// selectorPackage returns the package of a qualified type (i.e. 'ext' in ext.Checkpoint).
+// Selectors with more than one level (i.e. a.b.C) are not valid types.
+func selectorPackage(name string, expr *ast.SelectorExpr) (string, error) {
+	ident, ok := expr.X.(*ast.Ident)
+	if !ok {
+		return "", fmt.Errorf("field %s has an unsupported qualified type %s, only package.Type selectors are supported", name, types.ExprString(expr))
+	}
+	return ident.Name, nil
+}
+
+// parseConcreteType parses an interface field with a 'ssz-concrete' tag. The field
+// is encoded as the concrete type it holds, which must implement the ssz interfaces.
+func (e *env) parseConcreteType(name, tags, concrete string) (*Value, error) {
+	var ref string
+	if spl := strings.Split(concrete, "."); len(spl) == 2 {
+		ref, concrete = spl[0], spl[1]
+	}
+	v, err := e.encodeItem(concrete, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse concrete type %s of field %s: %v", concrete, name, err)
+	}
+	if v.t != TypeContainer && v.t != TypeReference {
+		return nil, fmt.Errorf("concrete type %s of field %s does not implement the ssz interfaces", concrete, name)
+	}
+	v.ref = ref
+	v.noPtr = false
+	v.iface = true
+	return v, nil
+}
+
 func isExportedField(str string) bool {
 	return str[0] <= 90
 }
@@ -1083,51 +2643,188 @@ func getTagsInt(str string, field string) (uint64, bool) {
 	if !ok {
 		return 0, false
 	}
-	num, err := strconv.Atoi(numStr)
+	num, err := strconv.ParseUint(strings.TrimSpace(numStr), 10, 64)
 	if err != nil {
 		return 0, false
 	}
-	return uint64(num), true
+	return num, true
 }
 
-// getTags returns the tags from a given field
-func getTags(str string, field string) (string, bool) {
-	str = strings.Trim(str, "`")
-
-	for _, tag := range strings.Split(str, " ") {
-		if !strings.Contains(tag, ":") {
-			return "", false
+// fixedArrayDimensions returns the dimensions of a Go array with fixed lengths
+// (i.e. [32]byte) which does not require the ssz-size tag.
+func fixedArrayDimensions(typ *ast.ArrayType, tags string) ([]*SSZDimension, bool) {
+	if _, ok := getTags(tags, "ssz-size"); ok {
+		return nil, false
+	}
+	if _, ok := getTags(tags, "ssz-max"); ok {
+		return nil, false
+	}
+	dims := []*SSZDimension{}
+	for {
+		lit, ok := typ.Len.(*ast.BasicLit)
+		if !ok {
+			// slice or a length that is not a literal
+			return nil, false
 		}
-		spl := strings.Split(tag, ":")
-		if len(spl) != 2 {
-			return "", false
+		size, err := strconv.ParseUint(lit.Value, 0, 64)
+		if err != nil {
+			return nil, false
 		}
+		dims = append(dims, &SSZDimension{VectorLength: &size})
 
-		tagName, vals := spl[0], spl[1]
-		if !strings.HasPrefix(vals, "\"") || !strings.HasSuffix(vals, "\"") {
-			return "", false
+		elem, ok := typ.Elt.(*ast.ArrayType)
+		if !ok {
+			return dims, true
 		}
-		if tagName != field {
+		typ = elem
+	}
+}
+
+// arrayDepth returns the number of nested Go arrays of the type and
+// whether the element of the inner-most array is a byte.
+func arrayDepth(typ *ast.ArrayType) (int, bool) {
+	depth := 1
+	for {
+		switch elem := typ.Elt.(type) {
+		case *ast.ArrayType:
+			typ = elem
+			depth++
+		case *ast.Ident:
+			return depth, isByteIdent(elem.Name)
+		default:
+			return depth, false
+		}
+	}
+}
+
+// isByteIdent returns true if the identifier is a byte. Since uint8 is the
+// same type as byte, both collections are encoded as byte collections.
+func isByteIdent(name string) bool {
+	return name == "byte" || name == "uint8"
+}
+
+const (
+	// byteKindList is the explicit kind of a byte list (i.e. 'ssz:"list"')
+	byteKindList = "list"
+	// byteKindVector is the explicit kind of a byte vector (i.e. 'ssz:"vector"')
+	byteKindVector = "vector"
+	// byteKindBitvector is the explicit kind of a bitvector whose ssz-size is
+	// the number of bits (i.e. 'ssz:"bitvector"')
+	byteKindBitvector = "bitvector"
+)
+
+// byteCollectionKind returns the explicit kind of a byte collection set
+// with the ssz tag or an empty string if the kind is inferred from the bounds.
+func byteCollectionKind(tags string) (string, error) {
+	tag, ok := getTags(tags, "ssz")
+	if !ok {
+		return "", nil
+	}
+	kind := ""
+	for _, p := range strings.Split(tag, ",") {
+		if p != byteKindList && p != byteKindVector && p != byteKindBitvector {
 			continue
 		}
+		if kind != "" && kind != p {
+			return "", fmt.Errorf("a byte collection cannot be both a %s and a %s", kind, p)
+		}
+		kind = p
+	}
+	return kind, nil
+}
 
-		vals = strings.Trim(vals, "\"")
-		return vals, true
+// validateByteKind checks that the explicit kind of a byte collection
+// matches the bound provided with the ssz-size or ssz-max tags.
+func validateByteKind(kind string, dim *SSZDimension) error {
+	switch kind {
+	case byteKindVector:
+		if dim.IsBitlist() {
+			return fmt.Errorf("a bitlist cannot be a vector")
+		}
+		if !dim.IsVector() {
+			return fmt.Errorf("ssz:\"vector\" requires a ssz-size tag")
+		}
+	case byteKindList:
+		if !dim.IsList() {
+			return fmt.Errorf("ssz:\"list\" requires a ssz-max tag")
+		}
+	case byteKindBitvector:
+		if dim.IsBitlist() {
+			return fmt.Errorf("a bitlist cannot be a bitvector")
+		}
+		if !dim.IsVector() {
+			return fmt.Errorf("ssz:\"bitvector\" requires a ssz-size tag with the number of bits")
+		}
 	}
-	return "", false
+	return nil
+}
+
+// getTags returns the tags from a given field
+// sszTags are the struct tags recognized by the generator
+var sszTags = map[string]bool{
+	"ssz": true, "ssz-size": true, "ssz-max": true, "ssz-min": true, "ssz-allow-empty-max": true,
+	"ssz-bitlist": true, "ssz-bitvector": true, "ssz-concrete": true, "ssz-encrypt": true, "ssz-extensible": true, "ssz-fields": true,
+	"ssz-incremental": true, "ssz-inline": true, "ssz-length-from": true, "ssz-length-of": true,
+	"ssz-optional": true, "ssz-pack-bools": true, "ssz-reserved": true, "ssz-transient": true,
+	"ssz-tree-cache": true,
+}
+
+// tagKeys returns the keys of the struct tags in order, following the conventions
+// of reflect.StructTag (i.e. 'ssz-size:"32" json:"root"')
+func tagKeys(str string) []string {
+	tag := strings.Trim(str, "`")
+	keys := []string{}
+	for {
+		tag = strings.TrimLeft(tag, " ")
+		colon := strings.Index(tag, ":")
+		if colon <= 0 || strings.ContainsAny(tag[:colon], " \"\t") {
+			return keys
+		}
+		value, err := strconv.QuotedPrefix(tag[colon+1:])
+		if err != nil {
+			return keys
+		}
+		keys = append(keys, tag[:colon])
+		tag = tag[colon+1+len(value):]
+	}
+}
+
+// checkTags reports the tags of the field that start with ssz but are not recognized
+// (i.e. a misspelled 'ssz-mx'), which would be silently ignored. They are errors with
+// the strict-tags flag and warnings otherwise.
+func (e *env) checkTags(obj, name, tags string) error {
+	for _, key := range tagKeys(tags) {
+		if !strings.HasPrefix(key, "ssz") || sszTags[key] {
+			continue
+		}
+		if e.strictTags {
+			return fmt.Errorf("unknown tag %s in field %s of %s", key, name, obj)
+		}
+		warn("unknown tag %s in field %s of %s is ignored. Use the -strict-tags flag to fail on the unknown tags", key, name, obj)
+	}
+	return nil
+}
+
+func getTags(str string, field string) (string, bool) {
+	// the values may have spaces (i.e. 'ssz-size:"32, 48"')
+	return reflect.StructTag(strings.Trim(str, "`")).Lookup(field)
 }
 
 func (v *Value) isFixed() bool {
+	if v.optional {
+		// the absent value has no bytes
+		return false
+	}
 	switch v.t {
 	// fixed size primitive types
-	case TypeUint, TypeBool:
+	case TypeUint, TypeBool, TypePackedBools, TypeReserved:
 		return true
 	// dynamic collection types
 	case TypeList, TypeBitList:
 		return false
 	case TypeVector:
 		if v.e.t == TypeUndefined {
-			fmt.Printf("%s", v.name)
+			debugf("vector %s has an element of undefined type", v.name)
 		}
 		return v.e.isFixed()
 	case TypeBytes:
@@ -1139,9 +2836,13 @@ func (v *Value) isFixed() bool {
 		// critical that we set this correctly since the zero-value is false
 		return false
 	case TypeContainer:
+		if v.ext != "" {
+			// the extension has a variable size
+			return false
+		}
 		for _, f := range v.o {
 			if f.t == TypeUndefined {
-				fmt.Printf("%s %s", v.name, f.name)
+				debugf("field %s of %s has an undefined type", f.name, v.name)
 			}
 			// if any contained value is not fixed, it is not fixed
 			if !f.isFixed() {
@@ -1155,6 +2856,12 @@ func (v *Value) isFixed() bool {
 			return true
 		}
 		return false
+	case TypeCustom:
+		// the size of the custom field is given by its ssz-size tag
+		return v.fixed
+	case TypeUnion:
+		// the selector is followed by the value of the option
+		return false
 	default:
 		// TypeUndefined should be the only type to fallthrough to this case
 		// TypeUndefined always means there is a fatal error in the parsing logic
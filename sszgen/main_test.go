@@ -0,0 +1,2407 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// newTestEnv parses the Go sources into an environment ready to generate the IR
+func newTestEnv(t *testing.T, srcs ...string) *env {
+	t.Helper()
+
+	files := map[string]*ast.File{}
+	for indx, src := range srcs {
+		name := fmt.Sprintf("input%d.go", indx)
+		file, err := parser.ParseFile(token.NewFileSet(), name, src, parser.AllErrors)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = file
+	}
+	return &env{
+		include:          map[string]*ast.File{},
+		files:            files,
+		objs:             map[string]*Value{},
+		packName:         files["input0.go"].Name.Name,
+		excludeTypeNames: map[string]bool{},
+		maxDepth:         defaultMaxDepth,
+	}
+}
+
+// buildTestEncodings generates the encodings of the Go source in a temporary package of the
+// module and checks that the package builds. It returns the generated code.
+func buildTestEncodings(t *testing.T, src string, opts encodeOptions) string {
+	t.Helper()
+
+	// the package is in the module to import fastssz, the underscore hides it from ./...
+	dir, err := ioutil.TempDir("..", "_sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts.source = filepath.Join(dir, "obj.go")
+	opts.output = filepath.Join(dir, "encoding.go")
+	if err := ioutil.WriteFile(opts.source, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := encode(opts); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "vet", "./"+filepath.Base(dir))
+	cmd.Dir = ".."
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the generated code does not build: %v\n%s", err, out)
+	}
+	output, err := ioutil.ReadFile(opts.output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(output)
+}
+
+// generateTestIR parses the Go source and returns the IR of the generated objects
+func generateTestIR(t *testing.T, src string) map[string]*Value {
+	t.Helper()
+
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	return e.objs
+}
+
+func TestNamedDimensionsIR(t *testing.T) {
+	positional := generateTestIR(t, `package test
+	type Obj struct {
+		Roots        [][]byte   `+"`ssz-size:\"?,32\" ssz-max:\"1024\"`"+`
+		Transactions [][]byte   `+"`ssz-max:\"1024,64\"`"+`
+		Matrix       [][]byte   `+"`ssz-size:\"4\" ssz-max:\"?,16\"`"+`
+		Hashes       [][]byte   `+"`ssz-size:\"?,32\" ssz-max:\"1024\"`"+`
+	}`)
+
+	// the inner dimension is the inner-most one of the Go type even if
+	// the tags only write a single dimension
+	named := generateTestIR(t, `package test
+	type Obj struct {
+		Roots        [][]byte   `+"`ssz-size:\"inner=32\" ssz-max:\"outer=1024\"`"+`
+		Transactions [][]byte   `+"`ssz-max:\"outer=1024,inner=64\"`"+`
+		Matrix       [][]byte   `+"`ssz-size:\"outer=4\" ssz-max:\"1=16\"`"+`
+		Hashes       [][]byte   `+"`ssz-size:\"inner=32\" ssz-max:\"1024\"`"+`
+	}`)
+
+	if !reflect.DeepEqual(positional, named) {
+		t.Fatal("positional and named dimensions generate a different IR")
+	}
+
+	// the lists of lists of containers are rejected with either form of the dimensions
+	for _, tag := range []string{`ssz-max:"4,8"`, `ssz-max:"outer=4,inner=8"`} {
+		err := newTestEnv(t, `package test
+		type Item struct {
+			A uint64
+		}
+		type Obj struct {
+			Items [][]*Item `+"`"+tag+"`"+`
+		}`).generateIR()
+		if err == nil || !strings.Contains(err.Error(), "field Items is a list of lists, nested collections are only supported for bytes") {
+			t.Fatalf("expected error for the nested lists of %s but found %v", tag, err)
+		}
+	}
+}
+
+func TestConcreteTypeNotSSZ(t *testing.T) {
+	cases := []string{
+		`package test
+		type Slot uint64
+		type Obj struct {
+			Iface interface{} ` + "`ssz-concrete:\"Slot\"`" + `
+		}`,
+		`package test
+		type Obj struct {
+			Iface interface{} ` + "`ssz-concrete:\"Unknown\"`" + `
+		}`,
+		`package test
+		type Elem struct {
+			A uint64
+		}
+		type Obj struct {
+			Iface []interface{} ` + "`ssz-concrete:\"Elem\" ssz-max:\"4\"`" + `
+		}`,
+	}
+	for _, c := range cases {
+		if err := newTestEnv(t, c).generateIR(); err == nil {
+			t.Fatal("expected error for unsupported concrete type")
+		}
+	}
+}
+
+func TestIncrementalNotSupported(t *testing.T) {
+	cases := []string{
+		`package test
+		type Obj struct {
+			Values []uint64 ` + "`ssz-max:\"16\" ssz-incremental:\"true\"`" + `
+		}`,
+		`package test
+		type Obj struct {
+			Data [][]byte ` + "`ssz-size:\"?,64\" ssz-max:\"16\" ssz-incremental:\"true\"`" + `
+		}`,
+		`package test
+		type Elem struct {
+			A uint64
+		}
+		type Obj struct {
+			Elems []*Elem ` + "`ssz-size:\"4\" ssz-incremental:\"true\"`" + `
+		}`,
+	}
+	for _, c := range cases {
+		if err := newTestEnv(t, c).generateIR(); err == nil {
+			t.Fatal("expected error for unsupported incremental list")
+		}
+	}
+}
+
+func TestByteCollectionKind(t *testing.T) {
+	inferred := generateTestIR(t, `package test
+	type Obj struct {
+		Root  []byte   `+"`ssz-size:\"32\"`"+`
+		Data  []byte   `+"`ssz-max:\"64\"`"+`
+		Roots [][]byte `+"`ssz-size:\"?,32\" ssz-max:\"16\"`"+`
+	}`)
+
+	explicit := generateTestIR(t, `package test
+	type Obj struct {
+		Root  []byte   `+"`ssz:\"vector\" ssz-size:\"32\"`"+`
+		Data  []byte   `+"`ssz:\"list\" ssz-max:\"64\"`"+`
+		Roots [][]byte `+"`ssz:\"vector\" ssz-size:\"?,32\" ssz-max:\"16\"`"+`
+	}`)
+
+	if !reflect.DeepEqual(inferred, explicit) {
+		t.Fatal("explicit byte kind generates a different IR")
+	}
+
+	cases := []string{
+		"`ssz:\"vector\" ssz-max:\"32\"`",
+		"`ssz:\"list\" ssz-size:\"32\"`",
+		"`ssz:\"list,vector\" ssz-size:\"32\"`",
+		"`ssz:\"bitlist,vector\" ssz-size:\"32\"`",
+	}
+	for _, c := range cases {
+		src := `package test
+		type Obj struct {
+			Data []byte ` + c + `
+		}`
+		if err := newTestEnv(t, src).generateIR(); err == nil {
+			t.Fatalf("expected error for tag %s", c)
+		}
+	}
+
+	// the kind is only valid for byte collections
+	src := `package test
+	type Obj struct {
+		Data []uint64 ` + "`ssz:\"list\" ssz-max:\"32\"`" + `
+	}`
+	if err := newTestEnv(t, src).generateIR(); err == nil {
+		t.Fatal("expected error for a non byte collection")
+	}
+}
+
+func TestImplFuncMethodSet(t *testing.T) {
+	obj := `package test
+	type Obj struct {
+		A uint64
+	}
+	type Other struct {
+		Obj *Obj
+	}`
+
+	methods := []string{
+		"func (o *Obj) MarshalSSZTo(buf []byte) ([]byte, error) { return nil, nil }",
+		"func (o *Obj) UnmarshalSSZ(buf []byte) error { return nil }",
+		"func (o *Obj) SizeSSZ() int { return 0 }",
+		"func (o *Obj) HashTreeRootWith(hh *ssz.Hasher) error { return nil }",
+		// not one of the core methods
+		"func (o *Obj) HashTreeRoot() ([32]byte, error) { return [32]byte{}, nil }",
+	}
+
+	// the methods are declared in a different file than the object
+	impl := "package test\n" + strings.Join(methods, "\n")
+	e := newTestEnv(t, obj, impl)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	if typ := e.objs["Other"].o[0].t; typ != TypeReference {
+		t.Fatalf("expected a reference but found %s", typ)
+	}
+
+	// only some of the methods are implemented by hand
+	partial := "package test\n" + strings.Join(methods[1:], "\n")
+	e = newTestEnv(t, obj, partial)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	if typ := e.objs["Other"].o[0].t; typ != TypeContainer {
+		t.Fatalf("expected a container but found %s", typ)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	src := `package test
+	type Obj struct {
+		A uint64
+	}`
+
+	// the methods cannot be declared in another package
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "obj.go")
+	if err := ioutil.WriteFile(source, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "encoding.go")
+	err = encode(encodeOptions{source: source, output: output, packageName: "sszencodings", maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil || !strings.Contains(err.Error(), "is not the package 'test' of the source files") {
+		t.Fatalf("expected error for a different package but found %v", err)
+	}
+
+	// the package of the source files is accepted
+	output = buildTestEncodings(t, src, encodeOptions{packageName: "test", maxDepth: defaultMaxDepth, maxErrors: 1})
+	file, err := parser.ParseFile(token.NewFileSet(), "", output, parser.PackageClauseOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := file.Name.Name; name != "test" {
+		t.Fatalf("expected package test but found %s", name)
+	}
+}
+
+func TestByteArrayDimensions(t *testing.T) {
+	cases := []struct {
+		field string
+		err   string
+	}{
+		{
+			"[2][2][32]byte `ssz-size:\"2,32\"`",
+			"field Data has 3 Go array dimensions but ssz-size/ssz-max specify 2",
+		},
+		{
+			"[]byte `ssz-size:\"?,32\" ssz-max:\"4\"`",
+			"field Data has 1 Go array dimensions but ssz-size/ssz-max specify 2",
+		},
+		{
+			"[][32]byte `ssz-size:\"?,32\" ssz-max:\"4\"`",
+			"",
+		},
+	}
+	for _, c := range cases {
+		src := `package test
+		type Obj struct {
+			Data ` + c.field + `
+		}`
+		err := newTestEnv(t, src).generateIR()
+		if c.err == "" {
+			if err != nil {
+				t.Fatalf("field %s: %v", c.field, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("field %s: expected error '%s' but found %v", c.field, c.err, err)
+		}
+	}
+}
+
+func TestFixedByteSlice(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		Root []byte `+"`ssz-size:\"32\"`"+`
+	}`)
+
+	root := objs["Obj"].o[0]
+	if !root.isFixed() || root.s != 32 || root.c {
+		t.Fatal("expected a fixed byte slice of 32 bytes")
+	}
+	// the generated unmarshal must enforce the exact size of the buffer
+	if !strings.Contains(root.unmarshal("buf"), "if len(buf) != 32 { return ssz.ErrBytesLength }") {
+		t.Fatal("expected a length check on the fixed byte slice")
+	}
+	// except in a region of the fixed part with its size
+	if strings.Contains(root.unmarshal("buf[8:40]"), "ErrBytesLength") {
+		t.Fatal("unexpected length check of the region of the fixed byte slice")
+	}
+
+	// the fixed bytes are copied at once instead of byte by byte
+	e := newTestEnv(t, `package test
+	type Obj struct {
+		Slot      uint64
+		PubKey    []byte `+"`ssz-size:\"48\"`"+`
+		Signature [96]byte
+		Root      [32]byte
+		Roots     [4][32]byte
+	}`)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	marshal := e.marshal("Obj", e.objs["Obj"])
+	unmarshal := e.unmarshal("Obj", e.objs["Obj"])
+	for _, expected := range []string{"dst = append(dst, o.PubKey...)", "copy(fixed[0:96], o.Signature[:])", "copy(fixed[96:128], o.Root[:])", "dst = append(dst, o.Roots[ii][:]...)"} {
+		if !strings.Contains(marshal, expected) {
+			t.Fatalf("expected %s in the marshal:\n%s", expected, marshal)
+		}
+	}
+	for _, expected := range []string{"o.PubKey = append(o.PubKey[:0], buf[8:56]...)", "copy(o.Signature[:], buf[56:152])", "copy(o.Root[:], buf[152:184])", "copy(o.Roots[ii][:], buf[184:312][ii*32: (ii+1)*32])"} {
+		if !strings.Contains(unmarshal, expected) {
+			t.Fatalf("expected %s in the unmarshal:\n%s", expected, unmarshal)
+		}
+	}
+	// the only loop is over the roots of the vector
+	if strings.Count(marshal, "for ") != 1 || strings.Count(unmarshal, "for ") != 1 {
+		t.Fatalf("unexpected loops in the encoding of the fixed bytes:\n%s\n%s", marshal, unmarshal)
+	}
+}
+
+func TestReceiverName(t *testing.T) {
+	src := `package test
+	type Item struct {
+		Values []uint64 ` + "`ssz-max:\"16\"`" + `
+	}
+	type Block struct {
+		Slot uint64
+	}`
+
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	// the first letter of Item collides with the index of the list
+	if str := e.marshal("Item", e.objs["Item"]); !strings.Contains(str, "func (x *Item) MarshalSSZ()") {
+		t.Fatal("expected the default receiver for Item")
+	}
+	if str := e.marshal("Block", e.objs["Block"]); !strings.Contains(str, "func (b *Block) MarshalSSZ()") {
+		t.Fatal("expected the first letter as receiver for Block")
+	}
+
+	e.receiver = "self"
+	if str := e.marshal("Block", e.objs["Block"]); !strings.Contains(str, "func (self *Block) MarshalSSZ()") {
+		t.Fatal("expected the custom receiver for Block")
+	}
+
+	for _, name := range []string{"buf", "cipher", "dst", "o1", "ssz", "1x", "_", "a-b"} {
+		if err := e.validateReceiver(name); err == nil {
+			t.Fatalf("expected error for receiver %s", name)
+		}
+	}
+	if err := e.validateReceiver("self"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUint8ByteCollection(t *testing.T) {
+	bytesIR := generateTestIR(t, `package test
+	type Obj struct {
+		Root  [32]byte `+"`ssz-size:\"32\"`"+`
+		Data  []byte   `+"`ssz-max:\"64\"`"+`
+		Roots [][]byte `+"`ssz-size:\"?,32\" ssz-max:\"16\"`"+`
+		Hash  []byte   `+"`ssz-size:\"32\"`"+`
+	}`)
+
+	uint8IR := generateTestIR(t, `package test
+	type Obj struct {
+		Root  [32]uint8 `+"`ssz-size:\"32\"`"+`
+		Data  []uint8   `+"`ssz-max:\"64\"`"+`
+		Roots [][]uint8 `+"`ssz-size:\"?,32\" ssz-max:\"16\"`"+`
+		Hash  []uint8   `+"`ssz-size:\"32\"`"+`
+	}`)
+
+	if !reflect.DeepEqual(bytesIR, uint8IR) {
+		t.Fatal("uint8 collections generate a different IR than byte collections")
+	}
+	if typ := uint8IR["Obj"].o[1].t; typ != TypeBytes {
+		t.Fatalf("expected bytes type but found %s", typ.String())
+	}
+}
+
+func TestTypeAlias(t *testing.T) {
+	src := `package test
+	type Root = [32]byte
+	type Slot = uint64
+	type A struct {
+		Slot Slot
+	}
+	type B = A
+	type Obj struct {
+		Root Root
+		A    *B
+	}`
+
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := generateTestIR(t, `package test
+	type A struct {
+		Slot uint64
+	}
+	type Obj struct {
+		Root [32]byte
+		A    *A
+	}`)
+	if !reflect.DeepEqual(e.objs["Obj"], expected["Obj"]) {
+		t.Fatal("the aliases do not resolve to the aliased types")
+	}
+	// the aliases do not have their own methods
+	for _, name := range []string{"Root", "Slot", "B"} {
+		if _, ok := e.objs[name]; ok {
+			t.Fatalf("alias %s should not be generated", name)
+		}
+	}
+
+	// targeting an alias generates the aliased struct
+	e = newTestEnv(t, src)
+	e.targets = []string{"B"}
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := e.objs["A"]; !ok {
+		t.Fatal("expected the aliased struct to be generated")
+	}
+}
+
+func TestFormatSourceGoimports(t *testing.T) {
+	src := `package test
+
+	import (
+		ssz "github.com/photon-storage/fastssz"
+		"github.com/prysmaticlabs/go-bitfield"
+		"fmt"
+		"strings"
+	)
+
+	var _ = ssz.ErrSize
+	var _ = bitfield.Bitlist{}
+	var _ = fmt.Sprintf
+	`
+	expected := `package test
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/go-bitfield"
+
+	ssz "github.com/photon-storage/fastssz"
+)
+`
+	out, err := formatSource("test.go", []byte(src), true, "github.com/photon-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(out), expected) {
+		t.Fatalf("bad imports:\n%s", out)
+	}
+
+	// without goimports the imports are not modified
+	out, err = formatSource("test.go", []byte(src), false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"strings"`) {
+		t.Fatal("expected the imports to be kept")
+	}
+}
+
+func TestMultiLevelSelector(t *testing.T) {
+	// a.b.C is not a valid type for the Go parser but it can be built in the AST
+	sel := &ast.SelectorExpr{
+		X: &ast.SelectorExpr{
+			X:   ast.NewIdent("a"),
+			Sel: ast.NewIdent("b"),
+		},
+		Sel: ast.NewIdent("C"),
+	}
+	e := newTestEnv(t, `package test`)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	for _, expr := range []ast.Expr{sel, &ast.StarExpr{X: sel}} {
+		_, err := e.parseASTFieldType("F", "", expr)
+		if err == nil {
+			t.Fatal("expected error for a multi-level selector")
+		}
+		if !strings.Contains(err.Error(), "a.b.C") || !strings.Contains(err.Error(), "field F") {
+			t.Fatalf("expected the field and the expression in the error: %v", err)
+		}
+	}
+}
+
+func TestPackBools(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		_ struct{} `+"`ssz-pack-bools:\"true\"`"+`
+		A uint64
+		B bool
+		C bool
+	}`)
+
+	obj := objs["Obj"]
+	if len(obj.o) != 2 || obj.o[0].t != TypePackedBools || obj.o[1].name != "A" {
+		t.Fatal("expected a leading packed bools field")
+	}
+	if packed := obj.o[0]; len(packed.o) != 2 || packed.fixedSize() != 1 {
+		t.Fatal("expected the two bools packed in one byte")
+	}
+
+	cases := []string{
+		// no bool fields
+		`package test
+		type Obj struct {
+			_ struct{} ` + "`ssz-pack-bools:\"true\"`" + `
+			A uint64
+		}`,
+		// unknown value
+		`package test
+		type Obj struct {
+			_ struct{} ` + "`ssz-pack-bools:\"yes\"`" + `
+			A bool
+		}`,
+	}
+	for _, c := range cases {
+		if err := newTestEnv(t, c).generateIR(); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+}
+
+func TestFieldsCount(t *testing.T) {
+	generateTestIR(t, `package test
+	type Obj struct {
+		_ struct{} `+"`ssz-fields:\"2\"`"+`
+		A uint64
+		B []byte `+"`ssz-max:\"32\"`"+`
+		C uint64 `+"`ssz:\"-\"`"+`
+	}`)
+
+	err := newTestEnv(t, `package test
+	type Obj struct {
+		_ struct{} `+"`ssz-fields:\"3\"`"+`
+		A uint64
+		B uint64
+	}`).generateIR()
+	if err == nil || !strings.Contains(err.Error(), "Obj has 2 ssz fields but ssz-fields expects 3") {
+		t.Fatalf("expected a fields count error but found %v", err)
+	}
+
+	err = newTestEnv(t, `package test
+	type Obj struct {
+		_ struct{} `+"`ssz-fields:\"two\"`"+`
+		A uint64
+	}`).generateIR()
+	if err == nil {
+		t.Fatal("expected error for a non numeric ssz-fields")
+	}
+}
+
+func TestEncryptTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A uint64
+		B []byte `+"`ssz-max:\"32\" ssz-encrypt:\"true\"`"+`
+	}`)
+	if obj := objs["Obj"]; obj.o[0].encrypt || !obj.o[1].encrypt {
+		t.Fatal("expected only the field B to be encrypted")
+	}
+
+	cases := []string{
+		// unknown value
+		`package test
+		type Obj struct {
+			A uint64 ` + "`ssz-encrypt:\"yes\"`" + `
+		}`,
+		// packed bools
+		`package test
+		type Obj struct {
+			_ struct{} ` + "`ssz-pack-bools:\"true\"`" + `
+			A bool
+			B uint64 ` + "`ssz-encrypt:\"true\"`" + `
+		}`,
+	}
+	for _, c := range cases {
+		if err := newTestEnv(t, c).generateIR(); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+}
+
+func TestEncryptReceiver(t *testing.T) {
+	// the receiver of Credentials is the first letter of its name
+	output := buildTestEncodings(t, `package test
+	type Credentials struct {
+		ID     uint64
+		Secret []byte `+"`ssz-max:\"64\" ssz-encrypt:\"true\"`"+`
+	}`, encodeOptions{maxDepth: defaultMaxDepth, maxErrors: 1})
+	if !strings.Contains(output, "func (c *Credentials) MarshalSSZEncrypted(cipher ssz.Cipher)") {
+		t.Fatalf("expected the encrypted marshal with the receiver c:\n%s", output)
+	}
+}
+
+func TestTransientTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A uint64
+		B [32]byte `+"`ssz-transient:\"Populate\"`"+`
+		c uint64 `+"`ssz-transient:\"Populate\"`"+`
+	}
+
+	func (o *Obj) Populate() {}`)
+	if obj := objs["Obj"]; len(obj.o) != 1 || !reflect.DeepEqual(obj.transient, []string{"Populate"}) {
+		t.Fatal("expected the transient fields to not be encoded")
+	}
+
+	cases := map[string]string{
+		// no method
+		``: "the ssz-transient method Populate must be declared as func (x *Obj) Populate()",
+		// bad signature
+		`func (o *Obj) Populate() error { return nil }`: "the ssz-transient method Populate must be declared",
+		`func (o *Obj) Populate(a int) {}`:              "the ssz-transient method Populate must be declared",
+		// value receiver
+		`func (o Obj) Populate() {}`: "the ssz-transient method Populate must be declared",
+	}
+	for method, expected := range cases {
+		err := newTestEnv(t, `package test
+		type Obj struct {
+			A uint64
+			B [32]byte `+"`ssz-transient:\"Populate\"`"+`
+		}
+		`+method).generateIR()
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for '%s': %v", method, err)
+		}
+	}
+}
+
+func TestExtensible(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A uint64
+		Ext []byte `+"`ssz-extensible:\"true\"`"+`
+	}`)
+
+	obj := objs["Obj"]
+	if obj.ext != "Ext" || len(obj.o) != 1 {
+		t.Fatal("expected the extension out of the fields")
+	}
+	if obj.isFixed() || obj.fixedSize() != 8 {
+		t.Fatal("expected a dynamic object with the fixed part of the fields")
+	}
+
+	cases := []string{
+		// not the last field
+		`package test
+		type Obj struct {
+			Ext []byte ` + "`ssz-extensible:\"true\"`" + `
+			A uint64
+		}`,
+		// not a byte slice
+		`package test
+		type Obj struct {
+			A uint64
+			Ext []uint64 ` + "`ssz-extensible:\"true\"`" + `
+		}`,
+		// dynamic fields
+		`package test
+		type Obj struct {
+			A []byte ` + "`ssz-max:\"32\"`" + `
+			Ext []byte ` + "`ssz-extensible:\"true\"`" + `
+		}`,
+		// unknown value
+		`package test
+		type Obj struct {
+			A uint64
+			Ext []byte ` + "`ssz-extensible:\"yes\"`" + `
+		}`,
+	}
+	for _, c := range cases {
+		if err := newTestEnv(t, c).generateIR(); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	a := `type A struct {
+		B *B
+	}`
+	b := `type B struct {
+		C []uint64 ` + "`ssz-size:\"2\"`" + `
+	}`
+
+	// the depth does not depend on the order of the objects
+	for _, src := range []string{"package test\n" + a + "\n" + b, "package test\n" + b + "\n" + a} {
+		// A.B, B.C and the elements of C
+		e := newTestEnv(t, src)
+		e.maxDepth = 3
+		if err := e.generateIR(); err != nil {
+			t.Fatal(err)
+		}
+
+		e = newTestEnv(t, src)
+		e.maxDepth = 2
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "max depth 2") {
+			t.Fatalf("expected max depth error but found %v", err)
+		}
+	}
+
+	// recursive types
+	e := newTestEnv(t, `package test
+	type A struct {
+		Next *A
+	}`)
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "recursive type A") {
+		t.Fatalf("expected recursive type error but found %v", err)
+	}
+}
+
+func TestUnsupportedFieldType(t *testing.T) {
+	cases := map[string]string{
+		"func(uint64) error":       "unsupported function type 'func(uint64) error'",
+		"chan uint64":              "unsupported channel type 'chan uint64'",
+		"map[string]uint64":        "unsupported map type 'map[string]uint64'",
+		"struct{ A uint64 }":       "unsupported anonymous struct type 'struct{A uint64}'",
+		"*func()":                  "unsupported pointer to function type '*func()'",
+		"*map[string]uint64":       "unsupported pointer to map type '*map[string]uint64'",
+		"[]func() `ssz-max:\"4\"`": "unsupported function type 'func()'",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			A uint64
+			F `+typ+`
+		}`)
+		err := e.generateIR()
+		if err == nil {
+			t.Fatalf("expected error for %s", typ)
+		}
+		if !strings.Contains(err.Error(), "field F has an "+expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+		if !strings.Contains(err.Error(), "Use the ssz:\"-\" tag to skip the field F") {
+			t.Fatalf("expected the hint to skip the field for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srcs := map[string]string{
+		"a.go": "package test\ntype A struct {\nB *B\n}",
+		"b.go": "package test\ntype B struct {\nX uint64\n}",
+		"c.go": "package test\ntype C struct {\nY uint64\n}",
+	}
+	for name, src := range srcs {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	generate := func(changed ...string) {
+		if err := encode(encodeOptions{source: dir, changed: changed, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	output := func(name string) string {
+		return filepath.Join(dir, name+encodingPrefix)
+	}
+	generate()
+
+	// mark the outputs to know which ones are generated again
+	old := "package test\n\n// old\n"
+	for _, name := range []string{"a", "b", "c"} {
+		if err := ioutil.WriteFile(output(name), []byte(old), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	generate(filepath.Join(dir, "b.go"))
+
+	for name, regenerated := range map[string]bool{"a": true, "b": true, "c": false} {
+		data, err := ioutil.ReadFile(output(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (string(data) != old) != regenerated {
+			t.Fatalf("bad output of %s, regenerated: %v", name, regenerated)
+		}
+	}
+
+	// the missing outputs are always generated
+	if err := os.Remove(output("c")); err != nil {
+		t.Fatal(err)
+	}
+	generate(filepath.Join(dir, "b.go"))
+	if _, err := os.Stat(output("c")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSkipNonSerializableField(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	import "sync"
+	type Obj struct {
+		sync.Mutex
+		A  uint64
+		Mu sync.Mutex `+"`ssz:\"-\"`"+`
+		mu sync.Mutex
+	}`)
+	if obj := objs["Obj"]; len(obj.o) != 1 || obj.o[0].name != "A" {
+		t.Fatal("expected only the field A")
+	}
+
+	// the error suggests the tag
+	e := newTestEnv(t, `package test
+	import "sync"
+	type Obj struct {
+		A  uint64
+		Mu sync.Mutex
+	}`)
+	err := e.generateIR()
+	if err == nil || !strings.Contains(err.Error(), `Use the ssz:"-" tag to skip the field Mu`) {
+		t.Fatalf("expected the ssz tag in the error but found %v", err)
+	}
+}
+
+func TestMinTag(t *testing.T) {
+	cases := map[string]string{
+		"[]uint64 `ssz-max:\"8\" ssz-min:\"9\"`":  "ssz-min 9 is higher than ssz-max 8 in field F",
+		"[]uint64 `ssz-size:\"8\" ssz-min:\"1\"`": "ssz-min is only supported for lists, field F",
+		"[]byte `ssz-size:\"8\" ssz-min:\"1\"`":   "ssz-min is only supported for lists, field F",
+		"[]byte `ssz-max:\"8\" ssz-min:\"a\"`":    "failed to parse ssz-min tag of field F",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			F `+typ+`
+		}`)
+		err := e.generateIR()
+		if err == nil {
+			t.Fatalf("expected error for %s", typ)
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestZeroMaxTag(t *testing.T) {
+	cases := []string{
+		"[]byte `ssz-max:\"0\"`",
+		"[]uint64 `ssz-max:\"0\"`",
+		"[][]byte `ssz-max:\"0,32\"`",
+		"[][]byte `ssz-max:\"4,0\"`",
+		"bitfield.Bitlist `ssz-max:\"0\"`",
+	}
+	for _, typ := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			F `+typ+`
+		}`)
+		err := e.generateIR()
+		if err == nil {
+			t.Fatalf("expected error for %s", typ)
+		}
+		if !strings.Contains(err.Error(), "field F has ssz-max 0") {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+
+	// the empty lists are allowed explicitly
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		F []uint64 `+"`ssz-max:\"0\" ssz-allow-empty-max:\"true\"`"+`
+	}`)
+	if f := objs["Obj"].o[0]; f.t != TypeList || f.s != 0 {
+		t.Fatal("expected an empty list")
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	err := encode(encodeOptions{format: "json", maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil || err.Error() != "unknown format 'json'" {
+		t.Fatalf("expected an unknown format error but found %v", err)
+	}
+}
+
+func TestNoFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := `package test
+	type Obj struct {
+		A uint64
+		B []byte ` + "`ssz-max:\"32\"`" + `
+	}`
+	source := filepath.Join(dir, "obj.go")
+	if err := ioutil.WriteFile(source, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "encoding.go")
+	if err := encode(encodeOptions{source: source, output: output, noFormat: true, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the output is valid but it is not formatted
+	out, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formatted, err := format.Source(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(out, formatted) {
+		t.Fatal("expected the output to not be formatted")
+	}
+
+	err = encode(encodeOptions{source: source, output: output, goimports: true, noFormat: true, maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil {
+		t.Fatal("expected an error with goimports and no-format")
+	}
+}
+
+func TestInplace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := `package test
+
+import "fmt"
+
+// Obj is an object with hand-written methods
+type Obj struct {
+	A uint64
+	B []byte ` + "`ssz-max:\"32\"`" + `
+}
+
+// String is a hand-written method
+func (o *Obj) String() string {
+	return fmt.Sprintf("%d", o.A)
+}
+`
+	source := filepath.Join(dir, "obj.go")
+	if err := ioutil.WriteFile(source, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	generate := func() []byte {
+		if err := encode(encodeOptions{source: source, inplace: true, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+			t.Fatal(err)
+		}
+		out, err := ioutil.ReadFile(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	out := generate()
+	str := string(out)
+	if !strings.HasPrefix(str, strings.TrimSuffix(src[:strings.Index(src, "import")], "\n")) {
+		t.Fatal("expected the source to be preserved")
+	}
+	for _, s := range []string{
+		"ssz \"github.com/photon-storage/fastssz\"",
+		"func (o *Obj) String() string",
+		inplaceBegin,
+		"func (o *Obj) MarshalSSZ() ([]byte, error)",
+		inplaceEnd,
+	} {
+		if !strings.Contains(str, s) {
+			t.Fatalf("expected %s in the source", s)
+		}
+	}
+	if strings.Index(str, "func (o *Obj) String()") > strings.Index(str, inplaceBegin) {
+		t.Fatal("expected the generated methods after the hand-written code")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "obj_encoding.go")); !os.IsNotExist(err) {
+		t.Fatal("expected no encoding file")
+	}
+
+	// the generation is idempotent
+	if !bytes.Equal(out, generate()) {
+		t.Fatal("expected the same source when generating it again")
+	}
+
+	err = encode(encodeOptions{source: source, output: filepath.Join(dir, "out.go"), inplace: true, maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil {
+		t.Fatal("expected an error with inplace and output")
+	}
+}
+
+func TestCompatTest(t *testing.T) {
+	for _, testVectors := range []bool{false, true} {
+		dir, err := ioutil.TempDir("", "sszgen")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		src := `package test
+		type Obj struct {
+			A uint64
+		}`
+		source := filepath.Join(dir, "obj.go")
+		if err := ioutil.WriteFile(source, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := encode(encodeOptions{source: source, testVectors: testVectors, compatTest: "github.com/prysmaticlabs/go-ssz", maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := ioutil.ReadFile(filepath.Join(dir, "obj_compat_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{
+			`compat "github.com/prysmaticlabs/go-ssz"`,
+			"func TestSSZCompatObj(t *testing.T)",
+			"ssz.CompareReference(obj, compat.Marshal, compat.HashTreeRoot)",
+		} {
+			if !strings.Contains(string(out), expected) {
+				t.Fatalf("expected %s in the compatibility tests:\n%s", expected, out)
+			}
+		}
+		// the fill function is declared in the test vectors if they are generated
+		if hasFill := strings.Contains(string(out), "func fillObjSSZ"); hasFill == testVectors {
+			t.Fatalf("bad fill function with test vectors %v", testVectors)
+		}
+	}
+}
+
+func TestGroupedTypeSpecs(t *testing.T) {
+	src := `package test
+
+	type (
+		C struct {
+			A uint64
+			B *A
+		}
+		A struct {
+			A uint64
+		}
+	)
+
+	type D struct {
+		A []byte ` + "`ssz-max:\"32\"`" + `
+	}
+
+	type (
+		B struct {
+			C *C
+		}
+		Alias = uint64
+	)`
+
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	var order []string
+	for _, o := range e.order {
+		order = o
+	}
+	if expected := []string{"C", "A", "D", "B", "Alias"}; !reflect.DeepEqual(order, expected) {
+		t.Fatalf("expected the source order %v but found %v", expected, order)
+	}
+
+	// the output is the same in every generation
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the hash of the sources does not depend on the order of the files
+	for _, name := range []string{"obj.go", "other.go", "more.go"} {
+		content := src
+		if name != "obj.go" {
+			content = "package test\ntype " + strings.TrimSuffix(name, ".go") + " struct {\nA uint64\n}"
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	output := filepath.Join(dir, "obj_encoding.go")
+	var expected []byte
+	for i := 0; i < 10; i++ {
+		if err := encode(encodeOptions{source: dir, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+			t.Fatal(err)
+		}
+		out, err := ioutil.ReadFile(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{output, filepath.Join(dir, "other_encoding.go"), filepath.Join(dir, "more_encoding.go")} {
+			if err := os.Remove(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if i == 0 {
+			expected = out
+		} else if !bytes.Equal(out, expected) {
+			t.Fatal("the output is not deterministic")
+		}
+	}
+
+	// the methods are generated in the source order
+	idx := []int{}
+	for _, name := range []string{"C", "A", "D", "B"} {
+		idx = append(idx, bytes.Index(expected, []byte(fmt.Sprintf(") MarshalSSZ() ([]byte, error) {\n\treturn ssz.MarshalSSZ(%s)", strings.ToLower(name)))))
+	}
+	if !sort.IntsAreSorted(idx) || idx[0] == -1 {
+		t.Fatalf("the methods are not in the source order %v", idx)
+	}
+}
+
+func TestPromoteIncludedStructs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sszgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srcs := map[string]string{
+		"a.go":     "package test\ntype A struct {\nB *B\n}",
+		"b.go":     "package test\ntype B struct {\nX uint64\n}\ntype C struct {\nY uint64\n}",
+		"ext/d.go": "package ext\ntype D struct {\nZ uint64\n}",
+	}
+	for name, src := range srcs {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	generate := func(include string, targets ...string) error {
+		return encode(encodeOptions{source: filepath.Join(dir, "a.go"), targets: targets, includePaths: []string{filepath.Join(dir, include)}, maxDepth: defaultMaxDepth, maxErrors: 1})
+	}
+
+	// B is generated in the output of its file but not C
+	if err := generate("b.go", "A", "B"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "b"+encodingPrefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "func (b *B) MarshalSSZ()") {
+		t.Fatal("expected the methods of B")
+	}
+	if strings.Contains(string(data), "func (c *C)") {
+		t.Fatal("unexpected methods of C")
+	}
+
+	// the structs of other packages cannot be generated
+	err = generate("ext/d.go", "A", "D")
+	if err == nil || !strings.Contains(err.Error(), "cannot generate the included struct D of package ext") {
+		t.Fatalf("expected an error for the struct of another package but found %v", err)
+	}
+}
+
+func TestVerbosity(t *testing.T) {
+	defer func(level logLevel, out io.Writer) {
+		verbosity, logOutput = level, out
+	}(verbosity, logOutput)
+
+	var buf bytes.Buffer
+	logOutput = &buf
+
+	flags := flag.NewFlagSet("sszgen", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	flags.Var(verbosityFlag{&verbosity}, "v", "")
+
+	cases := []struct {
+		args  []string
+		level logLevel
+		logs  []string
+	}{
+		{nil, levelWarn, []string{"[WARN]: a"}},
+		{[]string{"-v"}, levelInfo, []string{"[WARN]: a", "[INFO]: b"}},
+		{[]string{"-v=2"}, levelDebug, []string{"[WARN]: a", "[INFO]: b", "[DEBUG]: c"}},
+	}
+	for _, c := range cases {
+		verbosity = levelWarn
+		if err := flags.Parse(c.args); err != nil {
+			t.Fatal(err)
+		}
+		if verbosity != c.level {
+			t.Fatalf("expected level %d but found %d", c.level, verbosity)
+		}
+		buf.Reset()
+		warn("a")
+		infof("b")
+		debugf("c")
+		if logs := strings.Split(strings.TrimSpace(buf.String()), "\n"); !reflect.DeepEqual(logs, c.logs) {
+			t.Fatalf("expected logs %v but found %v", c.logs, logs)
+		}
+	}
+	if err := flags.Parse([]string{"-v=3"}); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+}
+
+func TestMaxErrors(t *testing.T) {
+	src := `package test
+	type A struct {
+		F1 []byte
+		F2 uint64
+		F3 []uint64 ` + "`ssz-max:\"0\"`" + `
+		F4 *C
+	}
+	type B struct {
+		F1 map[string]string
+		F2 *C
+	}
+	type C struct {
+		F1 []*A ` + "`ssz-max:\"4\"`" + `
+	}`
+
+	// only the first error is reported by default
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err == nil || strings.Contains(err.Error(), "errors:") {
+		t.Fatalf("expected a single error but found %v", err)
+	}
+
+	expected := []string{
+		"failed to encode A.F1: No ssz-size or ssz-max tags found",
+		"failed to encode A.F3: field F3 has ssz-max 0",
+		"failed to encode C.F1: recursive type A",
+		"failed to encode B.F1: field F1 has an unsupported map type",
+	}
+	e = newTestEnv(t, src)
+	e.maxErrors = -1
+	err := e.generateIR()
+	errs, ok := err.(errorList)
+	if !ok {
+		t.Fatalf("expected a list of errors but found %v", err)
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors but found %d: %v", len(expected), len(errs), err)
+	}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(errs[i].Error(), prefix) {
+			t.Fatalf("bad error %d, expected %s but found %v", i, prefix, errs[i])
+		}
+	}
+	if !strings.HasPrefix(err.Error(), "4 errors:\n") {
+		t.Fatalf("bad message %v", err)
+	}
+
+	// the errors are reported up to the maximum
+	e = newTestEnv(t, src)
+	e.maxErrors = 2
+	if errs, ok := e.generateIR().(errorList); !ok || len(errs) != 2 {
+		t.Fatalf("expected 2 errors but found %v", errs)
+	}
+}
+
+func TestBitvectorBits(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A bitfield.Bitvector12 `+"`ssz-size:\"2\"`"+`
+		B bitfield.Bitvector64 `+"`ssz-size:\"8\"`"+`
+		C []byte `+"`ssz:\"bitvector\" ssz-size:\"12\"`"+`
+	}`)
+	for indx, bits := range []uint64{12, 64, 12} {
+		f := objs["Obj"].o[indx]
+		if f.t != TypeBytes || !f.isFixed() || f.bits != bits || f.s != (bits+7)/8 {
+			t.Fatalf("bad bitvector %s", f.name)
+		}
+	}
+
+	cases := map[string]string{
+		"bitfield.Bitvector12 `ssz-size:\"1\"`":          "has ssz-size 1 instead of 2 bytes",
+		"[2]byte `ssz:\"bitvector\" ssz-size:\"12\"`":    "requires a []byte",
+		"[]byte `ssz:\"bitvector\" ssz-max:\"12\"`":      "requires a ssz-size tag",
+		"[]byte `ssz:\"bitvector,list\" ssz-size:\"8\"`": "cannot be both a bitvector and a list",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			F `+typ+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestExternalStructWithoutMethods(t *testing.T) {
+	other := `package other
+	type Thing struct {
+		A uint64
+	}`
+	methods := `package other
+	func (t *Thing) MarshalSSZTo(buf []byte) ([]byte, error) { return nil, nil }
+	func (t *Thing) UnmarshalSSZ(buf []byte) error { return nil }
+	func (t *Thing) SizeSSZ() int { return 8 }
+	func (t *Thing) HashTreeRootWith(hh *ssz.Hasher) error { return nil }`
+
+	include := func(e *env, srcs ...string) {
+		for indx, src := range srcs {
+			name := fmt.Sprintf("other%d.go", indx)
+			file, err := parser.ParseFile(token.NewFileSet(), name, src, parser.AllErrors)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e.include[name] = file
+		}
+	}
+
+	cases := []string{
+		"[]*other.Thing `ssz-max:\"4\"`",
+		"[]other.Thing `ssz-max:\"4\"`",
+		"[4]*other.Thing",
+		"*other.Thing",
+		"other.Thing",
+	}
+	for _, typ := range cases {
+		src := `package test
+		import "example.com/other"
+		type Obj struct {
+			F ` + typ + `
+		}`
+
+		// the included struct does not have the methods
+		e := newTestEnv(t, src)
+		include(e, other)
+		err := e.generateIR()
+		if err == nil || !strings.Contains(err.Error(), "field F references the struct other.Thing which does not implement the ssz methods") {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+
+		// the methods are found in the generated files of the package
+		e = newTestEnv(t, src)
+		include(e, other, methods)
+		if err := e.generateIR(); err != nil {
+			t.Fatalf("unexpected error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestOptionalTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Inner struct {
+		A uint64
+	}
+	type Obj struct {
+		A uint64
+		B *Inner `+"`ssz-optional:\"true\"`"+`
+	}`)
+	obj := objs["Obj"]
+	if f := obj.o[1]; !f.optional || f.isFixed() || f.fixedSize() != bytesPerLengthOffset {
+		t.Fatal("expected a dynamic optional field")
+	}
+	if obj.isFixed() || obj.fixedSize() != 12 {
+		t.Fatal("expected a dynamic container")
+	}
+	if schema := obj.schemaContainer(true); schema != "Container(A:uint64,B:Optional[Inner])" {
+		t.Fatalf("bad schema %s", schema)
+	}
+	// only the present value adds its presence byte and its size
+	if size := obj.o[1].size("size"); !strings.Contains(size, "if ::.B != nil {\n\t\t\tsize += 1 + ::.B.SizeSSZ()") {
+		t.Fatalf("bad size of the optional field:\n%s", size)
+	}
+
+	cases := map[string]string{
+		"*Inner `ssz-optional:\"false\"`":                "ssz-optional only accepts the value 'true'",
+		"Inner `ssz-optional:\"true\"`":                  "ssz-optional requires a pointer to a struct",
+		"[]*Inner `ssz-max:\"4\" ssz-optional:\"true\"`": "ssz-optional requires a pointer to a struct",
+		"uint64 `ssz-optional:\"true\"`":                 "ssz-optional requires a pointer to a struct",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Inner struct {
+			A uint64
+		}
+		type Obj struct {
+			F `+typ+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestStdio(t *testing.T) {
+	defer func(r io.Reader, w io.Writer) {
+		stdin, stdout = r, w
+	}(stdin, stdout)
+
+	src := `package test
+	type Obj struct {
+		A uint64
+	}`
+	out := new(bytes.Buffer)
+	stdin, stdout = strings.NewReader(src), out
+
+	if err := encode(encodeOptions{source: stdio, output: stdio, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "", out.Bytes(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := file.Name.Name; name != "test" {
+		t.Fatalf("expected package test but found %s", name)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("func (o *Obj) MarshalSSZTo(")) {
+		t.Fatal("expected the methods of Obj in the output")
+	}
+
+	// the source from stdin does not have a file to derive the output from
+	err = encode(encodeOptions{source: stdio, maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil || !strings.Contains(err.Error(), "requires the output flag") {
+		t.Fatalf("expected an error without output but found %v", err)
+	}
+	// the additional files cannot be written to stdout
+	err = encode(encodeOptions{source: stdio, output: stdio, testVectors: true, maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used with the output to stdout") {
+		t.Fatalf("expected an error with the test vectors but found %v", err)
+	}
+}
+
+func TestOptionalMethods(t *testing.T) {
+	// the non-standard methods are only generated with their flags
+	cases := []struct {
+		method string
+		enable func(e *env)
+	}{
+		{"MarshalFieldsSSZ", func(e *env) { e.fieldsEncoding = true }},
+		{"OffsetsSSZ", func(e *env) { e.offsets = true }},
+	}
+	for _, c := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			A uint64
+			B []byte `+"`ssz-max:\"32\"`"+`
+		}`)
+		if err := e.generateIR(); err != nil {
+			t.Fatal(err)
+		}
+		out, _, err := e.print([]string{"Obj"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(out, c.method) {
+			t.Fatalf("unexpected %s without its flag", c.method)
+		}
+		c.enable(e)
+		if out, _, err = e.print([]string{"Obj"}, false); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out, "func (o *Obj) "+c.method+"(") {
+			t.Fatalf("expected %s with its flag", c.method)
+		}
+	}
+}
+
+func TestTreeCacheTag(t *testing.T) {
+	e := newTestEnv(t, `package test
+	type Obj struct {
+		A uint64
+		tree *ssz.Node `+"`ssz-tree-cache:\"true\"`"+`
+	}`)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	if obj := e.objs["Obj"]; obj.treeCache != "tree" || len(obj.o) != 1 {
+		t.Fatal("expected the cached tree to be skipped from the fields")
+	}
+	// the tree is only generated with the experimental flag
+	if _, _, err := e.print([]string{"Obj"}, false); err == nil || !strings.Contains(err.Error(), "requires the experimental flag") {
+		t.Fatalf("expected an error without the experimental flag but found %v", err)
+	}
+	if _, _, err := e.print([]string{"Obj"}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"*ssz.Node `ssz-tree-cache:\"false\"`": "ssz-tree-cache only accepts the value 'true'",
+		"[]byte `ssz-tree-cache:\"true\"`":     "ssz-tree-cache requires a *ssz.Node field",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			F `+typ+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestListSizeTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A []byte `+"`ssz-size:\"*\" ssz-max:\"64\"`"+`
+		B [][]byte `+"`ssz-size:\"4,*\" ssz-max:\"?,64\"`"+`
+		C []uint64 `+"`ssz-size:\"*\" ssz-max:\"8\"`"+`
+	}`)
+	obj := objs["Obj"]
+	if f := obj.o[0]; f.t != TypeBytes || f.isFixed() || f.m != 64 {
+		t.Fatal("expected a byte list")
+	}
+	if f := obj.o[1]; f.t != TypeVector || f.s != 4 || f.e.t != TypeBytes || f.e.isFixed() || f.e.m != 64 {
+		t.Fatal("expected a vector of byte lists")
+	}
+	if f := obj.o[2]; f.t != TypeList || f.s != 8 {
+		t.Fatal("expected a list of uints")
+	}
+}
+
+func TestEmptyContainer(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Empty struct {}
+	type Obj struct {
+		A *Empty
+		B []*Empty `+"`ssz-size:\"2\"`"+`
+	}`)
+	if empty := objs["Empty"]; !empty.isFixed() || empty.fixedSize() != 0 || len(empty.o) != 0 {
+		t.Fatal("expected a fixed container without bytes")
+	}
+
+	// the length of a list of empty containers cannot be decoded
+	e := newTestEnv(t, `package test
+	type Empty struct {}
+	type Obj struct {
+		F []*Empty `+"`ssz-max:\"4\"`"+`
+	}`)
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "field F is a list of the empty container Empty") {
+		t.Fatalf("expected an error for the list of empty containers but found %v", err)
+	}
+}
+
+func TestCustomFieldMethods(t *testing.T) {
+	methods := `
+	func (o *Obj) marshalFieldB(dst []byte) []byte { return dst }
+	func (o *Obj) unmarshalFieldB(buf []byte) error { return nil }
+	func (o *Obj) sizeFieldB() int { return 0 }
+	`
+	src := `package test
+	import ssz "github.com/photon-storage/fastssz"
+	type Obj struct {
+		A uint64
+		B float64 ` + "`ssz-size:\"8\"`" + `
+		C string
+	}` + methods
+
+	// the field does not have the hash method
+	e := newTestEnv(t, src)
+	err := e.generateIR()
+	if err == nil || !strings.Contains(err.Error(), "does not declare func (x *Obj) hashTreeRootFieldB(hh *ssz.Hasher) error") {
+		t.Fatalf("expected an error for the partial override but found %v", err)
+	}
+
+	objs := generateTestIR(t, src+`
+	func (o *Obj) hashTreeRootFieldB(hh *ssz.Hasher) error { return nil }
+	func (o *Obj) marshalFieldC(dst []byte) []byte { return dst }
+	func (o *Obj) unmarshalFieldC(buf []byte) error { return nil }
+	func (o *Obj) sizeFieldC() int { return 0 }
+	func (o *Obj) hashTreeRootFieldC(hh *ssz.Hasher) error { return nil }
+	`)
+	obj := objs["Obj"]
+	if b := obj.o[1]; b.t != TypeCustom || !b.isFixed() || b.fixedSize() != 8 {
+		t.Fatal("expected a fixed custom field of 8 bytes")
+	}
+	if c := obj.o[2]; c.t != TypeCustom || c.isFixed() {
+		t.Fatal("expected a dynamic custom field")
+	}
+}
+
+func TestCheckLayout(t *testing.T) {
+	// a fixed custom field without bytes would not shift the offsets of the next fields
+	e := newTestEnv(t, `package test
+	import ssz "github.com/photon-storage/fastssz"
+	type Obj struct {
+		A uint64
+		B float64 `+"`ssz-size:\"0\"`"+`
+		C []byte `+"`ssz-max:\"32\"`"+`
+	}
+	func (o *Obj) marshalFieldB(dst []byte) []byte { return dst }
+	func (o *Obj) unmarshalFieldB(buf []byte) error { return nil }
+	func (o *Obj) sizeFieldB() int { return 0 }
+	func (o *Obj) hashTreeRootFieldB(hh *ssz.Hasher) error { return nil }
+	`)
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "inconsistent layout of Obj: the fixed field B (custom) has no bytes") {
+		t.Fatalf("expected an error for the fixed field without bytes but found %v", err)
+	}
+
+	// the values that do not come from the parser are checked as well
+	obj := &Value{name: "Obj", t: TypeContainer, o: []*Value{
+		{name: "A", t: TypeUint, s: 8},
+		{name: "B", t: TypeVector, s: 2, e: &Value{t: TypeList, s: 4, e: &Value{t: TypeUint, s: 8}}},
+	}}
+	if err := obj.checkLayout(); err != nil {
+		t.Fatal(err)
+	}
+	obj.o[1].e = &Value{t: TypeList, s: 4, e: &Value{t: TypeCustom, fixed: true}}
+	if err := obj.checkLayout(); err == nil || !strings.Contains(err.Error(), "(custom) are fixed but they have no bytes") {
+		t.Fatalf("expected an error for the elements without bytes but found %v", err)
+	}
+
+	// the containers of the tests have a consistent layout
+	objs := generateTestIR(t, `package test
+	type Empty struct {}
+	type Inner struct {
+		A []byte `+"`ssz-max:\"32\"`"+`
+	}
+	type Obj struct {
+		A uint64
+		B *Inner
+		C []*Inner `+"`ssz-size:\"2\"`"+`
+		D [2]*Empty
+		E []uint16 `+"`ssz-max:\"8\"`"+`
+	}`)
+	for name, obj := range objs {
+		if err := obj.checkLayout(); err != nil {
+			t.Fatalf("unexpected layout error for %s: %v", name, err)
+		}
+	}
+}
+
+func TestVectorExactLength(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A []uint32 `+"`ssz-size:\"4\"`"+`
+		B [32]byte
+	}`)
+	a, b := objs["Obj"].o[0], objs["Obj"].o[1]
+
+	// the buffer of unknown length is checked before it is decoded
+	if str := a.unmarshal("buf"); !strings.Contains(str, "if len(buf) != 16 {\n\t\t\t\treturn ssz.ErrVectorLength") {
+		t.Fatalf("expected the vector length to be checked:\n%s", str)
+	}
+	if str := b.unmarshal("buf"); !strings.Contains(str, "if len(buf) != 32 { return ssz.ErrBytesLength }") {
+		t.Fatalf("expected the array length to be checked:\n%s", str)
+	}
+	// the regions of the fixed fields have the size of the vector
+	if str := a.unmarshal("buf[4:20]"); strings.Contains(str, "ErrVectorLength") {
+		t.Fatalf("unexpected check of the region of the vector:\n%s", str)
+	}
+	if str := b.unmarshal("buf[ii*32: (ii+1)*32]"); strings.Contains(str, "ErrBytesLength") {
+		t.Fatalf("unexpected check of the element of the list:\n%s", str)
+	}
+	if str := b.unmarshal("buf[4:20]"); !strings.Contains(str, "ErrBytesLength") {
+		t.Fatalf("expected the short region to be checked:\n%s", str)
+	}
+}
+
+func TestNolint(t *testing.T) {
+	var directive string
+	f := nolintFlag{&directive}
+	cases := map[string]string{
+		"true":                               "//nolint:all",
+		"gocyclo,funlen":                     "//nolint:gocyclo,funlen",
+		"//lint:file-ignore U1000 generated": "//lint:file-ignore U1000 generated",
+		"false":                              "",
+	}
+	for value, expected := range cases {
+		if err := f.Set(value); err != nil {
+			t.Fatal(err)
+		}
+		if directive != expected {
+			t.Fatalf("expected %s for %s but found %s", expected, value, directive)
+		}
+	}
+	for _, value := range []string{"", "gocyclo funlen", "//nolint\npackage"} {
+		if err := f.Set(value); err == nil {
+			t.Fatalf("expected an error for %q", value)
+		}
+	}
+
+	defer func(r io.Reader, w io.Writer) {
+		stdin, stdout = r, w
+	}(stdin, stdout)
+
+	out := new(bytes.Buffer)
+	stdin, stdout = strings.NewReader(`package test
+	type Obj struct {
+		A uint64
+	}`), out
+	if err := encode(encodeOptions{source: stdio, output: stdio, nolint: "//nolint:all", maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	// the directive is the last line before the package clause
+	if !bytes.Contains(out.Bytes(), []byte("\n//nolint:all\npackage test\n")) {
+		t.Fatalf("expected the directive before the package clause:\n%s", out.String())
+	}
+
+	// the source files with the generated code also have hand-written code
+	err := encode(encodeOptions{source: "./main.go", inplace: true, nolint: "//nolint:all", maxDepth: defaultMaxDepth, maxErrors: 1})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used with the inplace flag") {
+		t.Fatalf("expected an error with the inplace flag but found %v", err)
+	}
+}
+
+func TestTypesFromJSON(t *testing.T) {
+	schemas := `[
+		{"Name": "Header", "Fields": [
+			{"Name": "Slot", "Type": "uint64", "Size": 8, "Fixed": true},
+			{"Name": "Root", "Type": "Vector[byte,32]", "Size": 32, "Fixed": true}
+		]},
+		{"Name": "Block", "Fields": [
+			{"Name": "Header", "Type": "Header", "Size": 40, "Fixed": true},
+			{"Name": "Parent", "Type": "Optional[Header]"},
+			{"Name": "Bits", "Type": "Bitlist[64]"},
+			{"Name": "Flags", "Type": "Bitvector[12]", "Size": 2, "Fixed": true},
+			{"Name": "Scores", "Type": "Vector[uint16,4]", "Size": 8, "Fixed": true},
+			{"Name": "Headers", "Type": "List[Header,16]"},
+			{"Name": "Roots", "Type": "List[Vector[byte,32],8]"},
+			{"Name": "Blobs", "Type": "List[List[byte,64],4]"},
+			{"Name": "Done", "Type": "bool", "Size": 1, "Fixed": true}
+		]}
+	]`
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schemas.json")
+	if err := ioutil.WriteFile(path, []byte(schemas), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "types.go")
+	if err := typesFromJSON(path, output, "types"); err != nil {
+		t.Fatal(err)
+	}
+	src, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{
+		"Root []byte `ssz-size:\"32\"`",
+		"Parent  *Header   `ssz-optional:\"true\"`",
+		"Bits    []byte    `ssz:\"bitlist\" ssz-max:\"64\"`",
+		"Flags   []byte    `ssz:\"bitvector\" ssz-size:\"12\"`",
+		"Roots   [][]byte  `ssz-size:\"?,32\" ssz-max:\"8\"`",
+		"Blobs   [][]byte  `ssz-max:\"4,64\"`",
+	} {
+		if !strings.Contains(string(src), field) {
+			t.Fatalf("expected the field %s in:\n%s", field, src)
+		}
+	}
+
+	// the structs have the same schemas
+	objs := generateTestIR(t, string(src))
+	if schema := objs["Header"].schemaContainer(true); schema != "Container(Slot:uint64,Root:Vector[byte,32])" {
+		t.Fatalf("bad schema of Header %s", schema)
+	}
+	if schema := objs["Block"].schemaContainer(true); schema != "Container(Header:Header,Parent:Optional[Header],Bits:Bitlist[64],Flags:Bitvector[12],Scores:Vector[uint16,4],Headers:List[Header,16],Roots:List[Vector[byte,32],8],Blobs:List[List[byte,64],4],Done:bool)" {
+		t.Fatalf("bad schema of Block %s", schema)
+	}
+
+	cases := map[string]string{
+		`{"Name": "Obj", "Fields": [{"Name": "A", "Type": "Other"}]}`:              "unknown type Other",
+		`{"Name": "Obj", "Fields": [{"Name": "A", "Type": "Custom[8]"}]}`:          "cannot be generated",
+		`{"Name": "Obj", "Fields": [{"Name": "A", "Type": "List[Bitlist[8],2]"}]}`: "cannot be the element of a collection",
+		`{"Name": "Obj", "Fields": [{"Name": "A", "Type": "List[uint64,x]"}]}`:     "bad length",
+		`{"Name": "Obj", "Fields": [{"Name": "a", "Type": "uint64"}]}`:             "not a valid name of an exported field",
+		`[{"Name": "Obj", "Fields": []}, {"Name": "Obj", "Fields": []}]`:           "two schemas share the same name",
+	}
+	for schema, expected := range cases {
+		if err := ioutil.WriteFile(path, []byte(schema), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := typesFromJSON(path, output, "types"); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", schema, err)
+		}
+	}
+}
+
+func TestBuildTags(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"mainnet.go": `//go:build mainnet
+
+package types
+
+type Obj struct {
+	Roots [][]byte ` + "`ssz-size:\"4,32\"`" + `
+}`,
+		"minimal.go": `//go:build !mainnet
+
+package types
+
+type Obj struct {
+	Roots [][]byte ` + "`ssz-size:\"2,32\"`" + `
+}`,
+		"common.go": `package types
+
+type Other struct {
+	A uint64
+}
+
+// the structs declared in a function cannot have methods
+func local() {
+	type Obj struct {
+		B uint32
+	}
+}`,
+	}
+	for name, src := range srcs {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// only the variant of the build tags is parsed
+	if err := encode(encodeOptions{source: dir, buildTags: []string{"mainnet"}, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "minimal_encoding.go")); !os.IsNotExist(err) {
+		t.Fatalf("expected the variant without the build tag to be skipped: %v", err)
+	}
+	if out := read("mainnet_encoding.go"); !strings.Contains(out, "//go:build mainnet\n\npackage types") || !strings.Contains(out, "ssz.ErrVectorLength") {
+		t.Fatalf("expected the build constraint of the source file:\n%s", out)
+	}
+	if out := read("common_encoding.go"); strings.Contains(out, "go:build") || strings.Contains(out, "B uint32") {
+		t.Fatalf("expected the file without build constraints:\n%s", out)
+	}
+
+	// the other variant is generated without the build tags
+	if err := encode(encodeOptions{source: dir, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if out := read("minimal_encoding.go"); !strings.Contains(out, "//go:build !mainnet\n\npackage types") {
+		t.Fatalf("expected the build constraint of the source file:\n%s", out)
+	}
+
+	// a single output has the constraints of all the files
+	output := filepath.Join(dir, "out.go")
+	if err := encode(encodeOptions{source: dir, output: output, buildTags: []string{"mainnet"}, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if out := read("out.go"); !strings.Contains(out, "//go:build mainnet\n\npackage types") {
+		t.Fatalf("expected the build constraint of the source files:\n%s", out)
+	}
+}
+
+func TestInlineTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Inner struct {
+		A uint64
+		B []byte `+"`ssz-max:\"8\"`"+`
+	}
+	type Middle struct {
+		Inner Inner `+"`ssz-inline:\"true\"`"+`
+		C     bool
+	}
+	type Obj struct {
+		D      uint32
+		Middle Middle `+"`ssz-inline:\"true\"`"+`
+	}`)
+	obj := objs["Obj"]
+	names := []string{}
+	for _, f := range obj.o {
+		names = append(names, f.name)
+	}
+	if expected := []string{"D", "Middle.Inner.A", "Middle.Inner.B", "Middle.C"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected the fields %v but found %v", expected, names)
+	}
+	if obj.isFixed() || obj.fixedSize() != 4+8+4+1 {
+		t.Fatal("expected a dynamic container with the fixed parts of the inlined fields")
+	}
+	// the struct of the inlined field is not modified
+	if inner := objs["Inner"]; inner.o[0].name != "A" {
+		t.Fatalf("bad field %s of Inner", inner.o[0].name)
+	}
+
+	cases := map[string]string{
+		"Inner `ssz-inline:\"false\"`":                "ssz-inline only accepts the value 'true'",
+		"*Inner `ssz-inline:\"true\"`":                "ssz-inline requires a struct value of the same package",
+		"uint64 `ssz-inline:\"true\"`":                "ssz-inline requires a struct value of the same package",
+		"Packed `ssz-inline:\"true\"`":                "ssz-inline cannot flatten Packed",
+		"[]Inner `ssz-max:\"2\" ssz-inline:\"true\"`": "ssz-inline requires a struct value of the same package",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Inner struct {
+			A uint64
+		}
+		type Packed struct {
+			_ struct{} `+"`ssz-pack-bools:\"true\"`"+`
+			A bool
+		}
+		type Obj struct {
+			F `+typ+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestStrictTags(t *testing.T) {
+	defer func(level logLevel, out io.Writer) {
+		verbosity, logOutput = level, out
+	}(verbosity, logOutput)
+
+	var buf bytes.Buffer
+	verbosity, logOutput = levelWarn, &buf
+
+	if keys := tagKeys("`ssz-size:\"4, 32\" json:\"a,omitempty\" ssz-mx:\"8\"`"); !reflect.DeepEqual(keys, []string{"ssz-size", "json", "ssz-mx"}) {
+		t.Fatalf("bad keys %v", keys)
+	}
+
+	src := `package test
+	type Obj struct {
+		A [32]byte ` + "`ssz-mx:\"32\" json:\"a\"`" + `
+		B []uint64 ` + "`ssz-max:\"8\"`" + `
+		c uint64 ` + "`sszsize:\"8\"`" + `
+	}`
+
+	// the unknown tags are warnings by default
+	e := newTestEnv(t, src)
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	logs := buf.String()
+	for _, expected := range []string{"unknown tag ssz-mx in field A of Obj", "unknown tag sszsize in field c of Obj"} {
+		if !strings.Contains(logs, expected) {
+			t.Fatalf("expected the warning %s but found %s", expected, logs)
+		}
+	}
+	if strings.Contains(logs, "json") || strings.Contains(logs, "ssz-max") {
+		t.Fatalf("unexpected warning for a known tag: %s", logs)
+	}
+
+	// and errors with the strict-tags flag
+	buf.Reset()
+	e = newTestEnv(t, src)
+	e.strictTags = true
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "unknown tag ssz-mx in field A of Obj") {
+		t.Fatalf("expected an error for the unknown tag but found %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("unexpected warnings %s", buf.String())
+	}
+}
+
+func TestNamedSlice(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Blob []byte
+	type Obj struct {
+		A Blob `+"`ssz-max:\"64\"`"+`
+		B Blob `+"`ssz-size:\"8\"`"+`
+		C []Blob `+"`ssz-max:\"4,16\"`"+`
+	}`)
+	// the named slice does not have methods, its length is given by each field
+	if _, ok := objs["Blob"]; ok {
+		t.Fatal("expected the named slice to be encoded with the tags of the fields")
+	}
+	obj := objs["Obj"]
+	if schema := obj.schemaContainer(true); schema != "Container(A:List[byte,64],B:Vector[byte,8],C:List[List[byte,16],4])" {
+		t.Fatalf("bad schema %s", schema)
+	}
+	for indx, f := range obj.o[:2] {
+		if f.goType() != "Blob" {
+			t.Fatalf("expected the type Blob of field %d but found %s", indx, f.goType())
+		}
+	}
+	if typ := obj.o[2].goType(); typ != "[]Blob" {
+		t.Fatalf("expected the type []Blob but found %s", typ)
+	}
+}
+
+func TestReservedTag(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A uint32
+		_ [4]byte `+"`ssz-reserved:\"4\"`"+`
+		_ struct{} `+"`ssz-fields:\"3\"`"+`
+		B []byte `+"`ssz-max:\"8\"`"+`
+	}`)
+	obj := objs["Obj"]
+	if len(obj.o) != 3 || obj.o[1].t != TypeReserved || obj.o[1].name != reservedName {
+		t.Fatal("expected the reserved bytes as the second field")
+	}
+	if obj.fixedSize() != 4+4+4 {
+		t.Fatalf("expected the reserved bytes in the fixed part but found %d bytes", obj.fixedSize())
+	}
+
+	cases := map[string]string{
+		"[4]byte `ssz-reserved:\"0\"`":   "ssz-reserved must be a positive number of bytes",
+		"[4]byte `ssz-reserved:\"a\"`":   "ssz-reserved must be a positive number of bytes",
+		"[4]byte `ssz-reserved:\"8\"`":   "ssz-reserved requires a blank [8]byte field",
+		"[]byte `ssz-reserved:\"4\"`":    "ssz-reserved requires a blank [4]byte field",
+		"[4]uint16 `ssz-reserved:\"4\"`": "ssz-reserved requires a blank [4]byte field",
+	}
+	for typ, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			A uint64
+			_ `+typ+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", typ, err)
+		}
+	}
+}
+
+func TestRecursive(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"types.go": `package types
+
+import "example.com/types/sub"
+
+type Obj struct {
+	Inner *sub.Inner
+	A     uint64
+}`,
+		"sub/sub.go": `package sub
+
+type Inner struct {
+	B uint64
+}`,
+		// a package without structs
+		"util/util.go": `package util
+
+func Add(a, b uint64) uint64 {
+	return a + b
+}`,
+		"sub/sub_test.go": `package sub_test`,
+		"testdata/data.go": `package data
+
+type Skipped struct {
+	C uint64
+}`,
+	}
+	for name, src := range srcs {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dirs, err := packageDirs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{dir, filepath.Join(dir, "sub"), filepath.Join(dir, "util")}
+	if !reflect.DeepEqual(dirs, expected) {
+		t.Fatalf("expected the packages %v but found %v", expected, dirs)
+	}
+
+	encodeDir := func(source string, includePaths []string) error {
+		return encode(encodeOptions{source: source, includePaths: includePaths, maxDepth: defaultMaxDepth, maxErrors: 1})
+	}
+	if err := encodeRecursive(dir, nil, encodeDir); err != nil {
+		t.Fatal(err)
+	}
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	// each package has its own files and the references to the other packages are resolved
+	if out := read("types_encoding.go"); !strings.Contains(out, "package types") || !strings.Contains(out, "new(sub.Inner)") {
+		t.Fatalf("bad output of the types package:\n%s", out)
+	}
+	if out := read("sub/sub_encoding.go"); !strings.Contains(out, "package sub") || !strings.Contains(out, "*Inner) MarshalSSZTo(") {
+		t.Fatalf("bad output of the sub package:\n%s", out)
+	}
+	for _, name := range []string{"util/util_encoding.go", "testdata/data_encoding.go"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to not be generated: %v", name, err)
+		}
+	}
+
+	// in a module, the packages are identified by their import paths
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/types\n\ngo 1.18\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	paths, inModule, err := importPaths(dir, dirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedPaths := map[string]string{dir: "example.com/types", expected[1]: "example.com/types/sub", expected[2]: "example.com/types/util"}
+	if !inModule || !reflect.DeepEqual(paths, expectedPaths) {
+		t.Fatalf("expected the import paths %v but found %v", expectedPaths, paths)
+	}
+	// the imported packages are generated first
+	sorted, err := sortByImports(dir, dirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{expected[1], dir, expected[2]}; !reflect.DeepEqual(sorted, expected) {
+		t.Fatalf("expected the order %v but found %v", expected, sorted)
+	}
+
+	cases := map[string]struct {
+		source, output, packageName string
+		targets                     []string
+	}{
+		"the recursive flag requires the path flag with a directory":                 {source: stdio},
+		"cannot be used with the output or package flags":                            {source: dir, output: "out.go"},
+		"cannot be used with the objs flag":                                          {source: dir, targets: []string{"Obj"}},
+		"requires the path flag with a directory but " + dir + "/types.go is a file": {source: filepath.Join(dir, "types.go")},
+	}
+	for expected, c := range cases {
+		if err := checkRecursive(c.source, c.output, c.packageName, c.targets); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error '%s' but found %v", expected, err)
+		}
+	}
+}
+
+func TestExcludeFields(t *testing.T) {
+	defer func(level logLevel, out io.Writer) {
+		verbosity, logOutput = level, out
+	}(verbosity, logOutput)
+
+	var buf bytes.Buffer
+	verbosity, logOutput = levelWarn, &buf
+
+	src := `package test
+	type Block struct {
+		Slot      uint64
+		Signature []byte
+		Body      *Body
+	}
+	type Body struct {
+		Root   [32]byte
+		Cached [32]byte
+	}
+	type Header struct {
+		_      struct{} ` + "`ssz-fields:\"2\"`" + `
+		Slot   uint64
+		Cached [32]byte
+	}`
+
+	// the excluded fields are not encoded, even if their type cannot be encoded
+	e := newTestEnv(t, src)
+	e.excludeFields = map[string]bool{"Block.Signature": true, "Body.Cached": true}
+	e.excludedFields = map[string]bool{}
+	if err := e.generateIR(); err != nil {
+		t.Fatal(err)
+	}
+	if block := e.objs["Block"]; len(block.o) != 2 || block.o[1].name != "Body" {
+		t.Fatal("expected the Signature field of Block to be excluded")
+	}
+	if body := e.objs["Body"]; len(body.o) != 1 || body.o[0].name != "Root" {
+		t.Fatal("expected the Cached field of Body to be excluded")
+	}
+	logs := buf.String()
+	for _, expected := range []string{"field Block.Signature is excluded", "field Body.Cached is excluded"} {
+		if strings.Count(logs, expected) != 1 {
+			t.Fatalf("expected the warning %s once but found %s", expected, logs)
+		}
+	}
+
+	// the count of the fields fails if a field is excluded
+	e = newTestEnv(t, src)
+	e.excludeFields = map[string]bool{"Block.Signature": true, "Header.Cached": true}
+	e.excludedFields = map[string]bool{}
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "Header has 1 ssz fields but ssz-fields expects 2") {
+		t.Fatalf("expected the fields count to fail but found %v", err)
+	}
+
+	// the fields that are not found are reported
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "types.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err := encode(encodeOptions{source: dir, excludeFields: map[string]bool{"Block.Signature": true, "Block.Other": true}, maxDepth: defaultMaxDepth, maxErrors: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if logs := buf.String(); !strings.Contains(logs, "the excluded field Block.Other was not found") || strings.Contains(logs, "Block.Signature was not found") {
+		t.Fatalf("expected the warning of the field that was not found: %s", logs)
+	}
+
+	for _, name := range []string{"Signature", "Block.", "Block.Body.Root"} {
+		err := encode(encodeOptions{source: dir, excludeFields: map[string]bool{name: true}, maxDepth: defaultMaxDepth, maxErrors: 1})
+		if err == nil || !strings.Contains(err.Error(), "expects fields as Type.Field") {
+			t.Fatalf("expected an error for %s but found %v", name, err)
+		}
+	}
+}
+
+func TestOneofUnion(t *testing.T) {
+	oneof := `
+	type isMsg_Body interface {
+		isMsg_Body()
+	}
+	type Msg_B struct {
+		B uint64
+	}
+	type Msg_A struct {
+		A []byte ` + "`ssz-max:\"8\"`" + `
+	}
+	func (*Msg_A) isMsg_Body() {}
+	func (*Msg_B) isMsg_Body() {}
+	`
+	objs := generateTestIR(t, `package test
+	type Msg struct {
+		Slot uint64
+		Body isMsg_Body
+	}`+oneof)
+
+	// the selectors follow the order in which the wrappers are declared
+	body := objs["Msg"].o[1]
+	if body.t != TypeUnion || body.isFixed() || len(body.o) != 2 {
+		t.Fatal("expected a dynamic union with two options")
+	}
+	if body.o[0].wrapper != "Msg_B" || body.o[0].t != TypeUint || body.o[1].wrapper != "Msg_A" || body.o[1].t != TypeBytes {
+		t.Fatal("expected the options in the order of the wrappers")
+	}
+	if schema := body.schema(); schema != "Union[None,uint64,List[byte,8]]" {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+	// the wrappers do not have their own methods
+	if _, ok := objs["Msg_A"]; ok {
+		t.Fatal("unexpected methods of the wrapper")
+	}
+
+	e := newTestEnv(t, `package test
+	type Msg struct {
+		Bodies []isMsg_Body `+"`ssz-max:\"4\"`"+`
+	}`+oneof)
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "oneof fields are not supported as the elements of the collection Bodies") {
+		t.Fatalf("expected an error for a list of oneofs but found %v", err)
+	}
+
+	e = newTestEnv(t, `package test
+	type Msg struct {
+		Body isMsg_Body
+	}
+	type isMsg_Body interface {
+		isMsg_Body()
+	}
+	type Msg_A struct {
+		A uint64
+		B uint64
+	}
+	func (*Msg_A) isMsg_Body() {}`)
+	if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), "the wrapper Msg_A of the oneof field Body must have a single exported field") {
+		t.Fatalf("expected an error for a wrapper with two fields but found %v", err)
+	}
+}
+
+func TestFramedFields(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A uint64
+		Len uint16 `+"`ssz-length-of:\"B\"`"+`
+		B []byte `+"`ssz-length-from:\"Len\" ssz-max:\"1024\"`"+`
+		C uint32
+	}`)
+	obj := objs["Obj"]
+	// the framed field has no offset in the fixed part
+	if obj.isFixed() || obj.fixedSize() != 14 || obj.hasOffsets() {
+		t.Fatalf("bad layout of the framed field: fixed %v with %d bytes", obj.isFixed(), obj.fixedSize())
+	}
+	if err := obj.checkLayout(); err != nil {
+		t.Fatal(err)
+	}
+	if offsets := new(env).offsetsMethod("Obj", obj); offsets != "" {
+		t.Fatalf("unexpected offsets of the framed field:\n%s", offsets)
+	}
+
+	cases := map[string]string{
+		// the length field
+		"Len bool `ssz-length-of:\"B\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`":                        "ssz-length-of requires a uint field",
+		"Len uint16 `ssz-length-of:\"C\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`":                      "the field C referenced by the length field Len does not exist",
+		"Len uint16 `ssz-length-of:\"B\"`\nB []byte `ssz-max:\"32\"`":                                              "the field B of Obj requires the tag ssz-length-from:\"Len\"",
+		"Len uint16 `ssz-length-of:\"\"`\nB []byte `ssz-max:\"32\"`":                                               "ssz-length-of requires the name of a field",
+		"Len uint8 `ssz-length-of:\"B\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"256\"`":                      "the length field Len (uint8) of B cannot hold its maximum length 256",
+		"B []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`\nLen uint16 `ssz-length-of:\"B\"`":                      "the length field Len must precede the framed field B",
+		"Len uint16 `ssz-length-of:\"B\" ssz-encrypt:\"true\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`": "ssz-encrypt is not supported with the framed field B",
+		// the framed field
+		"Len uint16\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`":                                                                          "the length field Len of Obj requires the tag ssz-length-of:\"B\"",
+		"Len [2]byte\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`":                                                                         "the length field Len of B must be a uint but it is a bytes",
+		"B []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`":                                                                                      "the length field Len of B does not exist in Obj",
+		"Len uint16 `ssz-length-of:\"B\"`\nB []byte `ssz-length-from:\"Len\" ssz-size:\"32\"`":                                                   "ssz-length-from requires a []byte field with ssz-max",
+		"Len uint16 `ssz-length-of:\"B\"`\nB []uint16 `ssz-length-from:\"Len\" ssz-max:\"32\"`":                                                  "ssz-length-from requires a []byte field with ssz-max",
+		"Len uint16 `ssz-length-of:\"B\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`\nC []byte `ssz-max:\"32\"`":                         "the framed field B cannot be used with the dynamic field C of Obj",
+		"Len uint16 `ssz-length-of:\"B\"`\nB []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`\nC []byte `ssz-length-from:\"Len\" ssz-max:\"32\"`": "Obj has more than one ssz-length-from field",
+	}
+	for fields, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			`+fields+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", fields, err)
+		}
+	}
+}
+
+func TestBitfieldTags(t *testing.T) {
+	objs := generateTestIR(t, `package test
+	type Obj struct {
+		A []byte `+"`ssz-bitlist:\"2048\"`"+`
+		B []byte `+"`ssz-bitvector:\"12\"`"+`
+	}`)
+	obj := objs["Obj"]
+	if a := obj.o[0]; a.t != TypeBitList || a.m != 2048 || a.bitfield != "ObjA" {
+		t.Fatalf("bad bitlist field %s %d %s", a.t, a.m, a.bitfield)
+	}
+	if b := obj.o[1]; b.t != TypeBytes || b.s != 2 || b.bits != 12 || b.bitfield != "ObjB" {
+		t.Fatalf("bad bitvector field %s %d %d %s", b.t, b.s, b.bits, b.bitfield)
+	}
+
+	cases := map[string]string{
+		"A []byte `ssz-bitlist:\"0\"`":                        "ssz-bitlist requires a positive number of bits, field A",
+		"A []byte `ssz-bitvector:\"a\"`":                      "ssz-bitvector requires a positive number of bits, field A",
+		"A []byte `ssz-bitlist:\"8\" ssz-bitvector:\"8\"`":    "field A cannot have both the ssz-bitlist and ssz-bitvector tags",
+		"A []byte `ssz-bitlist:\"8\" ssz-max:\"8\"`":          "ssz-bitlist cannot be used with the ssz-max tag, field A",
+		"A []byte `ssz-bitvector:\"8\" ssz:\"bitvector\"`":    "ssz-bitvector cannot be used with the ssz tag, field A",
+		"A []uint16 `ssz-bitlist:\"8\"`":                      "ssz-bitlist requires a []byte",
+		"A [1]byte `ssz-bitvector:\"8\"`":                     "ssz-bitvector requires a []byte",
+		"A []byte `ssz-bitlist:\"8\"`\n}\ntype ObjA struct {": "the type ObjA of the bitlist field A is already declared",
+	}
+	for fields, expected := range cases {
+		e := newTestEnv(t, `package test
+		type Obj struct {
+			`+fields+`
+		}`)
+		if err := e.generateIR(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("bad error for %s: %v", fields, err)
+		}
+	}
+}
@@ -5,15 +5,22 @@ import (
 	"strings"
 )
 
-// marshal creates a function that encodes the structs in SSZ format. It creates two functions:
+// marshal creates a function that encodes the structs in SSZ format. It creates three functions:
 // 1. MarshalTo(dst []byte) marshals the content to the target array.
 // 2. Marshal() marshals the content to a newly created array.
+// 3. MarshalPooled() marshals the content to an array of a pool.
 func (e *env) marshal(name string, v *Value) string {
-	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object
+	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object to a new buffer owned by the caller
 	func (:: *{{.name}}) MarshalSSZ() ([]byte, error) {
 		return ssz.MarshalSSZ(::)
 	}
 
+	// MarshalSSZPooled ssz marshals the {{.name}} object to a borrowed buffer of ssz.DefaultBufferPool,
+	// its bytes must not be retained after it is released
+	func (:: *{{.name}}) MarshalSSZPooled() (*ssz.Buffer, error) {
+		return ssz.MarshalSSZPooled(::)
+	}
+
 	// MarshalSSZTo ssz marshals the {{.name}} object to a target array
 	func (:: *{{.name}}) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = buf
@@ -27,12 +34,12 @@ func (e *env) marshal(name string, v *Value) string {
 		"marshal": v.marshalContainer(true),
 		"offset":  "",
 	}
-	if !v.isFixed() {
+	if v.hasOffsets() {
 		// offset is the position where the offset starts
 		data["offset"] = fmt.Sprintf("offset := int(%d)\n", v.fixedSize())
 	}
 	str := execTmpl(tmpl, data)
-	return appendObjSignature(str, v)
+	return e.appendObjSignature(str, v)
 }
 
 func (v *Value) marshal() string {
@@ -68,6 +75,9 @@ func (v *Value) marshal() string {
 	case TypeBool:
 		return fmt.Sprintf("dst = ssz.MarshalBool(dst, ::.%s)", v.name)
 
+	case TypePackedBools:
+		return v.marshalPackedBools()
+
 	case TypeVector:
 		if v.e.isFixed() {
 			return v.marshalVector()
@@ -77,6 +87,29 @@ func (v *Value) marshal() string {
 	case TypeList:
 		return v.marshalList()
 
+	case TypeCustom:
+		// the convention of the methods is documented in the generated code since
+		// they are only called by it
+		tmpl := `// custom codec, the container declares the methods
+		// marshalField{{.name}}(dst []byte) []byte, unmarshalField{{.name}}(buf []byte) error,
+		// sizeField{{.name}}() int and hashTreeRootField{{.name}}(hh *ssz.Hasher) error
+		{{if .fixed}}if size := ::.sizeField{{.name}}(); size != {{.size}} {
+			err = ssz.ErrBytesLength
+			return
+		}
+		{{end}}dst = ::.marshalField{{.name}}(dst)`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"fixed": v.isFixed(),
+			"size":  v.s,
+		})
+
+	case TypeReserved:
+		return v.marshalReserved()
+
+	case TypeUnion:
+		return fmt.Sprintf("if dst, err = ::.marshalUnion%s(dst); err != nil {\nreturn\n}", v.name)
+
 	default:
 		panic(fmt.Errorf("marshal not implemented for type %s", v.t.String()))
 	}
@@ -106,7 +139,9 @@ func (v *Value) marshalList() string {
 	tmpl := `{
 		offset = 4 * len(::.{{.name}})
 		for ii := 0; ii < len(::.{{.name}}); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
 			{{.size}}
 		}
 	}
@@ -137,6 +172,34 @@ func (v *Value) marshalVector() (str string) {
 }
 
 func (v *Value) marshalContainer(start bool) string {
+	if !start && v.iface {
+		tmpl := `{
+			obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				err = ssz.ErrConcreteType
+				return
+			}
+			if dst, err = obj.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"obj":  v.objRef(),
+		})
+	}
+	if !start && v.optional {
+		// the absent value has no bytes
+		tmpl := `if ::.{{.name}} != nil {
+			dst = append(dst, 1)
+			if dst, err = ::.{{.name}}.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+	}
 	if !start {
 		check := v.isFixed()
 		if v.isListElem() {
@@ -166,14 +229,25 @@ func (v *Value) marshalContainer(start bool) string {
 	offset := v.fixedSize()
 	out := []string{}
 
-	for indx, i := range v.o {
+	for indx := 0; indx < len(v.o); indx++ {
+		i := v.o[indx]
+		if run := v.fixedRun(indx); run > 1 {
+			// write the adjacent fixed fields at once
+			out = append(out, v.marshalFixedRun(indx, run))
+			indx += run - 1
+			continue
+		}
+
 		var str string
 		if i.isFixed() {
 			// write the content
 			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal())
+		} else if i.lengthFrom != "" {
+			// write the framed bytes in place
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalFramed())
 		} else {
 			// write the offset
-			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n%s\n", indx, i.name, i.size("offset"))
+			str = fmt.Sprintf("// Offset (%d) '%s'\nif dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {\nreturn\n}\n%s\n", indx, i.name, i.size("offset"))
 			offset += i.fixedSize()
 		}
 		out = append(out, str)
@@ -181,9 +255,85 @@ func (v *Value) marshalContainer(start bool) string {
 
 	// write the dynamic parts
 	for indx, i := range v.o {
-		if !i.isFixed() {
+		if i.hasOffset() {
 			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal()))
 		}
 	}
+
+	// write the extension after the known fields
+	if v.ext != "" {
+		out = append(out, fmt.Sprintf("// Extension '%s'\ndst = append(dst, ::.%s...)\n", v.ext, v.ext))
+	}
 	return strings.Join(out, "\n")
 }
+
+// putFixed returns the code to write the value in the region of the fixed
+// fields that starts at offset. It returns false if the value is not a basic
+// type or a byte array.
+func (v *Value) putFixed(offset uint64) (string, bool) {
+	region := fmt.Sprintf("fixed[%d:%d]", offset, offset+v.fixedSize())
+	switch v.t {
+	case TypeUint:
+		name := "::." + v.name
+		if v.ref != "" || v.obj != "" {
+			// alias of an uint
+			name = fmt.Sprintf("uint%d(%s)", v.s*8, name)
+		}
+		return fmt.Sprintf("ssz.Put%s(%s, %s)", uintVToName(v), region, name), true
+
+	case TypeBool:
+		return fmt.Sprintf("ssz.PutBool(%s, ::.%s)", region, v.name), true
+
+	case TypeBytes:
+		if !v.c {
+			// the length of the slices has to be validated
+			return "", false
+		}
+		return fmt.Sprintf("copy(%s, ::.%s[:])", region, v.name), true
+
+	case TypeReserved:
+		// the region is already zero
+		return "// reserved zero bytes", true
+
+	default:
+		return "", false
+	}
+}
+
+// fixedRun returns the number of adjacent fields starting at indx that can be
+// written with putFixed
+func (v *Value) fixedRun(indx int) int {
+	run := 0
+	for _, i := range v.o[indx:] {
+		if _, ok := i.putFixed(0); !ok {
+			break
+		}
+		run++
+	}
+	return run
+}
+
+// marshalFixedRun extends dst once for a run of fixed fields and writes
+// them in place, which avoids the bounds checks and copies of appending
+// each one of them. The output is the same as writing them one by one.
+func (v *Value) marshalFixedRun(indx, run int) string {
+	size := uint64(0)
+	out := []string{}
+	for j, i := range v.o[indx : indx+run] {
+		put, _ := i.putFixed(size)
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx+j, i.name, put))
+		size += i.fixedSize()
+	}
+
+	tmpl := `{
+		dst = append(dst, make([]byte, {{.size}})...)
+		fixed := dst[len(dst)-{{.size}}:]
+
+		{{.put}}
+	}
+	`
+	return execTmpl(tmpl, map[string]interface{}{
+		"size": size,
+		"put":  strings.Join(out, "\n\n"),
+	})
+}
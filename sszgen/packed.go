@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// packedBoolsName is the name of the synthetic field with the packed bools of a
+// struct tagged with ssz-pack-bools (i.e. _ struct{} `ssz-pack-bools:"true"`)
+const packedBoolsName = "PackedBools"
+
+// packBools replaces the bool fields of the container with a leading bitvector
+// where the bit i is the bool field i.
+func (v *Value) packBools() error {
+	bools := []*Value{}
+	fields := []*Value{}
+	for _, i := range v.o {
+		if i.t == TypeBool {
+			bools = append(bools, i)
+		} else {
+			fields = append(fields, i)
+		}
+	}
+	if len(bools) == 0 {
+		return fmt.Errorf("ssz-pack-bools requires bool fields in %s", v.name)
+	}
+	packed := &Value{
+		name: packedBoolsName,
+		t:    TypePackedBools,
+		s:    uint64(len(bools)+7) / 8,
+		o:    bools,
+	}
+	v.o = append([]*Value{packed}, fields...)
+	return nil
+}
+
+// packedBools returns the code to pack the bools in the 'packed' array
+func (v *Value) packedBools() string {
+	out := []string{fmt.Sprintf("var packed [%d]byte", v.s)}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("if ::.%s {\npacked[%d] |= 1 << %d\n}", i.name, indx/8, indx%8))
+	}
+	return strings.Join(out, "\n")
+}
+
+func (v *Value) marshalPackedBools() string {
+	return fmt.Sprintf("{\n%s\ndst = append(dst, packed[:]...)\n}", v.packedBools())
+}
+
+func (v *Value) hashTreeRootPackedBools() string {
+	return fmt.Sprintf("{\n%s\nhh.PutBytes(packed[:])\n}", v.packedBools())
+}
+
+func (v *Value) getTreePackedBools() string {
+	return fmt.Sprintf("{\n%s\nw.AddBytes(packed[:])\n}", v.packedBools())
+}
+
+func (v *Value) unmarshalPackedBools(dst string) string {
+	tmpl := `{
+		packed := {{.dst}}
+		{{if .trailing}}if packed[{{.last}}]>>{{.trailing}} != 0 {
+			return ssz.ErrInvalidBitvector
+		}
+		{{end}}{{.unpack}}
+	}`
+
+	unpack := []string{}
+	for indx, i := range v.o {
+		unpack = append(unpack, fmt.Sprintf("::.%s = packed[%d]&(1<<%d) != 0", i.name, indx/8, indx%8))
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"dst":      dst,
+		"last":     v.s - 1,
+		"trailing": len(v.o) % 8,
+		"unpack":   strings.Join(unpack, "\n"),
+	})
+}
+
+// packedFields returns the values that are encoded as a field of the container.
+// The bools packed in a bitvector are encoded as the same field and the reserved
+// bytes are not a field that can be selected.
+func (v *Value) packedFields() []*Value {
+	if v.t == TypePackedBools {
+		return v.o
+	}
+	if v.t == TypeReserved {
+		return nil
+	}
+	return []*Value{v}
+}
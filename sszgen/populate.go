@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// populatePrefix is the suffix of the generated file with the PopulateSSZ methods
+	populatePrefix = "_populate_test.go"
+	// populateListLimit is the maximum number of elements of the populated lists
+	// since the limit of some lists (i.e. the validators registry) cannot be allocated
+	populateListLimit = 1 << 12
+)
+
+// printPopulate creates a test file with the PopulateSSZ methods that fill the
+// objects with random values up to the limits of their lists, which are used to
+// seed property tests, fuzzers and benchmarks.
+func (e *env) printPopulate(order []string) (string, bool, error) {
+	hash, err := e.hashSource()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to hash files: %v", err)
+	}
+
+	tmpl := `// Code generated by fastssz. DO NOT EDIT.
+	// Hash: {{.hash}}
+	{{if .build}}{{.build}}
+
+	{{end}}{{if .nolint}}{{.nolint}}
+	{{end}}package {{.package}}
+
+	import (
+		"math/rand"
+		{{if .ssz}}
+		ssz "github.com/photon-storage/fastssz" {{end}}{{ if .imports }}{{ range $value := .imports }}
+			{{ $value }} {{ end }}
+		{{ end }}
+	)
+
+	{{ range .objs }}
+		{{ . }}
+	{{ end }}
+	`
+
+	data := map[string]interface{}{
+		"package": e.packName,
+		"hash":    hash,
+		"nolint":  e.nolint,
+		"build":   e.buildConstraint(order),
+	}
+
+	objs := []string{}
+	imports := []string{}
+	for _, name := range order {
+		obj, ok := e.printableObj(name)
+		if !ok {
+			continue
+		}
+		imports = appendWithoutRepeated(imports, detectImports(obj))
+		objs = append(objs, e.populateObj(name, obj))
+	}
+	if len(objs) == 0 {
+		return "", false, nil
+	}
+	data["objs"] = objs
+	// the runtime is only used to populate the bitlists
+	data["ssz"] = strings.Contains(strings.Join(objs, "\n"), "ssz.")
+
+	importsStr, err := e.buildImports(imports)
+	if err != nil {
+		return "", false, err
+	}
+	if len(importsStr) != 0 {
+		data["imports"] = importsStr
+	}
+	return execTmpl(tmpl, data), true, nil
+}
+
+func (e *env) populateObj(name string, v *Value) string {
+	tmpl := `// PopulateSSZ fills the {{.name}} object with random values, the lists
+	// are filled up to their limit
+	func (:: *{{.name}}) PopulateSSZ(rnd *rand.Rand) {
+		{{.fill}}
+	}`
+
+	out := []string{}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, e.fillField(v, i, true)))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+		"fill": strings.Join(out, "\n"),
+	})
+	return e.appendObjSignature(str, v)
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// checkRecursive returns an error if the flags cannot be used with the recursive flag,
+// which generates the files of each package of the tree next to its sources
+func checkRecursive(source, output, packageName string, targets []string) error {
+	if source == "" || source == stdio {
+		return fmt.Errorf("the recursive flag requires the path flag with a directory")
+	}
+	if output != "" || packageName != "" {
+		return fmt.Errorf("the recursive flag cannot be used with the output or package flags since each package has its own files")
+	}
+	if len(targets) != 0 {
+		return fmt.Errorf("the recursive flag cannot be used with the objs flag since the types of each package are generated")
+	}
+	ok, err := isDir(source)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("the recursive flag requires the path flag with a directory but %s is a file", source)
+	}
+	return nil
+}
+
+// packageDirs returns the directories of the tree rooted at root with Go files (other
+// than tests) in lexical order. As with the go tool, the testdata and vendor
+// directories and the directories that start with '.' or '_' are skipped.
+func packageDirs(root string) ([]string, error) {
+	dirs := []string{}
+	seen := map[string]bool{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != root && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			// the files of a directory may be walked before and after its subdirectories
+			if dir := filepath.Dir(path); !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// modulePath returns the path of the module that contains dir and the directory of
+// its go.mod file or empty strings if dir is not in a module
+func modulePath(dir string) (string, string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+	for {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+					return strings.Trim(fields[1], "\"`"), dir, nil
+				}
+			}
+			return "", "", fmt.Errorf("no module path in %s", filepath.Join(dir, "go.mod"))
+		}
+		if !os.IsNotExist(err) {
+			return "", "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// importPaths returns the import path of each directory and whether they are in a module.
+// Outside of a module, the directories are identified by their path relative to root (which
+// is a suffix of their import path) and the import path of root is not known.
+func importPaths(root string, dirs []string) (map[string]string, bool, error) {
+	module, moduleDir, err := modulePath(root)
+	if err != nil {
+		return nil, false, err
+	}
+	paths := map[string]string{}
+	for _, dir := range dirs {
+		if module == "" {
+			if rel, err := filepath.Rel(root, dir); err == nil && rel != "." {
+				paths[dir] = filepath.ToSlash(rel)
+			}
+			continue
+		}
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, false, err
+		}
+		rel, err := filepath.Rel(moduleDir, abs)
+		if err != nil {
+			return nil, false, err
+		}
+		if rel == "." {
+			paths[dir] = module
+		} else {
+			paths[dir] = module + "/" + filepath.ToSlash(rel)
+		}
+	}
+	return paths, module != "", nil
+}
+
+// sortByImports sorts the directories so that the packages are generated after the
+// packages of the tree they import, since the methods of the referenced structs are
+// found in the generated files of the included packages.
+func sortByImports(root string, dirs []string) ([]string, error) {
+	paths, inModule, err := importPaths(root, dirs)
+	if err != nil {
+		return nil, err
+	}
+	notTest := func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	deps := map[string][]string{}
+	for _, dir := range dirs {
+		pkgs, err := parser.ParseDir(token.NewFileSet(), dir, notTest, parser.ImportsOnly)
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				for _, i := range file.Imports {
+					imp, err := strconv.Unquote(i.Path.Value)
+					if err != nil {
+						return nil, err
+					}
+					for _, other := range dirs {
+						path, ok := paths[other]
+						if !ok || other == dir {
+							continue
+						}
+						if imp == path || (!inModule && strings.HasSuffix(imp, "/"+path)) {
+							deps[dir] = append(deps[dir], other)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	sorted := []string{}
+	visited := map[string]bool{}
+	var visit func(dir string)
+	visit = func(dir string) {
+		if visited[dir] {
+			return
+		}
+		visited[dir] = true
+		for _, dep := range deps[dir] {
+			visit(dep)
+		}
+		sorted = append(sorted, dir)
+	}
+	for _, dir := range dirs {
+		visit(dir)
+	}
+	return sorted, nil
+}
+
+// encodeRecursive generates the files of each package of the tree rooted at root. The
+// other packages of the tree are included so that the references between them are resolved.
+func encodeRecursive(root string, includePaths []string, encodeDir func(source string, includePaths []string) error) error {
+	dirs, err := packageDirs(root)
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		return fmt.Errorf("no Go packages found in %s", root)
+	}
+	if dirs, err = sortByImports(root, dirs); err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		include := append([]string{}, includePaths...)
+		for _, other := range dirs {
+			if other != dir {
+				include = append(include, other)
+			}
+		}
+		infof("generating the package in %s", dir)
+		if err := encodeDir(dir, include); err != nil {
+			return fmt.Errorf("failed to generate the package in %s: %v", dir, err)
+		}
+	}
+	return nil
+}
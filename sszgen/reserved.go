@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+)
+
+// reservedName is the name of the blank fields with reserved bytes. The field
+// cannot be accessed, the generated code only writes and checks zero bytes.
+const reservedName = "_"
+
+// reservedField returns the value of a blank field with n reserved bytes tagged
+// with ssz-reserved (i.e. _ [4]byte `ssz-reserved:"4"`). The bytes are written as
+// zeros and any other value is rejected when they are decoded.
+func reservedField(obj, tags string, expr ast.Expr) (*Value, error) {
+	size, ok := getTagsInt(tags, "ssz-reserved")
+	if !ok || size == 0 {
+		return nil, fmt.Errorf("ssz-reserved must be a positive number of bytes in %s", obj)
+	}
+	if !isByteArray(expr, size) {
+		return nil, fmt.Errorf("ssz-reserved requires a blank [%d]byte field in %s", size, obj)
+	}
+	return &Value{name: reservedName, t: TypeReserved, s: size}, nil
+}
+
+// isByteArray returns true if the expression is a [size]byte or a [size]uint8
+func isByteArray(expr ast.Expr, size uint64) bool {
+	arr, ok := expr.(*ast.ArrayType)
+	if !ok {
+		return false
+	}
+	lit, ok := arr.Len.(*ast.BasicLit)
+	if !ok {
+		return false
+	}
+	if num, err := strconv.ParseUint(lit.Value, 0, 64); err != nil || num != size {
+		return false
+	}
+	ident, ok := arr.Elt.(*ast.Ident)
+	return ok && isByteIdent(ident.Name)
+}
+
+func (v *Value) marshalReserved() string {
+	return fmt.Sprintf("dst = append(dst, make([]byte, %d)...)", v.s)
+}
+
+func (v *Value) unmarshalReserved(dst string) string {
+	return fmt.Sprintf("if err = ssz.ValidateReserved(%s); err != nil {\nreturn err\n}", dst)
+}
+
+func (v *Value) hashTreeRootReserved() string {
+	return fmt.Sprintf("hh.PutZeroBytes(%d)", v.s)
+}
+
+func (v *Value) getTreeReserved() string {
+	return fmt.Sprintf("w.AddBytes(make([]byte, %d))", v.s)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// schemaString creates the functions that return the canonical ssz type signature of the struct
+// (i.e. Container(Slot:uint64,Root:Vector[byte,32])) and the layout of its fields.
+// Nested containers are referenced by name.
+func (e *env) schemaString(name string, v *Value) string {
+	tmpl := `// SSZSchemaString returns the canonical ssz type signature of the {{.name}} object
+	func (:: *{{.name}}) SSZSchemaString() string {
+		return {{.schema}}
+	}
+
+	// SSZSchema returns the layout of the fields of the {{.name}} object
+	func (:: *{{.name}}) SSZSchema() *ssz.Schema {
+		return &ssz.Schema{
+			Name: "{{.name}}",
+			Fields: []*ssz.SchemaField{
+				{{range .fields}}{{.}},
+				{{end}}
+			},
+		}
+	}`
+
+	fields := []string{}
+	for _, i := range v.o {
+		field := fmt.Sprintf("Name: \"%s\", Type: %s", i.name, strconv.Quote(i.schema()))
+		if i.isFixed() {
+			field += fmt.Sprintf(", Size: %d, Fixed: true", i.fixedSize())
+		}
+		if i.lengthFrom != "" {
+			field += fmt.Sprintf(", LengthFrom: \"%s\"", i.lengthFrom)
+		}
+		fields = append(fields, "{"+field+"}")
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"schema": strconv.Quote(v.schemaContainer(true)),
+		"fields": fields,
+	})
+	return e.appendObjSignature(str, v)
+}
+
+func (v *Value) schema() string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.optional {
+			return "Optional[" + v.schemaContainer(false) + "]"
+		}
+		return v.schemaContainer(false)
+
+	case TypeUint:
+		return fmt.Sprintf("%s%d", v.t.String(), v.s*8)
+
+	case TypeBool:
+		return v.t.String()
+
+	case TypePackedBools:
+		return fmt.Sprintf("Bitvector[%d]", len(v.o))
+
+	case TypeBytes:
+		if v.bits != 0 {
+			return fmt.Sprintf("Bitvector[%d]", v.bits)
+		}
+		if v.isFixed() {
+			return fmt.Sprintf("Vector[byte,%d]", v.s)
+		}
+		return fmt.Sprintf("List[byte,%d]", v.s)
+
+	case TypeBitList:
+		return fmt.Sprintf("Bitlist[%d]", v.s)
+
+	case TypeVector:
+		return fmt.Sprintf("Vector[%s,%d]", v.e.schema(), v.s)
+
+	case TypeList:
+		return fmt.Sprintf("List[%s,%d]", v.e.schema(), v.s)
+
+	case TypeCustom:
+		// the type of the custom field is only known by its methods
+		if v.isFixed() {
+			return fmt.Sprintf("Custom[%d]", v.s)
+		}
+		return "Custom"
+
+	case TypeReserved:
+		// the reserved bytes are encoded as a zero byte vector
+		return fmt.Sprintf("Vector[byte,%d]", v.s)
+
+	case TypeUnion:
+		return v.schemaUnion()
+
+	default:
+		panic(fmt.Errorf("schema not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) schemaContainer(start bool) string {
+	if !start {
+		return v.obj
+	}
+	fields := []string{}
+	for _, i := range v.o {
+		fields = append(fields, i.name+":"+i.schema())
+	}
+	return "Container(" + strings.Join(fields, ",") + ")"
+}
+
+// registerSchema creates the init function that registers the struct in ssz.SchemaRegistry
+// with its name qualified by the package (i.e. types.BeaconBlock)
+func (e *env) registerSchema(name string) string {
+	tmpl := `func init() {
+		ssz.SchemaRegistry.Register("{{.package}}.{{.name}}", func() ssz.SchemaObject {
+			return new({{.name}})
+		})
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"package": e.packName,
+		"name":    name,
+	})
+}
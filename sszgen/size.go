@@ -11,6 +11,7 @@ import (
 // 2. Dynamic: Size that depends on the input (i.e. lists, dynamic containers...)
 // Note that if any of the internal fields of the struct is nil, we will not fail, only not add up
 // that field to the size. It is up to other methods like marshal to fail on that scenario.
+// Fixed size structs also get a SizeSSZ<name> function that does not require an object.
 func (e *env) size(name string, v *Value) string {
 	tmpl := `// SizeSSZ returns the ssz encoded size in bytes for the {{.name}} object
 	func (:: *{{.name}}) SizeSSZ() (size int) {
@@ -19,17 +20,68 @@ func (e *env) size(name string, v *Value) string {
 		{{.dynamic}}
 		{{end}}
 		return
-	}`
+	}{{if .isFixed}}
+
+	// SizeSSZ{{.name}} returns the ssz encoded size in bytes of any {{.name}} object
+	func SizeSSZ{{.name}}() int {
+		return {{.fixed}}
+	}{{end}}`
 
 	str := execTmpl(tmpl, map[string]interface{}{
 		"name":    name,
 		"fixed":   v.fixedSize(),
 		"dynamic": v.sizeContainer("size", true),
+		"isFixed": v.isFixed(),
+	})
+	return e.appendObjSignature(str, v)
+}
+
+// offsetsMethod creates a function that returns the offsets of the dynamic fields of the struct
+// written by MarshalSSZTo. The offsets are computed with the size of the dynamic fields.
+func (e *env) offsetsMethod(name string, v *Value) string {
+	dynamic := []int{}
+	for indx, i := range v.o {
+		if i.hasOffset() {
+			dynamic = append(dynamic, indx)
+		}
+	}
+	if len(dynamic) == 0 {
+		return ""
+	}
+
+	tmpl := `// OffsetsSSZ returns the offsets of the dynamic fields of the {{.name}} object
+	// written by MarshalSSZTo
+	func (:: *{{.name}}) OffsetsSSZ() []uint32 {
+		offsets := make([]uint32, 0, {{.num}})
+		offset := {{.fixed}}
+		{{.offsets}}
+		return offsets
+	}`
+
+	out := []string{}
+	for j, indx := range dynamic {
+		i := v.o[indx]
+		str := fmt.Sprintf("// Offset (%d) '%s'\noffsets = append(offsets, uint32(offset))", indx, i.name)
+		if j != len(dynamic)-1 {
+			// the size of the last field is not required
+			str += "\n" + i.size("offset")
+		}
+		out = append(out, str)
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"num":     len(dynamic),
+		"fixed":   v.fixedSize(),
+		"offsets": strings.Join(out, "\n\n"),
 	})
-	return appendObjSignature(str, v)
+	return e.appendObjSignature(str, v)
 }
 
 func (v *Value) fixedSize() uint64 {
+	if v.optional {
+		return bytesPerLengthOffset
+	}
 	switch v.t {
 	case TypeVector:
 		if v.e == nil {
@@ -45,7 +97,7 @@ func (v *Value) fixedSize() uint64 {
 		for _, f := range v.o {
 			if f.isFixed() {
 				fixed += f.fixedSize()
-			} else {
+			} else if f.hasOffset() {
 				// we don't want variable size objects to recursively calculate their inner sizes
 				fixed += bytesPerLengthOffset
 			}
@@ -60,6 +112,26 @@ func (v *Value) fixedSize() uint64 {
 }
 
 func (v *Value) sizeContainer(name string, start bool) string {
+	if !start && v.iface {
+		tmpl := `if obj, ok := ::.{{.name}}.(*{{.obj}}); ok {
+			{{ .dst }} += obj.SizeSSZ()
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"dst":  name,
+			"obj":  v.objRef(),
+		})
+	}
+	if !start && v.optional {
+		// the present value is prefixed with the presence byte
+		tmpl := `if ::.{{.name}} != nil {
+			{{ .dst }} += 1 + ::.{{.name}}.SizeSSZ()
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"dst":  name,
+		})
+	}
 	if !start {
 		tmpl := `{{if .check}} if ::.{{.name}} == nil {
 			::.{{.name}} = new({{.obj}})
@@ -86,6 +158,9 @@ func (v *Value) sizeContainer(name string, start bool) string {
 			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, v.name, v.size(name)))
 		}
 	}
+	if v.ext != "" {
+		out = append(out, fmt.Sprintf("// Extension '%s'\n%s += len(::.%s)", v.ext, name, v.ext))
+	}
 	return strings.Join(out, "\n\n")
 }
 
@@ -112,6 +187,12 @@ func (v *Value) size(name string) string {
 	case TypeBytes:
 		return fmt.Sprintf(name+" += len(::.%s)", v.name)
 
+	case TypeCustom:
+		return fmt.Sprintf("%s += ::.sizeField%s()", name, v.name)
+
+	case TypeUnion:
+		return fmt.Sprintf("%s += ::.sizeUnion%s()", name, v.name)
+
 	case TypeList:
 		fallthrough
 
@@ -75,9 +75,9 @@ func GetSSZTags(tag string) (map[string]string, error) {
 }
 
 // cannot compare untyped nil to typed nil
-// this value gives us a nil with type of *int
+// this value gives us a nil with type of *uint64
 // to compare to ssz-size = '?' values
-var nilInt *int
+var nilInt *uint64
 
 // handle tag structured like 'ssz:"bitlist"'
 // this is not used in prysm but needs to be supported for fastssz tests
@@ -95,7 +95,10 @@ func isBitList(tags map[string]string) bool {
 	return false
 }
 
-func extractSSZDimensions(tag string) ([]*SSZDimension, error) {
+// extractSSZDimensions parses the dimensions of the ssz-size and ssz-max tags. The depth is
+// the number of array dimensions of the Go type of the field (0 if unknown), the named
+// dimensions are resolved against it so that 'inner' is always the inner-most dimension.
+func extractSSZDimensions(tag string, depth int) ([]*SSZDimension, error) {
 	// parse the ssz-max and ssz-size key/value pairs out of the tag
 	tags, err := GetSSZTags(tag)
 	if err != nil {
@@ -107,14 +110,43 @@ func extractSSZDimensions(tag string) ([]*SSZDimension, error) {
 		return nil, fmt.Errorf("No ssz-size or ssz-max tags found for element. tag=%s", tag)
 	}
 
-	// split each tag by ",". each position in the csv represents a dimension of an n-dimensional array
-	sizeSplit := strings.Split(sszSizes, ",")
-	maxSplit := strings.Split(sszMax, ",")
+	// split each tag by ",". each position in the csv represents a dimension of an n-dimensional array,
+	// unless the dimensions are named (i.e. 'ssz-max:"outer=1024,inner=64"') in which case the
+	// position is resolved from the name once we know the total number of dimensions.
+	var sizeSplit, maxSplit []string
+	var sizeNamed, maxNamed map[string]string
+	if sizeDefined {
+		if sizeSplit, sizeNamed, err = splitDimensions(sszSizes); err != nil {
+			return nil, fmt.Errorf("failed to parse ssz-size, tag=%s. err=%s", tag, err)
+		}
+	}
+	if maxDefined {
+		if maxSplit, maxNamed, err = splitDimensions(sszMax); err != nil {
+			return nil, fmt.Errorf("failed to parse ssz-max, tag=%s. err=%s", tag, err)
+		}
+	}
 	// find the largest of the two dimensions. for backward compat we'll be permissive and let them be uneven
 	ndims := len(sizeSplit)
 	if len(maxSplit) > len(sizeSplit) {
 		ndims = len(maxSplit)
 	}
+	if n := namedDimensionCount(sizeNamed, maxNamed); n > ndims {
+		ndims = n
+	}
+	if (sizeNamed != nil || maxNamed != nil) && depth > ndims {
+		// the dimensions written in the tags do not include the inner-most one
+		ndims = depth
+	}
+	if sizeNamed != nil {
+		if sizeSplit, err = resolveNamedDimensions(sizeNamed, ndims); err != nil {
+			return nil, fmt.Errorf("failed to resolve ssz-size dimensions, tag=%s. err=%s", tag, err)
+		}
+	}
+	if maxNamed != nil {
+		if maxSplit, err = resolveNamedDimensions(maxNamed, ndims); err != nil {
+			return nil, fmt.Errorf("failed to resolve ssz-max dimensions, tag=%s. err=%s", tag, err)
+		}
+	}
 	dims := make([]*SSZDimension, ndims)
 	for i := 0; i < ndims; i++ {
 		isbl := false
@@ -131,26 +163,32 @@ func extractSSZDimensions(tag string) ([]*SSZDimension, error) {
 		if len(maxSplit) > i {
 			mxi = maxSplit[i]
 		}
+		if szi == dimList && (mxi == "" || mxi == "?" || mxi == dimList) {
+			return nil, fmt.Errorf("ssz-size '*' at dimension %d is a list that requires a numeric ssz-max, tag=%s", i, tag)
+		}
+		if mxi == dimList {
+			return nil, fmt.Errorf("'*' at dimension %d is only supported in ssz-size, tag=%s", i, tag)
+		}
 		if szi == "?" && mxi == "?" {
 			return nil, fmt.Errorf("At dimension %d both ssz-size and ssz-max had a '?' value. For each dimension, either ssz-size or ssz-max must have a value. Ex: 'ssz-size:\"?,32\" ssz-max:\"100\" defines a List with 100 element limit, containing 32 byte fixed-sized vectors. tag=%s", i, tag)
 		}
 		switch szi {
-		case "?", "":
+		case "?", "", dimList:
 			if mxi == "?" || mxi == "" {
 				return nil, fmt.Errorf("no numeric ssz-size or ssz-max tag for value at dimesion %d, tag=%s", i, tag)
 			}
-			m, err := strconv.Atoi(mxi)
+			m, err := strconv.ParseUint(mxi, 10, 64)
 			if err != nil {
-				return nil, fmt.Errorf("atoi failed on value %s for ssz-max at dimension %d, tag=%s. err=%s", mxi, i, tag, err)
+				return nil, fmt.Errorf("failed to parse value %s for ssz-max at dimension %d, tag=%s. err=%s", mxi, i, tag, err)
 			}
 			dims[i] = &SSZDimension{
 				isBitlist:  isbl,
 				ListLength: &m,
 			}
 		default: // szi is not empty or "?"
-			s, err := strconv.Atoi(szi)
+			s, err := strconv.ParseUint(szi, 10, 64)
 			if err != nil {
-				return nil, fmt.Errorf("atoi failed on value %s for ssz-size at dimension %d, tag=%s. err=%s", szi, i, tag, err)
+				return nil, fmt.Errorf("failed to parse value %s for ssz-size at dimension %d, tag=%s. err=%s", szi, i, tag, err)
 			}
 			dims[i] = &SSZDimension{
 				isBitlist:    isbl,
@@ -162,9 +200,112 @@ func extractSSZDimensions(tag string) ([]*SSZDimension, error) {
 	return dims, nil
 }
 
+// dimList is the ssz-size of a dimension that is a list bounded by the ssz-max
+// of the same dimension (i.e. 'ssz-size:"*" ssz-max:"64"'). Unlike '?', which only
+// leaves the dimension to the other tag, it states that the dimension is a list.
+const dimList = "*"
+
+const (
+	// dimOuter is the name of the outer-most dimension in a named dimension tag
+	dimOuter = "outer"
+	// dimInner is the name of the inner-most dimension in a named dimension tag
+	dimInner = "inner"
+)
+
+// splitDimensions splits the value of a ssz-size or ssz-max tag into its dimensions.
+// Dimensions are either positional (i.e. '1024,64') or named (i.e. 'outer=1024,inner=64').
+// Named dimensions are returned as a map since their position depends on the total number
+// of dimensions of the field, which is only known after both tags have been parsed.
+// Mixing both forms in the same tag is not allowed. The whitespace around the dimensions
+// is ignored but empty dimensions (i.e. a trailing comma) are an error.
+func splitDimensions(val string) ([]string, map[string]string, error) {
+	parts := strings.Split(val, ",")
+
+	named := 0
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			return nil, nil, fmt.Errorf("empty dimension %d in '%s'", i, val)
+		}
+		parts[i] = p
+		if strings.Contains(p, "=") {
+			named++
+		}
+	}
+	if named == 0 {
+		return parts, nil, nil
+	}
+	if named != len(parts) {
+		return nil, nil, fmt.Errorf("cannot mix named and positional dimensions in '%s'", val)
+	}
+
+	res := map[string]string{}
+	for _, p := range parts {
+		spl := strings.SplitN(p, "=", 2)
+		name, num := strings.TrimSpace(spl[0]), strings.TrimSpace(spl[1])
+		if name != dimOuter && name != dimInner {
+			if _, err := strconv.Atoi(name); err != nil {
+				return nil, nil, fmt.Errorf("unknown dimension name '%s', expected '%s', '%s' or a dimension index", name, dimOuter, dimInner)
+			}
+		}
+		if _, ok := res[name]; ok {
+			return nil, nil, fmt.Errorf("dimension '%s' is defined twice", name)
+		}
+		res[name] = num
+	}
+	return nil, res, nil
+}
+
+// namedDimensionCount returns the minimum number of dimensions required by the named dimensions
+// of both the ssz-size and ssz-max tags (i.e. 'outer' and 'inner' refer to two different dimensions).
+func namedDimensionCount(namedList ...map[string]string) int {
+	names := map[string]bool{}
+	count := 0
+	for _, named := range namedList {
+		for name := range named {
+			names[name] = true
+			if indx, err := strconv.Atoi(name); err == nil && indx+1 > count {
+				count = indx + 1
+			}
+		}
+	}
+	if len(names) > count {
+		count = len(names)
+	}
+	return count
+}
+
+// resolveNamedDimensions maps a set of named dimensions onto their positions given the total
+// number of dimensions. 'outer' is the first dimension and 'inner' the last one.
+func resolveNamedDimensions(named map[string]string, ndims int) ([]string, error) {
+	res := make([]string, ndims)
+	set := make([]bool, ndims)
+
+	for name, num := range named {
+		var indx int
+		switch name {
+		case dimOuter:
+			indx = 0
+		case dimInner:
+			indx = ndims - 1
+		default:
+			indx, _ = strconv.Atoi(name)
+		}
+		if indx < 0 || indx >= ndims {
+			return nil, fmt.Errorf("dimension '%s' out of range for %d dimensions", name, ndims)
+		}
+		if set[indx] {
+			return nil, fmt.Errorf("dimension '%s' overlaps with another named dimension", name)
+		}
+		res[indx] = num
+		set[indx] = true
+	}
+	return res, nil
+}
+
 type SSZDimension struct {
-	VectorLength *int
-	ListLength   *int
+	VectorLength *uint64
+	ListLength   *uint64
 	isBitlist    bool
 }
 
@@ -180,11 +321,11 @@ func (dim *SSZDimension) IsBitlist() bool {
 	return dim.isBitlist
 }
 
-func (dim *SSZDimension) ListLen() int {
+func (dim *SSZDimension) ListLen() uint64 {
 	return *dim.ListLength
 }
 
-func (dim *SSZDimension) VectorLen() int {
+func (dim *SSZDimension) VectorLen() uint64 {
 	return *dim.VectorLength
 }
 
@@ -202,10 +343,10 @@ func (dim *SSZDimension) ValueType() Type {
 // ValueType returns ssz-max or ssz-size, to be used in the construction of a fastssz Value type
 func (dim *SSZDimension) ValueLen() uint64 {
 	if dim.IsList() {
-		return uint64(dim.ListLen())
+		return dim.ListLen()
 	}
 	if dim.IsVector() {
-		return uint64(dim.VectorLen())
+		return dim.VectorLen()
 	}
 	return 0
 }
@@ -1,8 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
-
 )
 
 func TestTokens(t *testing.T) {
@@ -31,7 +32,7 @@ func TestTokens(t *testing.T) {
 
 func TestFullTag(t *testing.T) {
 	tag := "`protobuf:\"bytes,1002,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3\" json:\"genesis_validators_root,omitempty\" ssz-size:\"32\"`"
-	_, err := extractSSZDimensions(tag)
+	_, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -39,7 +40,7 @@ func TestFullTag(t *testing.T) {
 
 func TestListOfVector(t *testing.T) {
 	tag := "`protobuf:\"bytes,2004,rep,name=historical_roots,json=historicalRoots,proto3\" json:\"historical_roots,omitempty\" ssz-max:\"16777216\" ssz-size:\"?,32\"`"
-	_, err := extractSSZDimensions(tag)
+	_, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -47,7 +48,7 @@ func TestListOfVector(t *testing.T) {
 
 func TestWildcardSSZSize(t *testing.T)  {
 	tag := "`ssz-max:\"16777216\" ssz-size:\"?,32\"`"
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -77,7 +78,7 @@ func TestWildcardSSZSize(t *testing.T)  {
 
 func TestListOfList(t *testing.T) {
 	tag := "`protobuf:\"bytes,14,rep,name=transactions,proto3\" json:\"transactions,omitempty\" ssz-max:\"1048576,1073741824\" ssz-size:\"?,?\"`"
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -107,7 +108,7 @@ func TestListOfList(t *testing.T) {
 
 func TestOneDVector(t *testing.T) {
 	tag := "`protobuf:\"bytes,1,opt,name=randao_reveal,json=randaoReveal,proto3\" json:\"randao_reveal,omitempty\" ssz-size:\"96\""
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -119,7 +120,7 @@ func TestOneDVector(t *testing.T) {
 
 func TestOneDList(t *testing.T) {
 	tag := "`protobuf:\"bytes,4,rep,name=proposer_slashings,json=proposerSlashings,proto3\" json:\"proposer_slashings,omitempty\" ssz-max:\"16\"`"
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -131,7 +132,7 @@ func TestOneDList(t *testing.T) {
 
 func TestNoDims(t *testing.T) {
 	tag := "`protobuf:\"bytes,2,opt,name=eth1_data,json=eth1Data,proto3\" json:\"eth1_data,omitempty\"`"
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err == nil {
 		t.Errorf("expected error when calling extractSSZDimensions without ssz-size or ssz-max: %v", err)
 	}
@@ -143,7 +144,7 @@ func TestNoDims(t *testing.T) {
 
 func TestBitlist(t *testing.T) {
 	tag := "`json:\"aggregation_bits\" ssz:\"bitlist\" ssz-max:\"2048\"`"
-	dims, err := extractSSZDimensions(tag)
+	dims, err := extractSSZDimensions(tag, 0)
 	if err != nil {
 		t.Errorf("Unexpected error calling extractSSZDimensions: %v", err)
 	}
@@ -154,4 +155,112 @@ func TestBitlist(t *testing.T) {
 	if !dims[0].IsBitlist() {
 		t.Error("Expected tag 'ssz:\"bitlist\" to mark field as a bitlist")
 	}
-}
\ No newline at end of file
+}
+
+// dimensionsCase is a tag with the dimensions it resolves to, formatted as
+// 'type:length', or an invalid tag if valid is false
+type dimensionsCase struct {
+	tag   string
+	dims  []string
+	valid bool
+}
+
+// testDimensions checks the dimensions extracted from the tags of the cases
+func testDimensions(t *testing.T, cases []dimensionsCase) {
+	t.Helper()
+
+	for _, c := range cases {
+		dims, err := extractSSZDimensions(c.tag, 0)
+		if err != nil {
+			if c.valid {
+				t.Errorf("Unexpected error calling extractSSZDimensions for %s: %v", c.tag, err)
+			}
+			continue
+		}
+		if !c.valid {
+			t.Errorf("expected error calling extractSSZDimensions for %s", c.tag)
+			continue
+		}
+		found := []string{}
+		for _, dim := range dims {
+			found = append(found, fmt.Sprintf("%s:%d", dim.ValueType(), dim.ValueLen()))
+		}
+		if !reflect.DeepEqual(found, c.dims) {
+			t.Errorf("expected dimensions %v for %s, got %v", c.dims, c.tag, found)
+		}
+	}
+}
+
+func TestNamedDimensions(t *testing.T) {
+	cases := []dimensionsCase{
+		{"`ssz-max:\"outer=1048576,inner=1073741824\"`", []string{"list:1048576", "list:1073741824"}, true},
+		{"`ssz-max:\"inner=1073741824,outer=1048576\"`", []string{"list:1048576", "list:1073741824"}, true},
+		{"`ssz-size:\"inner=32\" ssz-max:\"outer=16\"`", []string{"list:16", "vector:32"}, true},
+		{"`ssz-size:\"?,?,32\" ssz-max:\"outer=16,1=8\"`", []string{"list:16", "list:8", "vector:32"}, true},
+		{"`ssz-size:\"0=4,2=32\" ssz-max:\"1=8\"`", []string{"vector:4", "list:8", "vector:32"}, true},
+		{"`ssz-max:\"outer=16,8\"`", nil, false},
+		{"`ssz-max:\"middle=16\"`", nil, false},
+		{"`ssz-max:\"outer=16,0=8\"`", nil, false},
+		{"`ssz-max:\"outer=16,outer=8\"`", nil, false},
+	}
+	testDimensions(t, cases)
+}
+
+func TestLargeTagValues(t *testing.T) {
+	tag := "`ssz-max:\"1099511627776\"`"
+	num, ok := getTagsInt(tag, "ssz-max")
+	if !ok {
+		t.Fatal("failed to parse ssz-max")
+	}
+	if num != 1099511627776 {
+		t.Fatalf("Expected ssz-max to be %d, got %d", uint64(1099511627776), num)
+	}
+
+	dims, err := extractSSZDimensions("`ssz-max:\"1099511627776,1073741824\"`", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dims[0].ListLen() != 1099511627776 {
+		t.Fatalf("Expected ssz-max of first dimension to be %d, got %d", uint64(1099511627776), dims[0].ListLen())
+	}
+}
+
+func TestDimensionsWhitespace(t *testing.T) {
+	cases := []dimensionsCase{
+		{"`ssz-size:\"32, 48\"`", []string{"vector:32", "vector:48"}, true},
+		{"`ssz-size:\" ?,32 \" ssz-max:\"16 \"`", []string{"list:16", "vector:32"}, true},
+		{"`ssz-max:\"outer = 16, inner = 8\"`", []string{"list:16", "list:8"}, true},
+		{"`ssz-size:\"32,\"`", nil, false},
+		{"`ssz-size:\"32,,48\"`", nil, false},
+		{"`ssz-size:\",32\"`", nil, false},
+		{"`ssz-max:\"16, \"`", nil, false},
+		{"`ssz-max:\"\"`", nil, false},
+	}
+	testDimensions(t, cases)
+}
+
+func TestGetTagsWhitespace(t *testing.T) {
+	tag := "`json:\"a\" ssz-size:\"32, 48\" ssz-max:\" 16\"`"
+	if val, ok := getTags(tag, "ssz-size"); !ok || val != "32, 48" {
+		t.Fatalf("bad ssz-size %s", val)
+	}
+	if num, ok := getTagsInt(tag, "ssz-max"); !ok || num != 16 {
+		t.Fatalf("bad ssz-max %d", num)
+	}
+}
+
+func TestListSizeDimension(t *testing.T) {
+	cases := []dimensionsCase{
+		{"`ssz-size:\"*\" ssz-max:\"64\"`", []string{"list:64"}, true},
+		{"`ssz-size:\"*,32\" ssz-max:\"16\"`", []string{"list:16", "vector:32"}, true},
+		{"`ssz-size:\"4,*\" ssz-max:\"?,64\"`", []string{"vector:4", "list:64"}, true},
+		{"`ssz-size:\"4,*\" ssz-max:\"inner=64\"`", []string{"vector:4", "list:64"}, true},
+		{"`ssz-size:\"*,?\" ssz-max:\"16,8\"`", []string{"list:16", "list:8"}, true},
+		{"`ssz-size:\"*\"`", nil, false},
+		{"`ssz-size:\"*\" ssz-max:\"?\"`", nil, false},
+		{"`ssz-size:\"*\" ssz-max:\"*\"`", nil, false},
+		{"`ssz-size:\"4,*\" ssz-max:\"64\"`", nil, false},
+		{"`ssz-max:\"*\"`", nil, false},
+	}
+	testDimensions(t, cases)
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"strings"
+
+	ssz "github.com/photon-storage/fastssz"
 )
 
 // getTree creates a function that SSZ hashes the structs,
@@ -18,15 +20,70 @@ func (e *env) getTree(name string, v *Value) string {
 		if err := ::.GetTreeWithWrapper(w); err != nil {
 			return nil, err
 		}
-		return w.Node(), nil
+		{{if .treeCache}}::.{{.treeCache}} = w.Node()
+		{{end}}return w.Node(), nil
 	}`
 
 	data := map[string]interface{}{
-		"name":    name,
-		"getTree": v.getTreeContainer(true),
+		"name":      name,
+		"getTree":   v.getTreeContainer(true),
+		"treeCache": v.treeCache,
 	}
 	str := execTmpl(tmpl, data)
-	return appendObjSignature(str, v)
+	if v.treeCache != "" {
+		str += "\n\n" + v.updateTree(name)
+	}
+	return e.appendObjSignature(str, v)
+}
+
+// updateTree creates a function that recomputes the subtrees of the changed fields
+// in the cached tree (ssz-tree-cache), the root is then hashed again only along
+// the paths of those fields.
+func (v *Value) updateTree(name string) string {
+	tmpl := `// UpdateTree recomputes the subtrees of the changed fields in the cached tree of the {{.name}} object
+	func (:: *{{.name}}) UpdateTree(fields ...string) (err error) {
+		if ::.{{.treeCache}} == nil {
+			_, err = ::.GetTree()
+			return
+		}
+		for _, field := range fields {
+			w := &ssz.Wrapper{}
+			{{if not .single}}var indx int
+			{{end}}switch field {
+			{{range .fields}}case "{{.name}}":
+				{{if not $.single}}indx = {{.indx}}
+				{{end}}{{.getTree}}
+			{{end}}default:
+				return ssz.ErrUnknownField
+			}
+			{{if .single}}// the tree of a single field is the tree of the container
+			::.{{.treeCache}} = w.Node(){{else}}if err = ::.{{.treeCache}}.Replace(indx, w.Node()); err != nil {
+				return
+			}{{end}}
+		}
+		return
+	}`
+
+	// the generalized index of the field in the tree of the container
+	numLeaves := int(ssz.NextPowerOfTwo(uint64(len(v.o))))
+	fields := []map[string]interface{}{}
+	for indx, i := range v.o {
+		if i.t == TypeReserved {
+			// the reserved bytes never change
+			continue
+		}
+		fields = append(fields, map[string]interface{}{
+			"name":    i.name,
+			"indx":    numLeaves + indx,
+			"getTree": i.getTree(),
+		})
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"treeCache": v.treeCache,
+		"fields":    fields,
+		"single":    len(v.o) == 1,
+	})
 }
 
 func (v *Value) getTrees(isList bool, elem Type) string {
@@ -72,7 +129,9 @@ func (v *Value) getTree() string {
 		return v.getTreeContainer(false)
 
 	case TypeBytes:
-		// There are only fixed []byte
+		if !v.isFixed() {
+			return v.getTreeByteList("::." + v.name)
+		}
 		name := v.name
 		if v.c {
 			name += "[:]"
@@ -103,6 +162,9 @@ func (v *Value) getTree() string {
 	case TypeBool:
 		return fmt.Sprintf("tmp = ssz.LeafFromBool(::.%s)", v.name)
 
+	case TypePackedBools:
+		return v.getTreePackedBools()
+
 	case TypeVector:
 		return v.getTrees(false, v.e.t)
 
@@ -112,6 +174,28 @@ func (v *Value) getTree() string {
 				return v.getTrees(true, v.e.t)
 			}
 		}
+		if v.e.t == TypeBytes {
+			// the root of each byte list has its own length mixed in
+			// before the length of the outer list is mixed in
+			tmpl := `{
+				subIdx := w.Indx()
+				num := len(::.{{.name}})
+				if num > {{.num}} {
+					err = ssz.ErrIncorrectListSize
+					return err
+				}
+				for _, elem := range ::.{{.name}} {
+					{{.elem}}
+				}
+				w.CommitWithMixin(subIdx, num, {{.limit}})
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name":  v.name,
+				"num":   v.m,
+				"limit": ssz.NextPowerOfTwo(v.m),
+				"elem":  v.e.getTreeByteList("elem"),
+			})
+		}
 		tmpl := `{
 			subIdx := w.Indx()
 			num := len(::.{{.name}})
@@ -133,17 +217,117 @@ func (v *Value) getTree() string {
 			"num":  v.m,
 		})
 
+	case TypeCustom:
+		// the root of the custom field is a leaf of the tree
+		tmpl := `{
+			hh := ssz.DefaultHasherPool.Get()
+			if err := ::.hashTreeRootField{{.name}}(hh); err != nil {
+				ssz.DefaultHasherPool.Put(hh)
+				return err
+			}
+			root, err := hh.HashRoot()
+			ssz.DefaultHasherPool.Put(hh)
+			if err != nil {
+				return err
+			}
+			w.AddNode(ssz.LeafFromBytes(root[:]))
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+
+	case TypeReserved:
+		return v.getTreeReserved()
+
+	case TypeUnion:
+		// the root of the union is a leaf of the tree
+		tmpl := `{
+			hh := ssz.DefaultHasherPool.Get()
+			if err := ::.hashTreeRootUnion{{.name}}(hh); err != nil {
+				ssz.DefaultHasherPool.Put(hh)
+				return err
+			}
+			root, err := hh.HashRoot()
+			ssz.DefaultHasherPool.Put(hh)
+			if err != nil {
+				return err
+			}
+			w.AddNode(ssz.LeafFromBytes(root[:]))
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+
 	default:
 		panic(fmt.Errorf("hash not implemented for type %s", v.t.String()))
 	}
 }
 
+// getTreeByteList returns the code to add the tree of a byte list, its bytes are packed
+// in chunks up to the limit of the list and its length is mixed in.
+func (v *Value) getTreeByteList(name string) string {
+	tmpl := `{
+		elemIdx := w.Indx()
+		byteLen := len({{.name}})
+		if byteLen > {{.max}} {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for jj := 0; jj < byteLen; jj += 32 {
+			end := jj + 32
+			if end > byteLen {
+				end = byteLen
+			}
+			// the capacity of the chunk is limited since the last one is padded with zeros
+			w.AddBytes({{.name}}[jj:end:end])
+		}
+		w.CommitWithMixin(elemIdx, byteLen, {{.limit}})
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"max":   v.m,
+		"limit": ssz.NextPowerOfTwo(ssz.MerkleizeLimit(v.m, 1)),
+	})
+}
+
 func (v *Value) getTreeContainer(start bool) string {
+	if !start && v.iface {
+		tmpl := `{
+			obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				return ssz.ErrConcreteType
+			}
+			if err := obj.GetTreeWithWrapper(w); err != nil {
+				return err
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"obj":  v.objRef(),
+		})
+	}
+	if !start && v.optional {
+		// the optional is a list with at most one element
+		tmpl := `{
+			subIndx := w.Indx()
+			num := 0
+			if ::.{{.name}} != nil {
+				if err := ::.{{.name}}.GetTreeWithWrapper(w); err != nil {
+					return err
+				}
+				num = 1
+			}
+			w.CommitWithMixin(subIndx, num, 1)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+		})
+	}
 	if !start {
 		return fmt.Sprintf("if err := ::.%s.GetTreeWithWrapper(w); err != nil {\n return err\n}", v.name)
 	}
 
-	numLeaves := nextPowerOfTwo(uint64(len(v.o)))
+	numLeaves := ssz.NextPowerOfTwo(uint64(len(v.o)))
 	out := []string{}
 	for indx, i := range v.o {
 		str := fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.getTree())
@@ -152,8 +336,8 @@ func (v *Value) getTreeContainer(start bool) string {
 
 	// Empty leaves
 	emptyLeaves := ""
-	if numLeaves-uint(len(v.o)) > 0 {
-		emptyLeaves = fmt.Sprintf("for i := 0; i < %d; i++ {\nw.AddEmpty()\n}", numLeaves-uint(len(v.o)))
+	if numLeaves-uint64(len(v.o)) > 0 {
+		emptyLeaves = fmt.Sprintf("for i := 0; i < %d; i++ {\nw.AddEmpty()\n}", numLeaves-uint64(len(v.o)))
 	}
 
 	tmpl := `indx := w.Indx()
@@ -169,14 +353,3 @@ func (v *Value) getTreeContainer(start bool) string {
 		"emptyLeaves": emptyLeaves,
 	})
 }
-
-func nextPowerOfTwo(v uint64) uint {
-	v--
-	v |= v >> 1
-	v |= v >> 2
-	v |= v >> 4
-	v |= v >> 8
-	v |= v >> 16
-	v++
-	return uint(v)
-}
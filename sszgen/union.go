@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// maxUnionOptions is the number of options of a union, the selectors 1 to 127 are
+// assigned to the options and 0 is the nil value (None)
+const maxUnionOptions = 127
+
+// decodeOneofs returns the wrapper structs of the protobuf oneof interfaces of the file in
+// the order in which they are declared. A oneof is an interface with a single marker method
+// of the same name (i.e. isMsg_Body() for the interface isMsg_Body) that is implemented by
+// the wrapper struct of each of its options.
+func decodeOneofs(file *ast.File, res *astResult) map[string][]string {
+	oneofs := map[string][]string{}
+	for _, dec := range file.Decls {
+		genDecl, ok := dec.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			iface, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || iface.Methods == nil || len(iface.Methods.List) != 1 {
+				continue
+			}
+			method := iface.Methods.List[0]
+			fn, ok := method.Type.(*ast.FuncType)
+			if !ok || len(method.Names) != 1 || method.Names[0].Name != typeSpec.Name.Name {
+				continue
+			}
+			if fn.Params.NumFields() != 0 || fn.Results.NumFields() != 0 {
+				continue
+			}
+			wrappers := []string{}
+			for _, obj := range res.objs {
+				if obj.obj != nil && contains(typeSpec.Name.Name, res.hooks[obj.name]) {
+					wrappers = append(wrappers, obj.name)
+				}
+			}
+			oneofs[typeSpec.Name.Name] = wrappers
+		}
+	}
+	return oneofs
+}
+
+// isOneofWrapper returns true if the struct is the wrapper of an option of a oneof, which
+// is encoded by the container of the oneof field and does not have its own methods
+func (e *env) isOneofWrapper(name string) bool {
+	for _, wrappers := range e.oneofs {
+		if contains(name, wrappers) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseUnion parses a protobuf oneof field as a union whose options are the single
+// fields of its wrapper structs. The selectors are assigned in the order in which the
+// wrappers are declared starting at 1, the nil field is the selector 0.
+func (e *env) parseUnion(name, iface string) (*Value, error) {
+	wrappers := e.oneofs[iface]
+	if len(wrappers) == 0 {
+		return nil, fmt.Errorf("the oneof %s of field %s does not have any wrapper struct", iface, name)
+	}
+	if len(wrappers) > maxUnionOptions {
+		return nil, fmt.Errorf("the oneof %s of field %s has %d options but a union has at most %d", iface, name, len(wrappers), maxUnionOptions)
+	}
+	v := &Value{name: name, t: TypeUnion, o: []*Value{}}
+	for _, wrapper := range wrappers {
+		raw, ok := e.getRawItemByName(wrapper)
+		if !ok {
+			return nil, fmt.Errorf("could not find the wrapper %s of field %s", wrapper, name)
+		}
+		fields := raw.obj.Fields.List
+		if len(fields) != 1 || len(fields[0].Names) != 1 || !isExportedField(fields[0].Names[0].Name) {
+			return nil, fmt.Errorf("the wrapper %s of the oneof field %s must have a single exported field", wrapper, name)
+		}
+		field := fields[0]
+		tags := ""
+		if field.Tag != nil {
+			tags = field.Tag.Value
+			if err := e.checkTags(wrapper, field.Names[0].Name, tags); err != nil {
+				return nil, err
+			}
+		}
+		option, err := e.parseASTFieldType(field.Names[0].Name, tags, field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the option %s of the oneof field %s: %v", wrapper, name, err)
+		}
+		if option == nil {
+			return nil, fmt.Errorf("the option %s of the oneof field %s cannot be skipped", wrapper, name)
+		}
+		if option.t == TypeUnion || option.optional {
+			return nil, fmt.Errorf("the option %s of the oneof field %s cannot be a union or an optional value", wrapper, name)
+		}
+		option.name = field.Names[0].Name
+		option.wrapper = wrapper
+		v.o = append(v.o, option)
+	}
+	return v, nil
+}
+
+// unionOption returns a copy of the option whose name is the field of the wrapper held
+// by the union (i.e. Body.(*Msg_A).A), which can be read and assigned by the templates
+func (v *Value) unionOption(option *Value) *Value {
+	o := option.copy()
+	o.name = fmt.Sprintf("%s.(*%s).%s", v.name, option.wrapper, option.name)
+	return o
+}
+
+// unionMethods creates the methods that encode the union fields of the container, which
+// are called by the generated methods as the ones of the custom fields.
+func (e *env) unionMethods(name string, v *Value) string {
+	out := []string{}
+	for _, f := range v.o {
+		if f.t == TypeUnion {
+			out = append(out, f.unionMethods(name))
+		}
+	}
+	if len(out) == 0 {
+		return ""
+	}
+	return e.appendObjSignature(strings.Join(out, "\n\n"), v)
+}
+
+func (v *Value) unionMethods(obj string) string {
+	tmpl := `// marshalUnion{{.name}} ssz marshals the selector and the option of the {{.name}} union
+	func (:: *{{.obj}}) marshalUnion{{.name}}(buf []byte) (dst []byte, err error) {
+		dst = buf
+		switch obj := ::.{{.name}}.(type) {
+		case nil:
+			dst = append(dst, 0)
+		{{range .options}}case *{{.wrapper}}:
+			if obj == nil {
+				err = ssz.ErrUnionNil
+				return
+			}
+			dst = append(dst, {{.selector}})
+			{{.marshal}}
+		{{end}}}
+		return
+	}
+
+	// unmarshalUnion{{.name}} ssz unmarshals the selector and the option of the {{.name}} union
+	func (:: *{{.obj}}) unmarshalUnion{{.name}}(buf []byte, alloc ssz.Allocator) (err error) {
+		if len(buf) == 0 {
+			return ssz.ErrSize
+		}
+		selector := buf[0]
+		buf = buf[1:]
+		switch selector {
+		case 0:
+			if len(buf) != 0 {
+				return ssz.ErrSize
+			}
+			::.{{.name}} = nil
+		{{range .options}}case {{.selector}}:
+			if obj, ok := ::.{{$.name}}.(*{{.wrapper}}); !ok || obj == nil {
+				::.{{$.name}} = ssz.AllocNew[{{.wrapper}}](alloc)
+			}
+			{{if .fixed}}if len(buf) != {{.length}} {
+				return ssz.ErrSize
+			}
+			{{end}}{{.unmarshal}}
+		{{end}}default:
+			return ssz.ErrUnionSelector
+		}
+		return nil
+	}
+
+	// sizeUnion{{.name}} returns the size of the selector and the option of the {{.name}} union
+	func (:: *{{.obj}}) sizeUnion{{.name}}() (size int) {
+		size = 1
+		switch obj := ::.{{.name}}.(type) {
+		{{range .options}}case *{{.wrapper}}:
+			if obj != nil {
+				{{.size}}
+			}
+		{{end}}}
+		return
+	}
+
+	// hashTreeRootUnion{{.name}} appends the root of the {{.name}} union, which is the root
+	// of its option (zero for nil) mixed in with the selector
+	func (:: *{{.obj}}) hashTreeRootUnion{{.name}}(hh *ssz.Hasher) (err error) {
+		indx := hh.Index()
+		var selector uint64
+		switch obj := ::.{{.name}}.(type) {
+		case nil:
+			hh.PutZeroBytes(32)
+		{{range .options}}case *{{.wrapper}}:
+			if obj == nil {
+				return ssz.ErrUnionNil
+			}
+			selector = {{.selector}}
+			{{.hash}}
+		{{end}}}
+		hh.MerkleizeWithMixin(indx, selector, 1)
+		return
+	}`
+
+	options := []map[string]interface{}{}
+	for indx, option := range v.o {
+		o := v.unionOption(option)
+		options = append(options, map[string]interface{}{
+			"wrapper":   option.wrapper,
+			"selector":  indx + 1,
+			"marshal":   o.marshal(),
+			"unmarshal": o.unmarshal("buf"),
+			"fixed":     o.isFixed(),
+			"length":    o.fixedSize(),
+			"size":      o.size("size"),
+			"hash":      o.hashTreeRoot(""),
+		})
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"obj":     obj,
+		"options": options,
+	})
+}
+
+// fillUnion returns the code to set the union to nil or to a random option
+func (e *env) fillUnion(v *Value, depth int, populate bool) string {
+	tmpl := `switch rnd.Intn({{.num}}) {
+	case 0:
+		::.{{.name}} = nil
+	{{range .options}}case {{.selector}}:
+		::.{{$.name}} = new({{.wrapper}})
+		{{.fill}}
+	{{end}}}`
+
+	options := []map[string]interface{}{}
+	for indx, option := range v.o {
+		options = append(options, map[string]interface{}{
+			"selector": indx + 1,
+			"wrapper":  option.wrapper,
+			"fill":     e.fill(v.unionOption(option), depth, populate),
+		})
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"num":     len(v.o) + 1,
+		"options": options,
+	})
+}
+
+// schemaUnion returns the union of the options with None for the nil value
+func (v *Value) schemaUnion() string {
+	options := []string{"None"}
+	for _, option := range v.o {
+		options = append(options, option.schema())
+	}
+	return fmt.Sprintf("Union[%s]", strings.Join(options, ","))
+}
@@ -2,25 +2,69 @@ package main
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// regionRegexp matches the buffers sliced with constant bounds (i.e. buf[8:40])
+// or with the bounds of the element of a loop (i.e. buf[ii*32: (ii+1)*32])
+var regionRegexp = regexp.MustCompile(`\[(?:(\d+):(\d+)|(\w+)\*(\d+): ?\((\w+)\+1\)\*(\d+))\]$`)
+
+// hasLength returns true if the buffer is sliced with bounds that give it the size,
+// its length does not have to be checked before decoding it
+func hasLength(dst string, size uint64) bool {
+	match := regionRegexp.FindStringSubmatch(dst)
+	if match == nil {
+		return false
+	}
+	if match[1] != "" {
+		start, _ := strconv.ParseUint(match[1], 10, 64)
+		end, _ := strconv.ParseUint(match[2], 10, 64)
+		return end-start == size
+	}
+	return match[3] == match[5] && match[4] == match[6] && match[4] == strconv.FormatUint(size, 10)
+}
+
 // unmarshal creates a function that decodes the structs with the input byte in SSZ format.
+// The slices and objects are allocated with an optional ssz.Allocator (i.e. an arena).
 func (e *env) unmarshal(name string, v *Value) string {
 	tmpl := `// UnmarshalSSZ ssz unmarshals the {{.name}} object
 	func (:: *{{.name}}) UnmarshalSSZ(buf []byte) error {
+		return ::.UnmarshalSSZArena(buf, nil)
+	}
+
+	// UnmarshalSSZArena ssz unmarshals the {{.name}} object with the memory of the allocator
+	func (:: *{{.name}}) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 		var err error
 		{{.unmarshal}}
 		return err
+	}
+
+	// UnmarshalSSZWithOffset ssz unmarshals the {{.name}} object at the start of the buffer and
+	// returns the number of bytes consumed{{if not .fixed}}. The end of a dynamic object is not
+	// encoded, it consumes the whole buffer{{end}}
+	func (:: *{{.name}}) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+		{{if .fixed}}{{if .size}}if len(buf) < {{.size}} {
+			return 0, ssz.ErrSize
+		}
+		{{end}}if err := ::.UnmarshalSSZ(buf[:{{.size}}]); err != nil {
+			return 0, err
+		}
+		return {{.size}}, nil{{else}}if err := ::.UnmarshalSSZ(buf); err != nil {
+			return 0, err
+		}
+		return len(buf), nil{{end}}
 	}`
 
 	str := execTmpl(tmpl, map[string]interface{}{
 		"name":      name,
 		"unmarshal": v.umarshalContainer(true, "buf"),
+		"fixed":     v.isFixed(),
+		"size":      v.fixedSize(),
 	})
 
-	return appendObjSignature(str, v)
+	return e.appendObjSignature(str, v)
 }
 
 func (v *Value) unmarshal(dst string) string {
@@ -31,23 +75,43 @@ func (v *Value) unmarshal(dst string) string {
 
 	case TypeBytes:
 		if v.c {
-			return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+			if hasLength(dst, v.s) {
+				return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+			}
+			// the array is only filled if the buffer has its exact size
+			return fmt.Sprintf("if len(%s) != %d { return ssz.ErrBytesLength }\ncopy(::.%s[:], %s)", dst, v.s, v.name, dst)
 		}
-		validate := ""
-		if !v.isFixed() {
+		var validate string
+		if v.isFixed() {
+			// fixed bytes declared as a slice, the buffer must have the exact size
+			// unless it is a region of the fixed part with that size
+			if !hasLength(dst, v.s) {
+				validate = fmt.Sprintf("if len(%s) != %d { return ssz.ErrBytesLength }\n", dst, v.s)
+			}
+			if trailing := v.bits % 8; trailing != 0 {
+				// the bits of the last byte beyond the length of the bitvector must be zero
+				validate += fmt.Sprintf("if %s[%d]>>%d != 0 { return ssz.ErrInvalidBitvector }\n", dst, v.s-1, trailing)
+			}
+		} else {
 			// dynamic bytes, we need to validate the size of the buffer
-			validate = fmt.Sprintf("if len(%s) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
+			// (the length is compared as an uint64 since the limit may not fit in an int)
+			validate = fmt.Sprintf("if uint64(len(%s)) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
+			if v.min != 0 {
+				validate += fmt.Sprintf("if len(%s) < %d { return ssz.ErrListTooSmall }\n", dst, v.min)
+			}
 		}
-		// both fixed and dynamic are decoded equally
+		// both fixed and dynamic are decoded equally, the previous bytes
+		// are discarded so that the slice has the exact size of the input
 		tmpl := `{{.validate}}if cap(::.{{.name}}) == 0 {
-			::.{{.name}} = make([]byte, 0, len({{.dst}}))
+			::.{{.name}} = ssz.AllocBytes(alloc, len({{.dst}}))[:0]
 		}
-		::.{{.name}} = append(::.{{.name}}, {{.dst}}...)`
+		::.{{.name}} = append(::.{{.name}}[:0], {{.dst}}...){{.nilEmpty}}`
 		return execTmpl(tmpl, map[string]interface{}{
 			"validate": validate,
 			"name":     v.name,
 			"dst":      dst,
 			"size":     v.m,
+			"nilEmpty": v.unmarshalNilEmpty(),
 		})
 
 	case TypeUint:
@@ -66,9 +130,9 @@ func (v *Value) unmarshal(dst string) string {
 			return err
 		}
 		if cap(::.{{.name}}) == 0 {
-			::.{{.name}} = make([]byte, 0, len({{.dst}}))
+			::.{{.name}} = ssz.AllocBytes(alloc, len({{.dst}}))[:0]
 		}
-		::.{{.name}} = append(::.{{.name}}, {{.dst}}...)`
+		::.{{.name}} = append(::.{{.name}}[:0], {{.dst}}...)`
 		return execTmpl(tmpl, map[string]interface{}{
 			"name": v.name,
 			"dst":  dst,
@@ -77,16 +141,22 @@ func (v *Value) unmarshal(dst string) string {
 
 	case TypeVector:
 		if v.e.isFixed() {
-			dst = fmt.Sprintf("%s[ii*%d: (ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize())
-
-			tmpl := `{{.create}}
+			// the buffer must have exactly the elements of the vector, a shorter or
+			// longer buffer is not decoded into fewer or more elements
+			tmpl := `{{if .check}}if len({{.dst}}) != {{.length}} {
+				return ssz.ErrVectorLength
+			}
+			{{end}}{{.create}}
 			for ii := 0; ii < {{.size}}; ii++ {
 				{{.unmarshal}}
 			}`
 			return execTmpl(tmpl, map[string]interface{}{
+				"check":     !hasLength(dst, v.s*v.e.fixedSize()),
+				"dst":       dst,
+				"length":    v.s * v.e.fixedSize(),
 				"create":    v.createSlice(false),
 				"size":      v.s,
-				"unmarshal": v.e.unmarshal(dst),
+				"unmarshal": v.e.unmarshal(fmt.Sprintf("%s[ii*%d: (ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize())),
 			})
 		}
 		fallthrough
@@ -97,6 +167,18 @@ func (v *Value) unmarshal(dst string) string {
 	case TypeBool:
 		return fmt.Sprintf("::.%s = ssz.UnmarshalBool(%s)", v.name, dst)
 
+	case TypePackedBools:
+		return v.unmarshalPackedBools(dst)
+
+	case TypeCustom:
+		return fmt.Sprintf("if err = ::.unmarshalField%s(%s); err != nil {\nreturn err\n}", v.name, dst)
+
+	case TypeReserved:
+		return v.unmarshalReserved(dst)
+
+	case TypeUnion:
+		return fmt.Sprintf("if err = ::.unmarshalUnion%s(%s, alloc); err != nil {\nreturn err\n}", v.name, dst)
+
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %d", v.t))
 	}
@@ -110,15 +192,17 @@ func (v *Value) unmarshalList() string {
 		if err != nil {
 			return err
 		}
-		{{.create}}
+		{{.min}}{{.create}}
 		for ii := 0; ii < num; ii++ {
 			{{.unmarshal}}
-		}`
+		}{{.nilEmpty}}`
 		return execTmpl(tmpl, map[string]interface{}{
 			"size":      v.e.fixedSize(),
 			"max":       v.s,
+			"min":       v.unmarshalMin(),
 			"create":    v.createSlice(true),
 			"unmarshal": v.e.unmarshal(dst),
+			"nilEmpty":  v.unmarshalNilEmpty(),
 		})
 	}
 
@@ -133,42 +217,85 @@ func (v *Value) unmarshalList() string {
 	if err != nil {
 		return err
 	}
-	{{.create}}
+	{{.min}}{{.create}}
 	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
 		{{.unmarshal}}
 		return nil
 	})
 	if err != nil {
 		return err
-	}`
+	}{{.nilEmpty}}`
 
 	v.e.name = v.name + "[indx]"
 
 	data := map[string]interface{}{
-		"max":      v.s,
+		"max":       v.s,
+		"min":       v.unmarshalMin(),
 		"create":    v.createSlice(true),
 		"unmarshal": v.e.unmarshal("buf"),
+		"nilEmpty":  v.unmarshalNilEmpty(),
 	}
 	return execTmpl(tmpl, data)
 }
 
 func (v *Value) umarshalContainer(start bool, dst string) (str string) {
+	if !start && v.iface {
+		tmpl := `{
+			obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				obj = ssz.AllocNew[{{.obj}}](alloc)
+				::.{{.name}} = obj
+			}
+			if err = {{.unmarshal}}; err != nil {
+				return err
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":      v.name,
+			"obj":       v.objRef(),
+			"unmarshal": v.unmarshalObj("obj", dst),
+		})
+	}
+	if !start && v.optional {
+		// no bytes is the absent value, otherwise the value follows the presence byte
+		tmpl := `if len({{.dst}}) == 0 {
+			::.{{.name}} = nil
+		} else {
+			if {{.dst}}[0] != 1 {
+				return ssz.ErrInvalidOptional
+			}
+			if ::.{{.name}} == nil {
+				::.{{.name}} = ssz.AllocNew[{{.obj}}](alloc)
+			}
+			if err = {{.unmarshal}}; err != nil {
+				return err
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":      v.name,
+			"obj":       v.objRef(),
+			"dst":       dst,
+			"unmarshal": v.unmarshalObj("::."+v.name, dst+"[1:]"),
+		})
+	}
 	if !start {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
-			::.{{.name}} = new({{.obj}})
+			::.{{.name}} = ssz.AllocNew[{{.obj}}](alloc)
 		}
-		{{ end }}if err = ::.{{.name}}.UnmarshalSSZ({{.dst}}); err != nil {
+		{{ end }}if err = {{.unmarshal}}; err != nil {
 			return err
 		}`
 		check := true
+		obj := "::." + v.name
 		if v.noPtr {
 			check = false
+			obj = "&" + obj
 		}
 		return execTmpl(tmpl, map[string]interface{}{
-			"name":  v.name,
-			"obj":   v.objRef(),
-			"dst":   dst,
-			"check": check,
+			"name":      v.name,
+			"obj":       v.objRef(),
+			"unmarshal": v.unmarshalObj(obj, dst),
+			"check":     check,
 		})
 	}
 
@@ -176,7 +303,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	offsetsMatch := map[string]string{}
 
 	for indx, i := range v.o {
-		if !i.isFixed() {
+		if i.hasOffset() {
 			name := "o" + strconv.Itoa(indx)
 			if len(offsets) != 0 {
 				offsetsMatch[name] = offsets[len(offsets)-1]
@@ -188,6 +315,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	// safe check for the size. Two cases:
 	// 1. Struct is fixed: The size of the input buffer must be the same as the struct.
 	// 2. Struct is dynamic. The size of the input buffer must be higher than the fixed part of the struct.
+	// The struct is also dynamic if it is extensible, the extension holds any bytes after the fixed part.
 
 	var cmp string
 	if v.isFixed() {
@@ -219,13 +347,20 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	// Marshal the fixed part and offsets
 
 	// used for bounds checking of variable length offsets.
-	// for the first offset, use the size of the fixed-length data
-	// as the minimum boundary. subsequent offsets will replace this
-	// value with the name of the previous offset variable.
+	// the first offset must be the size of the fixed-length data,
+	// otherwise the bytes between the fixed part and the first
+	// dynamic field would be ignored. subsequent offsets will replace
+	// this value with the name of the previous offset variable.
 	firstOffsetCheck := fmt.Sprintf("%d", v.fixedSize())
 	outs := []string{}
 	for indx, i := range v.o {
 
+		if i.lengthFrom != "" {
+			// the framed bytes are read in place, the next fields keep their positions
+			outs = append(outs, fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshalFramed(o0, v.fixedSize())))
+			continue
+		}
+
 		// How much it increases on every item
 		var incr uint64
 		if i.isFixed() {
@@ -246,16 +381,17 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			offset := "o" + strconv.Itoa(indx)
 
 			data := map[string]interface{}{
-				"indx":   indx,
-				"name":   i.name,
-				"offset": offset,
-				"dst":    dst,
+				"indx":             indx,
+				"name":             i.name,
+				"offset":           offset,
+				"dst":              dst,
 				"firstOffsetCheck": firstOffsetCheck,
 			}
 
 			// We need to do two validations for the offset:
 			// 1. The offset is lower than the total size of the input buffer
 			// 2. The offset i needs to be higher than the offset i-1 (Only if the offset is not the first).
+			// The first offset is the end of the fixed part so that no bytes are skipped.
 
 			if prev, ok := offsetsMatch[offset]; ok {
 				data["more"] = fmt.Sprintf(" || %s > %s", prev, offset)
@@ -268,7 +404,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				return ssz.ErrOffset
 			}
 			{{ if .firstOffsetCheck }}
-			if {{.offset}} < {{.firstOffsetCheck}} {
+			if {{.offset}} != {{.firstOffsetCheck}} {
 				return ssz.ErrInvalidVariableOffset
 			}
 			{{ end }}
@@ -284,7 +420,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	c := 0
 
 	for indx, i := range v.o {
-		if !i.isFixed() {
+		if i.hasOffset() {
 			from := offsets[c]
 			var to string
 			if c == len(offsets)-1 {
@@ -309,17 +445,69 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		}
 	}
 
+	// the bytes beyond the known fields are captured in the extension
+	if v.ext != "" {
+		outs = append(outs, fmt.Sprintf("// Extension '%s'\n::.%s = append(::.%s[:0], buf[%d:]...)", v.ext, v.ext, v.ext, v.fixedSize()))
+	}
+	if transient := v.unmarshalTransient(); transient != "" {
+		outs = append(outs, transient)
+	}
+
 	str += strings.Join(outs, "\n\n")
 	return
 }
 
+// unmarshalTransient returns the calls to the methods that populate the fields
+// which are not encoded (ssz-transient) once the container is decoded. The cached
+// tree (ssz-tree-cache) is also discarded since the fields changed.
+func (v *Value) unmarshalTransient() string {
+	out := []string{}
+	if v.treeCache != "" {
+		out = append(out, fmt.Sprintf("// Cached tree\n::.%s = nil", v.treeCache))
+	}
+	if len(v.transient) != 0 {
+		hooks := []string{"// Transient fields"}
+		for _, hook := range v.transient {
+			hooks = append(hooks, fmt.Sprintf("::.%s()", hook))
+		}
+		out = append(out, strings.Join(hooks, "\n"))
+	}
+	return strings.Join(out, "\n\n")
+}
+
+// unmarshalMin checks that the decoded list has at least the number of elements of the 'ssz-min' tag
+func (v *Value) unmarshalMin() string {
+	if v.min == 0 {
+		return ""
+	}
+	return fmt.Sprintf("if num < %d {\nreturn ssz.ErrListTooSmall\n}\n", v.min)
+}
+
+// unmarshalNilEmpty returns the code that sets the decoded list to nil if it is empty.
+// Otherwise, the empty lists are decoded to empty slices.
+func (v *Value) unmarshalNilEmpty() string {
+	if !v.nilEmpty {
+		return ""
+	}
+	return fmt.Sprintf("\nif len(::.%s) == 0 {\n::.%s = nil\n}", v.name, v.name)
+}
+
+// unmarshalObj returns the call to unmarshal the pointer obj with the allocator.
+// Only the structs of this package are known to have the UnmarshalSSZArena method.
+func (v *Value) unmarshalObj(obj, dst string) string {
+	if v.t == TypeContainer && v.ref == "" {
+		return fmt.Sprintf("%s.UnmarshalSSZArena(%s, alloc)", strings.TrimPrefix(obj, "&"), dst)
+	}
+	return fmt.Sprintf("ssz.UnmarshalWithAllocator(%s, %s, alloc)", obj, dst)
+}
+
 // createItem is used to initialize slices of objects
 func (v *Value) createSlice(useNumVariable bool) string {
 	if v.t != TypeVector && v.t != TypeList {
 		panic("BUG: create item is only intended to be used with vectors and lists")
 	}
 
-	size := strconv.Itoa(int(v.s))
+	size := strconv.FormatUint(v.s, 10)
 	// when useNumVariable is specified, we assume there is a 'num' variable generated beforehand with the expected size.
 	if useNumVariable {
 		size = "num"
@@ -327,22 +515,20 @@ func (v *Value) createSlice(useNumVariable bool) string {
 
 	switch v.e.t {
 	case TypeUint:
-		// []int uses the Extend functions in the fastssz package
-		return fmt.Sprintf("::.%s = ssz.Extend%s(::.%s, %s)", v.name, uintVToName(v.e), v.name, size)
+		// []int reuses the capacity of the slice
+		return fmt.Sprintf("::.%s = ssz.AllocExtend(alloc, ::.%s, %s)", v.name, v.name, size)
 
-	case TypeContainer:
-		// []*(ref.)Struct{}
-		return fmt.Sprintf("::.%s = make([]*%s, %s)", v.name, v.e.objRef(), size)
+	case TypeContainer, TypeReference:
+		// []*(ref.)Struct{} reuses the capacity of the slice and the objects it points to
+		return fmt.Sprintf("::.%s = ssz.AllocExtend(alloc, ::.%s, %s)", v.name, v.name, size)
 
 	case TypeBytes:
 		// [][]byte
 		if v.c {
 			return ""
 		}
-		if v.e.c {
-			return fmt.Sprintf("::.%s = make([][%d]byte, %s)", v.name, v.e.s, size)
-		}
-		return fmt.Sprintf("::.%s = make([][]byte, %s)", v.name, size)
+		// the element may be a named type (i.e. []Blob)
+		return fmt.Sprintf("::.%s = ssz.AllocSlice[%s](alloc, %s)", v.name, v.e.goType(), size)
 
 	default:
 		panic(fmt.Sprintf("create not implemented for type %s", v.e.t.String()))
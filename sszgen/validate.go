@@ -1,5 +1,7 @@
 package main
 
+import "fmt"
+
 func (v *Value) validate() string {
 	switch v.t {
 	case TypeBitList, TypeBytes:
@@ -23,7 +25,7 @@ func (v *Value) validate() string {
 			"cmp":  cmp,
 			"name": v.name,
 			"size": v.s,
-		})
+		}) + v.validateMin()
 
 	case TypeVector:
 		// this is a fixed-length array, not a slice, so it's size is a constant we don't need to check
@@ -50,9 +52,17 @@ func (v *Value) validate() string {
 		return execTmpl(tmpl, map[string]interface{}{
 			"name": v.name,
 			"size": v.s,
-		})
+		}) + v.validateMin()
 
 	default:
 		return ""
 	}
 }
+
+// validateMin checks that the list has at least the number of elements of the 'ssz-min' tag
+func (v *Value) validateMin() string {
+	if v.min == 0 {
+		return ""
+	}
+	return fmt.Sprintf("if len(::.%s) < %d {\nerr = ssz.ErrListTooSmall\nreturn\n}\n", v.name, v.min)
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatVarint is the '-format' that also generates the methods of the varint format
+const formatVarint = "varint"
+
+// marshalVarint creates the functions to encode and decode the struct in the varint format.
+// This is a compact format (not SSZ) that encodes the uints as LEB128 varints. The fields
+// are written in order and the lists and dynamic bytes are prefixed with their length,
+// the nested structs must also be generated with the varint format.
+func (e *env) marshalVarint(name string, v *Value) string {
+	tmpl := `// MarshalVarint marshals the {{.name}} object in the varint format
+	func (:: *{{.name}}) MarshalVarint() ([]byte, error) {
+		return ::.MarshalVarintTo(nil)
+	}
+
+	// MarshalVarintTo marshals the {{.name}} object in the varint format to a target array
+	func (:: *{{.name}}) MarshalVarintTo(buf []byte) (dst []byte, err error) {
+		dst = buf
+		{{.marshal}}
+		return
+	}
+
+	// UnmarshalVarint unmarshals the {{.name}} object from the varint format
+	func (:: *{{.name}}) UnmarshalVarint(buf []byte) error {
+		if err := ::.UnmarshalVarintFrom(&buf); err != nil {
+			return err
+		}
+		if len(buf) != 0 {
+			return ssz.ErrSize
+		}
+		return nil
+	}
+
+	// UnmarshalVarintFrom unmarshals the {{.name}} object in the varint format from
+	// the start of the buffer and advances the buffer past the decoded bytes
+	func (:: *{{.name}}) UnmarshalVarintFrom(src *[]byte) error {
+		var err error
+		{{if .alloc}}var alloc ssz.Allocator
+		{{end}}buf := *src
+		{{.unmarshal}}
+		{{if .transient}}{{.transient}}
+		{{end}}*src = buf
+		return err
+	}`
+
+	marshal := []string{}
+	unmarshal := []string{}
+	for indx, i := range v.o {
+		marshal = append(marshal, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalVarint(0)))
+		unmarshal = append(unmarshal, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.unmarshalVarint(0)))
+	}
+	if v.ext != "" {
+		// the extension is encoded as dynamic bytes
+		tmpl := `// Extension '{{.name}}'
+		dst = ssz.AppendUvarint(dst, uint64(len(::.{{.name}})))
+		dst = append(dst, ::.{{.name}}...)
+		`
+		marshal = append(marshal, execTmpl(tmpl, map[string]interface{}{"name": v.ext}))
+
+		tmpl = `// Extension '{{.name}}'
+		{
+			size, err := ssz.ReadUvarint(&buf, 8)
+			if err != nil {
+				return err
+			}
+			val, err := ssz.ReadBytes(&buf, size)
+			if err != nil {
+				return err
+			}
+			::.{{.name}} = append(::.{{.name}}[:0], val...)
+		}
+		`
+		unmarshal = append(unmarshal, execTmpl(tmpl, map[string]interface{}{"name": v.ext}))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"marshal":   strings.Join(marshal, "\n"),
+		"unmarshal": strings.Join(unmarshal, "\n"),
+		"transient": v.unmarshalTransient(),
+		// the objects are decoded with the default allocator
+		"alloc": allocRegexp.MatchString(strings.Join(unmarshal, "\n")),
+	})
+	return e.appendObjSignature(str, v)
+}
+
+// varintIndex returns the name of the index of a collection nested depth times
+func varintIndex(depth int) string {
+	return strings.Repeat("i", depth+2)
+}
+
+func (v *Value) marshalVarint(depth int) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.optional {
+			// the presence of the value is encoded before it
+			tmpl := `if ::.{{.name}} == nil {
+				dst = ssz.AppendUvarint(dst, 0)
+			} else {
+				dst = ssz.AppendUvarint(dst, 1)
+				if dst, err = ::.{{.name}}.MarshalVarintTo(dst); err != nil {
+					return
+				}
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name": v.name,
+			})
+		}
+		tmpl := `{
+			{{if .iface}}obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				err = ssz.ErrConcreteType
+				return
+			}
+			{{else}}obj := {{if .noPtr}}&{{end}}::.{{.name}}
+			{{if not .noPtr}}if obj == nil {
+				obj = new({{.obj}})
+			}
+			{{end}}{{end}}if dst, err = obj.MarshalVarintTo(dst); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"obj":   v.objRef(),
+			"iface": v.iface,
+			"noPtr": v.noPtr,
+		})
+
+	case TypeUint:
+		return fmt.Sprintf("dst = ssz.AppendUvarint(dst, uint64(::.%s))", v.name)
+
+	case TypeBytes, TypeBitList:
+		if v.isFixed() {
+			return v.marshal()
+		}
+		// the dynamic bytes are prefixed with their length
+		return fmt.Sprintf("%sdst = ssz.AppendUvarint(dst, uint64(len(::.%s)))\ndst = append(dst, ::.%s...)", v.validate(), v.name, v.name)
+
+	case TypeBool, TypePackedBools, TypeReserved:
+		return v.marshal()
+
+	case TypeCustom:
+		if v.isFixed() {
+			return v.marshal()
+		}
+		// the dynamic field is prefixed with its length as the dynamic bytes
+		return fmt.Sprintf("dst = ssz.AppendUvarint(dst, uint64(::.sizeField%s()))\ndst = ::.marshalField%s(dst)", v.name, v.name)
+
+	case TypeUnion:
+		// the union is encoded in ssz and prefixed with its length as the dynamic bytes
+		return fmt.Sprintf("dst = ssz.AppendUvarint(dst, uint64(::.sizeUnion%s()))\nif dst, err = ::.marshalUnion%s(dst); err != nil {\nreturn\n}", v.name, v.name)
+
+	case TypeVector, TypeList:
+		indx := varintIndex(depth)
+		v.e.name = fmt.Sprintf("%s[%s]", v.name, indx)
+
+		tmpl := `{{.validate}}{{if .list}}dst = ssz.AppendUvarint(dst, uint64(len(::.{{.name}})))
+		{{end}}for {{.indx}} := range ::.{{.name}} {
+			{{.marshal}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"validate": v.validate(),
+			"list":     v.t == TypeList,
+			"name":     v.name,
+			"indx":     indx,
+			"marshal":  v.e.marshalVarint(depth + 1),
+		})
+
+	default:
+		panic(fmt.Errorf("marshal varint not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) unmarshalVarint(depth int) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.optional {
+			tmpl := `{
+				present, err := ssz.ReadUvarint(&buf, 1)
+				if err != nil {
+					return err
+				}
+				switch present {
+				case 0:
+					::.{{.name}} = nil
+				case 1:
+					if ::.{{.name}} == nil {
+						::.{{.name}} = ssz.AllocNew[{{.obj}}](alloc)
+					}
+					if err = ::.{{.name}}.UnmarshalVarintFrom(&buf); err != nil {
+						return err
+					}
+				default:
+					return ssz.ErrInvalidOptional
+				}
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name": v.name,
+				"obj":  v.objRef(),
+			})
+		}
+		tmpl := `{{if .iface}}{
+			obj, ok := ::.{{.name}}.(*{{.obj}})
+			if !ok {
+				obj = ssz.AllocNew[{{.obj}}](alloc)
+				::.{{.name}} = obj
+			}
+			if err = obj.UnmarshalVarintFrom(&buf); err != nil {
+				return err
+			}
+		}{{else}}{{if not .noPtr}}if ::.{{.name}} == nil {
+			::.{{.name}} = ssz.AllocNew[{{.obj}}](alloc)
+		}
+		{{end}}if err = ::.{{.name}}.UnmarshalVarintFrom(&buf); err != nil {
+			return err
+		}{{end}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"obj":   v.objRef(),
+			"iface": v.iface,
+			"noPtr": v.noPtr,
+		})
+
+	case TypeUint:
+		tmpl := `{
+			val, err := ssz.ReadUvarint(&buf, {{.size}})
+			if err != nil {
+				return err
+			}
+			::.{{.name}} = {{.type}}(val)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"size": v.s,
+			"type": v.goType(),
+		})
+
+	case TypeBytes, TypeBitList, TypeBool, TypePackedBools, TypeCustom, TypeReserved, TypeUnion:
+		// the bytes are decoded as in ssz once they are read from the buffer
+		tmpl := `{
+			{{if .fixed}}val, err := ssz.ReadBytes(&buf, {{.size}})
+			{{else}}size, err := ssz.ReadUvarint(&buf, 8)
+			if err != nil {
+				return err
+			}
+			val, err := ssz.ReadBytes(&buf, size)
+			{{end}}if err != nil {
+				return err
+			}
+			{{.unmarshal}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"fixed":     v.isFixed(),
+			"size":      v.fixedSize(),
+			"unmarshal": v.unmarshal("val"),
+		})
+
+	case TypeVector, TypeList:
+		indx := varintIndex(depth)
+		v.e.name = fmt.Sprintf("%s[%s]", v.name, indx)
+
+		create := ""
+		if !v.c {
+			create = v.createSlice(v.t == TypeList)
+		}
+		tmpl := `{
+			{{if .list}}size, err := ssz.ReadUvarint(&buf, 8)
+			if err != nil {
+				return err
+			}
+			if size > {{.max}} {
+				return ssz.ErrListTooBig
+			}
+			{{if .min}}if size < {{.min}} {
+				return ssz.ErrListTooSmall
+			}
+			{{end}}{{if .bound}}if size > uint64(len(buf)) {
+				return ssz.ErrSize
+			}
+			{{end}}num := int(size)
+			{{end}}{{.create}}
+			for {{.indx}} := 0; {{.indx}} < {{if .list}}num{{else}}{{.size}}{{end}}; {{.indx}}++ {
+				{{.unmarshal}}
+			}{{.nilEmpty}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"list": v.t == TypeList,
+			"max":  v.s,
+			"min":  v.min,
+			// every element other than a struct has at least one byte
+			"bound":     v.e.t != TypeContainer && v.e.t != TypeReference,
+			"size":      v.s,
+			"create":    create,
+			"indx":      indx,
+			"unmarshal": v.e.unmarshalVarint(depth + 1),
+			"nilEmpty":  v.unmarshalNilEmpty(),
+		})
+
+	default:
+		panic(fmt.Errorf("unmarshal varint not implemented for type %s", v.t.String()))
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// testVectorsPrefix is the suffix of the generated file with the test vectors
+	testVectorsPrefix = "_vectors_test.go"
+	// testVectorsCases is the number of random test vectors written for each object
+	testVectorsCases = 4
+	// testVectorsListLimit is the maximum number of elements of the random lists since
+	// the limit of the lists is usually too big to populate them entirely
+	testVectorsListLimit = 16
+)
+
+// printTestVectors creates a test file that writes random test vectors for the objects
+// in the format of the consensus spec tests. Lists are populated up to their limit
+// (or testVectorsListLimit) so that the vectors cover most of the encoding.
+func (e *env) printTestVectors(order []string) (string, bool, error) {
+	hash, err := e.hashSource()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to hash files: %v", err)
+	}
+
+	tmpl := `// Code generated by fastssz. DO NOT EDIT.
+	// Hash: {{.hash}}
+	{{if .build}}{{.build}}
+
+	{{end}}{{if .nolint}}{{.nolint}}
+	{{end}}package {{.package}}
+
+	import (
+		"fmt"
+		"math/rand"
+		"os"
+		"path/filepath"
+		"testing"
+
+		ssz "github.com/photon-storage/fastssz" {{ if .imports }}{{ range $value := .imports }}
+			{{ $value }} {{ end }}
+		{{ end }}
+	)
+
+	{{ range .objs }}
+		{{ . }}
+	{{ end }}
+	`
+
+	data := map[string]interface{}{
+		"package": e.packName,
+		"hash":    hash,
+		"nolint":  e.nolint,
+		"build":   e.buildConstraint(order),
+	}
+
+	objs := []string{}
+	imports := []string{}
+	for _, name := range order {
+		obj, ok := e.printableObj(name)
+		if !ok {
+			continue
+		}
+		imports = appendWithoutRepeated(imports, detectImports(obj))
+		objs = append(objs, e.testVectorsObj(name, obj))
+	}
+	if len(objs) == 0 {
+		return "", false, nil
+	}
+	data["objs"] = objs
+
+	importsStr, err := e.buildImports(imports)
+	if err != nil {
+		return "", false, err
+	}
+	if len(importsStr) != 0 {
+		data["imports"] = importsStr
+	}
+	return execTmpl(tmpl, data), true, nil
+}
+
+func (e *env) testVectorsObj(name string, v *Value) string {
+	tmpl := `// TestSSZTestVectors{{.name}} writes random test vectors of the {{.name}} object
+	// to the folder in the SSZ_TEST_VECTORS environment variable
+	func TestSSZTestVectors{{.name}}(t *testing.T) {
+		dir := os.Getenv("SSZ_TEST_VECTORS")
+		if dir == "" {
+			t.Skip("SSZ_TEST_VECTORS is not set")
+		}
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < {{.cases}}; i++ {
+			obj := new({{.name}})
+			fill{{.name}}SSZ(obj, rnd)
+			if err := ssz.WriteTestVector(filepath.Join(dir, "{{.name}}", "ssz_random", fmt.Sprintf("case_%d", i)), obj); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	{{.fill}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"cases": testVectorsCases,
+		"fill":  e.fillObj(name, v),
+	})
+}
+
+// fillObj creates the function that populates the object with random values
+func (e *env) fillObj(name string, v *Value) string {
+	tmpl := `// fill{{.name}}SSZ populates the {{.name}} object with random values
+	func fill{{.name}}SSZ(:: *{{.name}}, rnd *rand.Rand) {
+		{{.fill}}
+	}`
+
+	out := []string{}
+	for indx, i := range v.o {
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, e.fillField(v, i, false)))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+		"fill": strings.Join(out, "\n"),
+	})
+	return e.appendObjSignature(str, v)
+}
+
+// fillField returns the code to populate the field of the container with random data.
+// The length fields (ssz-length-of) are set to the length of their framed field.
+func (e *env) fillField(v, f *Value, populate bool) string {
+	if f.lengthOf != "" {
+		return v.fillFramed(f, populate)
+	}
+	return e.fill(f, 0, populate)
+}
+
+// fill returns the code to populate the value with random data.
+// depth is used to name the index of nested collections. With populate, the
+// lists are filled up to their limit and the nested objects with PopulateSSZ.
+func (e *env) fill(v *Value, depth int, populate bool) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return e.fillContainer(v, populate)
+
+	case TypeUint:
+		fn := "Uint32"
+		if v.s == 8 {
+			fn = "Uint64"
+		}
+		return fmt.Sprintf("::.%s = %s(rnd.%s())", v.name, v.goType(), fn)
+
+	case TypeBool:
+		return fmt.Sprintf("::.%s = rnd.Intn(2) == 1", v.name)
+
+	case TypePackedBools:
+		out := []string{}
+		for _, i := range v.o {
+			out = append(out, e.fill(i, depth, populate))
+		}
+		return strings.Join(out, "\n")
+
+	case TypeBytes:
+		if v.c {
+			return fmt.Sprintf("rnd.Read(::.%s[:])", v.name)
+		}
+		str := fmt.Sprintf("::.%s = make([]byte, %d)\nrnd.Read(::.%s)", v.name, v.fillLength(populate), v.name)
+		if trailing := v.bits % 8; trailing != 0 {
+			// clear the bits beyond the length of the bitvector
+			str += fmt.Sprintf("\n::.%s[%d] &= 0x%x", v.name, v.s-1, 1<<trailing-1)
+		}
+		return str
+
+	case TypeBitList:
+		return fmt.Sprintf("::.%s = ssz.RandomBitlist(rnd, %d)", v.name, v.fillLength(populate))
+
+	case TypeVector, TypeList:
+		indx := strings.Repeat("i", depth+2)
+		v.e.name = fmt.Sprintf("%s[%s]", v.name, indx)
+
+		tmpl := `{{if .make}}::.{{.name}} = make({{.type}}, {{.size}})
+		{{end}}for {{.indx}} := range ::.{{.name}} {
+			{{.fill}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"make": !v.c,
+			"type": v.goType(),
+			"size": v.fillLength(populate),
+			"indx": indx,
+			"fill": e.fill(v.e, depth+1, populate),
+		})
+
+	case TypeCustom, TypeReserved:
+		// the custom field is left with its zero value and the reserved bytes are not stored
+		return ""
+
+	case TypeUnion:
+		return e.fillUnion(v, depth, populate)
+
+	default:
+		panic(fmt.Errorf("fill not implemented for type %s", v.t.String()))
+	}
+}
+
+func (e *env) fillContainer(v *Value, populate bool) string {
+	// only the objects of this package have a fill function (the files of the
+	// package must be generated with test vectors), any other object is left with
+	// its zero value.
+	_, hasFill := e.printableObj(v.obj)
+	hasFill = hasFill && v.ref == "" && v.t == TypeContainer
+
+	// fillFn returns the call that populates the object at the pointer
+	fillFn := func(ptr string) string {
+		if populate {
+			return fmt.Sprintf("%s.PopulateSSZ(rnd)", ptr)
+		}
+		return fmt.Sprintf("fill%sSSZ(%s, rnd)", v.obj, ptr)
+	}
+
+	if v.iface {
+		tmpl := `{
+			obj := new({{.obj}})
+			{{if .fill}}{{.fill}}
+			{{end}}::.{{.name}} = obj
+		}`
+		fill := ""
+		if hasFill {
+			fill = fillFn("obj")
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"obj":  v.objRef(),
+			"fill": fill,
+		})
+	}
+	if v.noPtr {
+		if !hasFill {
+			return ""
+		}
+		return fillFn("&::." + v.name)
+	}
+	str := fmt.Sprintf("::.%s = new(%s)", v.name, v.objRef())
+	if hasFill {
+		str += "\n" + fillFn("::."+v.name)
+	}
+	if v.optional && !populate {
+		// the optional value is absent half of the times
+		str = fmt.Sprintf("if rnd.Intn(2) == 1 {\n%s\n}", str)
+	}
+	return str
+}
+
+// fillLength returns the number of elements used to populate a collection
+func (v *Value) fillLength(populate bool) uint64 {
+	limit := uint64(testVectorsListLimit)
+	if populate {
+		limit = populateListLimit
+	}
+	if v.t == TypeVector || v.isFixed() || v.s < limit {
+		return v.s
+	}
+	return limit
+}
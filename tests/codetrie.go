@@ -1,5 +1,7 @@
 package tests
 
+import ssz "github.com/photon-storage/fastssz"
+
 type Metadata struct {
 	Version    uint8
 	CodeHash   []byte `ssz-size:"32"`
@@ -20,3 +22,16 @@ type CodeTrieBig struct {
 	Metadata *Metadata
 	Chunks   []*Chunk `ssz-max:"1024"`
 }
+
+// CachedTrie keeps its tree to hash it again only along the changed fields
+type CachedTrie struct {
+	Metadata *Metadata
+	Chunks   []*Chunk  `ssz-max:"4"`
+	Tree     *ssz.Node `ssz-tree-cache:"true"`
+}
+
+// BlobTrie has a byte list and a list of byte lists whose roots have their lengths mixed in
+type BlobTrie struct {
+	Data  []byte   `ssz-max:"64"`
+	Blobs [][]byte `ssz-max:"4,40"`
+}
@@ -1,15 +1,22 @@
 // Code generated by fastssz. DO NOT EDIT.
+// Hash: 7de0a121b641cdf11a8a07f7ff6ce1b566e83a8406b56f4c058a798fb2317229
 package tests
 
 import (
 	ssz "github.com/photon-storage/fastssz"
 )
 
-// MarshalSSZ ssz marshals the Metadata object
+// MarshalSSZ ssz marshals the Metadata object to a new buffer owned by the caller
 func (m *Metadata) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(m)
 }
 
+// MarshalSSZPooled ssz marshals the Metadata object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (m *Metadata) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(m)
+}
+
 // MarshalSSZTo ssz marshals the Metadata object to a target array
 func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -32,6 +39,11 @@ func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Metadata object
 func (m *Metadata) UnmarshalSSZ(buf []byte) error {
+	return m.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Metadata object with the memory of the allocator
+func (m *Metadata) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 35 {
@@ -43,9 +55,9 @@ func (m *Metadata) UnmarshalSSZ(buf []byte) error {
 
 	// Field (1) 'CodeHash'
 	if cap(m.CodeHash) == 0 {
-		m.CodeHash = make([]byte, 0, len(buf[1:33]))
+		m.CodeHash = ssz.AllocBytes(alloc, len(buf[1:33]))[:0]
 	}
-	m.CodeHash = append(m.CodeHash, buf[1:33]...)
+	m.CodeHash = append(m.CodeHash[:0], buf[1:33]...)
 
 	// Field (2) 'CodeLength'
 	m.CodeLength = ssz.UnmarshallUint16(buf[33:35])
@@ -53,12 +65,131 @@ func (m *Metadata) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// UnmarshalSSZWithOffset ssz unmarshals the Metadata object at the start of the buffer and
+// returns the number of bytes consumed
+func (m *Metadata) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 35 {
+		return 0, ssz.ErrSize
+	}
+	if err := m.UnmarshalSSZ(buf[:35]); err != nil {
+		return 0, err
+	}
+	return 35, nil
+}
+
+// MarshalFieldsSSZ ssz marshals the given fields of the Metadata object
+func (m *Metadata) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return m.MarshalFieldsSSZTo(nil, fields...)
+}
+
+// MarshalFieldsSSZTo ssz marshals the given fields of the Metadata object to a target array
+func (m *Metadata) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "Version":
+			present[0] |= 1 << 0
+		case "CodeHash":
+			present[0] |= 1 << 1
+		case "CodeLength":
+			present[0] |= 1 << 2
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
+
+	// Field (0) 'Version'
+	if present[0]&(1<<0) != 0 {
+		dst = ssz.MarshalUint8(dst, m.Version)
+	}
+
+	// Field (1) 'CodeHash'
+	if present[0]&(1<<1) != 0 {
+		if len(m.CodeHash) != 32 {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, m.CodeHash...)
+	}
+
+	// Field (2) 'CodeLength'
+	if present[0]&(1<<2) != 0 {
+		dst = ssz.MarshalUint16(dst, m.CodeLength)
+	}
+
+	return
+}
+
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the Metadata object.
+// The fields that are not present in the encoding are not modified.
+func (m *Metadata) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>3 != 0 {
+		return ssz.ErrUnknownField
+	}
+
+	// Field (0) 'Version'
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 1 {
+			return ssz.ErrSize
+		}
+		buf := data[:1]
+		data = data[1:]
+		m.Version = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (1) 'CodeHash'
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 32 {
+			return ssz.ErrSize
+		}
+		buf := data[:32]
+		data = data[32:]
+		if len(buf) != 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(m.CodeHash) == 0 {
+			m.CodeHash = ssz.AllocBytes(alloc, len(buf))[:0]
+		}
+		m.CodeHash = append(m.CodeHash[:0], buf...)
+	}
+
+	// Field (2) 'CodeLength'
+	if present[0]&(1<<2) != 0 {
+		if len(data) < 2 {
+			return ssz.ErrSize
+		}
+		buf := data[:2]
+		data = data[2:]
+		m.CodeLength = ssz.UnmarshallUint16(buf)
+	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
+	return err
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Metadata object
 func (m *Metadata) SizeSSZ() (size int) {
 	size = 35
 	return
 }
 
+// SizeSSZMetadata returns the ssz encoded size in bytes of any Metadata object
+func SizeSSZMetadata() int {
+	return 35
+}
+
 // HashTreeRoot ssz hashes the Metadata object
 func (m *Metadata) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(m)
@@ -67,6 +198,7 @@ func (m *Metadata) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Metadata object with a hasher
 func (m *Metadata) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(3)
 
 	// Field (0) 'Version'
 	hh.PutUint8(m.Version)
@@ -85,6 +217,95 @@ func (m *Metadata) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MerkleProof returns the merkle branch that proves the field against the root of the Metadata object
+func (m *Metadata) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Version":
+		leaf = 0
+	case "CodeHash":
+		leaf = 1
+	case "CodeLength":
+		leaf = 2
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(m.Version)
+
+	// Field (1) 'CodeHash'
+	if len(m.CodeHash) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(m.CodeHash)
+
+	// Field (2) 'CodeLength'
+	hh.PutUint16(m.CodeLength)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Metadata object are zero
+func (m *Metadata) IsZeroSSZ() bool {
+	// Field (0) 'Version'
+	if m.Version != 0 {
+		return false
+	}
+
+	// Field (1) 'CodeHash'
+	for _, elem := range m.CodeHash {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	// Field (2) 'CodeLength'
+	if m.CodeLength != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the Metadata object into dst reusing the memory of dst
+func (m *Metadata) CopyInto(dst *Metadata) {
+	// Field (0) 'Version'
+	dst.Version = m.Version
+
+	// Field (1) 'CodeHash'
+	dst.CodeHash = append(dst.CodeHash[:0], m.CodeHash...)
+
+	// Field (2) 'CodeLength'
+	dst.CodeLength = m.CodeLength
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Metadata object
+func (m *Metadata) SSZSchemaString() string {
+	return "Container(Version:uint8,CodeHash:Vector[byte,32],CodeLength:uint16)"
+}
+
+// SSZSchema returns the layout of the fields of the Metadata object
+func (m *Metadata) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Metadata",
+		Fields: []*ssz.SchemaField{
+			{Name: "Version", Type: "uint8", Size: 1, Fixed: true},
+			{Name: "CodeHash", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+			{Name: "CodeLength", Type: "uint16", Size: 2, Fixed: true},
+		},
+	}
+}
+
 // GetTree returns tree-backing for the Metadata object
 func (m *Metadata) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
 	indx := w.Indx()
@@ -118,11 +339,23 @@ func (m *Metadata) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
-// MarshalSSZ ssz marshals the Chunk object
+func init() {
+	ssz.SchemaRegistry.Register("tests.Metadata", func() ssz.SchemaObject {
+		return new(Metadata)
+	})
+}
+
+// MarshalSSZ ssz marshals the Chunk object to a new buffer owned by the caller
 func (c *Chunk) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
 }
 
+// MarshalSSZPooled ssz marshals the Chunk object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (c *Chunk) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(c)
+}
+
 // MarshalSSZTo ssz marshals the Chunk object to a target array
 func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
@@ -142,6 +375,11 @@ func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Chunk object
 func (c *Chunk) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the Chunk object with the memory of the allocator
+func (c *Chunk) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 33 {
@@ -153,10 +391,107 @@ func (c *Chunk) UnmarshalSSZ(buf []byte) error {
 
 	// Field (1) 'Code'
 	if cap(c.Code) == 0 {
-		c.Code = make([]byte, 0, len(buf[1:33]))
+		c.Code = ssz.AllocBytes(alloc, len(buf[1:33]))[:0]
+	}
+	c.Code = append(c.Code[:0], buf[1:33]...)
+
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the Chunk object at the start of the buffer and
+// returns the number of bytes consumed
+func (c *Chunk) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if len(buf) < 33 {
+		return 0, ssz.ErrSize
+	}
+	if err := c.UnmarshalSSZ(buf[:33]); err != nil {
+		return 0, err
+	}
+	return 33, nil
+}
+
+// MarshalFieldsSSZ ssz marshals the given fields of the Chunk object
+func (c *Chunk) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return c.MarshalFieldsSSZTo(nil, fields...)
+}
+
+// MarshalFieldsSSZTo ssz marshals the given fields of the Chunk object to a target array
+func (c *Chunk) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "FIO":
+			present[0] |= 1 << 0
+		case "Code":
+			present[0] |= 1 << 1
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
+
+	// Field (0) 'FIO'
+	if present[0]&(1<<0) != 0 {
+		dst = ssz.MarshalUint8(dst, c.FIO)
+	}
+
+	// Field (1) 'Code'
+	if present[0]&(1<<1) != 0 {
+		if len(c.Code) != 32 {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, c.Code...)
 	}
-	c.Code = append(c.Code, buf[1:33]...)
 
+	return
+}
+
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the Chunk object.
+// The fields that are not present in the encoding are not modified.
+func (c *Chunk) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>2 != 0 {
+		return ssz.ErrUnknownField
+	}
+
+	// Field (0) 'FIO'
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 1 {
+			return ssz.ErrSize
+		}
+		buf := data[:1]
+		data = data[1:]
+		c.FIO = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (1) 'Code'
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 32 {
+			return ssz.ErrSize
+		}
+		buf := data[:32]
+		data = data[32:]
+		if len(buf) != 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Code) == 0 {
+			c.Code = ssz.AllocBytes(alloc, len(buf))[:0]
+		}
+		c.Code = append(c.Code[:0], buf...)
+	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
 	return err
 }
 
@@ -166,6 +501,11 @@ func (c *Chunk) SizeSSZ() (size int) {
 	return
 }
 
+// SizeSSZChunk returns the ssz encoded size in bytes of any Chunk object
+func SizeSSZChunk() int {
+	return 33
+}
+
 // HashTreeRoot ssz hashes the Chunk object
 func (c *Chunk) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -174,6 +514,7 @@ func (c *Chunk) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the Chunk object with a hasher
 func (c *Chunk) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'FIO'
 	hh.PutUint8(c.FIO)
@@ -189,6 +530,81 @@ func (c *Chunk) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MerkleProof returns the merkle branch that proves the field against the root of the Chunk object
+func (c *Chunk) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "FIO":
+		leaf = 0
+	case "Code":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'FIO'
+	hh.PutUint8(c.FIO)
+
+	// Field (1) 'Code'
+	if len(c.Code) != 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Code)
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the Chunk object are zero
+func (c *Chunk) IsZeroSSZ() bool {
+	// Field (0) 'FIO'
+	if c.FIO != 0 {
+		return false
+	}
+
+	// Field (1) 'Code'
+	for _, elem := range c.Code {
+		if elem != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CopyInto copies the Chunk object into dst reusing the memory of dst
+func (c *Chunk) CopyInto(dst *Chunk) {
+	// Field (0) 'FIO'
+	dst.FIO = c.FIO
+
+	// Field (1) 'Code'
+	dst.Code = append(dst.Code[:0], c.Code...)
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the Chunk object
+func (c *Chunk) SSZSchemaString() string {
+	return "Container(FIO:uint8,Code:Vector[byte,32])"
+}
+
+// SSZSchema returns the layout of the fields of the Chunk object
+func (c *Chunk) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "Chunk",
+		Fields: []*ssz.SchemaField{
+			{Name: "FIO", Type: "uint8", Size: 1, Fixed: true},
+			{Name: "Code", Type: "Vector[byte,32]", Size: 32, Fixed: true},
+		},
+	}
+}
+
 // GetTree returns tree-backing for the Chunk object
 func (c *Chunk) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
 	indx := w.Indx()
@@ -215,26 +631,39 @@ func (c *Chunk) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
-// MarshalSSZ ssz marshals the CodeTrieSmall object
+func init() {
+	ssz.SchemaRegistry.Register("tests.Chunk", func() ssz.SchemaObject {
+		return new(Chunk)
+	})
+}
+
+// MarshalSSZ ssz marshals the CodeTrieSmall object to a new buffer owned by the caller
 func (c *CodeTrieSmall) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
 }
 
+// MarshalSSZPooled ssz marshals the CodeTrieSmall object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (c *CodeTrieSmall) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(c)
+}
+
 // MarshalSSZTo ssz marshals the CodeTrieSmall object to a target array
 func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(39)
 
 	// Field (0) 'Metadata'
-	if c.Metadata == nil {
-		c.Metadata = new(Metadata)
-	}
-	if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
-		return
+	if c.Metadata != nil {
+		if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (1) 'Chunks'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(c.Chunks) * 33
 
 	// Field (1) 'Chunks'
@@ -253,6 +682,11 @@ func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the CodeTrieSmall object
 func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the CodeTrieSmall object with the memory of the allocator
+func (c *CodeTrieSmall) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 39 {
@@ -264,9 +698,9 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Metadata'
 	if c.Metadata == nil {
-		c.Metadata = new(Metadata)
+		c.Metadata = ssz.AllocNew[Metadata](alloc)
 	}
-	if err = c.Metadata.UnmarshalSSZ(buf[0:35]); err != nil {
+	if err = c.Metadata.UnmarshalSSZArena(buf[0:35], alloc); err != nil {
 		return err
 	}
 
@@ -275,6 +709,10 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	if o1 != 39 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
 	// Field (1) 'Chunks'
 	{
 		buf = tail[o1:]
@@ -282,12 +720,12 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		c.Chunks = make([]*Chunk, num)
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
 		for ii := 0; ii < num; ii++ {
 			if c.Chunks[ii] == nil {
-				c.Chunks[ii] = new(Chunk)
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
 			}
-			if err = c.Chunks[ii].UnmarshalSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
 				return err
 			}
 		}
@@ -295,68 +733,311 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the CodeTrieSmall object
-func (c *CodeTrieSmall) SizeSSZ() (size int) {
-	size = 39
-
-	// Field (1) 'Chunks'
-	size += len(c.Chunks) * 33
-
-	return
+// UnmarshalSSZWithOffset ssz unmarshals the CodeTrieSmall object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (c *CodeTrieSmall) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := c.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
 }
 
-// HashTreeRoot ssz hashes the CodeTrieSmall object
-func (c *CodeTrieSmall) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(c)
+// MarshalFieldsSSZ ssz marshals the given fields of the CodeTrieSmall object
+func (c *CodeTrieSmall) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return c.MarshalFieldsSSZTo(nil, fields...)
 }
 
-// HashTreeRootWith ssz hashes the CodeTrieSmall object with a hasher
-func (c *CodeTrieSmall) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalFieldsSSZTo ssz marshals the given fields of the CodeTrieSmall object to a target array
+func (c *CodeTrieSmall) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "Metadata":
+			present[0] |= 1 << 0
+		case "Chunks":
+			present[0] |= 1 << 1
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
 
 	// Field (0) 'Metadata'
-	if err = c.Metadata.HashTreeRootWith(hh); err != nil {
-		return
+	if present[0]&(1<<0) != 0 {
+		if c.Metadata != nil {
+			if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}
 	}
 
 	// Field (1) 'Chunks'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(c.Chunks))
-		if num > 4 {
-			err = ssz.ErrIncorrectListSize
+	if present[0]&(1<<1) != 0 {
+		offset := 0
+		offset += len(c.Chunks) * 33
+		if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+			return
+		}
+		if len(c.Chunks) > 4 {
+			err = ssz.ErrListTooBig
 			return
 		}
-		for i := uint64(0); i < num; i++ {
-			if err = c.Chunks[i].HashTreeRootWith(hh); err != nil {
+		for ii := 0; ii < len(c.Chunks); ii++ {
+			if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 4)
 	}
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree returns tree-backing for the CodeTrieSmall object
-func (c *CodeTrieSmall) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
-	indx := w.Indx()
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the CodeTrieSmall object.
+// The fields that are not present in the encoding are not modified.
+func (c *CodeTrieSmall) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>2 != 0 {
+		return ssz.ErrUnknownField
+	}
 
 	// Field (0) 'Metadata'
-	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
-		return err
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 35 {
+			return ssz.ErrSize
+		}
+		buf := data[:35]
+		data = data[35:]
+		if c.Metadata == nil {
+			c.Metadata = ssz.AllocNew[Metadata](alloc)
+		}
+		if err = c.Metadata.UnmarshalSSZArena(buf, alloc); err != nil {
+			return err
+		}
 	}
 
 	// Field (1) 'Chunks'
-	{
-		subIdx := w.Indx()
-		num := len(c.Chunks)
-		if num > 4 {
-			err = ssz.ErrIncorrectListSize
-			return err
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 4 {
+			return ssz.ErrSize
 		}
-		for i := 0; i < num; i++ {
+		size := ssz.ReadOffset(data)
+		if data = data[4:]; size > uint64(len(data)) {
+			return ssz.ErrOffset
+		}
+		buf := data[:size]
+		data = data[size:]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return err
+		}
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CodeTrieSmall object
+func (c *CodeTrieSmall) SizeSSZ() (size int) {
+	size = 39
+
+	// Field (1) 'Chunks'
+	size += len(c.Chunks) * 33
+
+	return
+}
+
+// OffsetsSSZ returns the offsets of the dynamic fields of the CodeTrieSmall object
+// written by MarshalSSZTo
+func (c *CodeTrieSmall) OffsetsSSZ() []uint32 {
+	offsets := make([]uint32, 0, 1)
+	offset := 39
+	// Offset (1) 'Chunks'
+	offsets = append(offsets, uint32(offset))
+	return offsets
+}
+
+// HashTreeRoot ssz hashes the CodeTrieSmall object
+func (c *CodeTrieSmall) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CodeTrieSmall object with a hasher
+func (c *CodeTrieSmall) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(2)
+
+	// Field (0) 'Metadata'
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the CodeTrieSmall object
+func (c *CodeTrieSmall) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Metadata":
+		leaf = 0
+	case "Chunks":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the CodeTrieSmall object are zero
+func (c *CodeTrieSmall) IsZeroSSZ() bool {
+	// Field (0) 'Metadata'
+	if c.Metadata != nil && !c.Metadata.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Chunks'
+	if len(c.Chunks) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the CodeTrieSmall object into dst reusing the memory of dst
+func (c *CodeTrieSmall) CopyInto(dst *CodeTrieSmall) {
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		dst.Metadata = nil
+	} else {
+		if dst.Metadata == nil {
+			dst.Metadata = new(Metadata)
+		}
+		c.Metadata.CopyInto(dst.Metadata)
+	}
+
+	// Field (1) 'Chunks'
+	if cap(dst.Chunks) < len(c.Chunks) {
+		dst.Chunks = make([]*Chunk, len(c.Chunks))
+	} else {
+		dst.Chunks = dst.Chunks[:len(c.Chunks)]
+	}
+	for ii := range c.Chunks {
+		if c.Chunks[ii] == nil {
+			dst.Chunks[ii] = nil
+		} else {
+			if dst.Chunks[ii] == nil {
+				dst.Chunks[ii] = new(Chunk)
+			}
+			c.Chunks[ii].CopyInto(dst.Chunks[ii])
+		}
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the CodeTrieSmall object
+func (c *CodeTrieSmall) SSZSchemaString() string {
+	return "Container(Metadata:Metadata,Chunks:List[Chunk,4])"
+}
+
+// SSZSchema returns the layout of the fields of the CodeTrieSmall object
+func (c *CodeTrieSmall) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "CodeTrieSmall",
+		Fields: []*ssz.SchemaField{
+			{Name: "Metadata", Type: "Metadata", Size: 35, Fixed: true},
+			{Name: "Chunks", Type: "List[Chunk,4]"},
+		},
+	}
+}
+
+// GetTree returns tree-backing for the CodeTrieSmall object
+func (c *CodeTrieSmall) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
+	indx := w.Indx()
+
+	// Field (0) 'Metadata'
+	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIdx := w.Indx()
+		num := len(c.Chunks)
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for i := 0; i < num; i++ {
 			n, err := c.Chunks[i].GetTree()
 			if err != nil {
 				return err
@@ -378,26 +1059,39 @@ func (c *CodeTrieSmall) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
-// MarshalSSZ ssz marshals the CodeTrieBig object
+func init() {
+	ssz.SchemaRegistry.Register("tests.CodeTrieSmall", func() ssz.SchemaObject {
+		return new(CodeTrieSmall)
+	})
+}
+
+// MarshalSSZ ssz marshals the CodeTrieBig object to a new buffer owned by the caller
 func (c *CodeTrieBig) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
 }
 
+// MarshalSSZPooled ssz marshals the CodeTrieBig object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (c *CodeTrieBig) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(c)
+}
+
 // MarshalSSZTo ssz marshals the CodeTrieBig object to a target array
 func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(39)
 
 	// Field (0) 'Metadata'
-	if c.Metadata == nil {
-		c.Metadata = new(Metadata)
-	}
-	if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
-		return
+	if c.Metadata != nil {
+		if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (1) 'Chunks'
-	dst = ssz.WriteOffset(dst, offset)
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
 	offset += len(c.Chunks) * 33
 
 	// Field (1) 'Chunks'
@@ -416,6 +1110,11 @@ func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the CodeTrieBig object
 func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the CodeTrieBig object with the memory of the allocator
+func (c *CodeTrieBig) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 39 {
@@ -427,9 +1126,9 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 
 	// Field (0) 'Metadata'
 	if c.Metadata == nil {
-		c.Metadata = new(Metadata)
+		c.Metadata = ssz.AllocNew[Metadata](alloc)
 	}
-	if err = c.Metadata.UnmarshalSSZ(buf[0:35]); err != nil {
+	if err = c.Metadata.UnmarshalSSZArena(buf[0:35], alloc); err != nil {
 		return err
 	}
 
@@ -438,6 +1137,10 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	if o1 != 39 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
 	// Field (1) 'Chunks'
 	{
 		buf = tail[o1:]
@@ -445,16 +1148,139 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 		if err != nil {
 			return err
 		}
-		c.Chunks = make([]*Chunk, num)
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the CodeTrieBig object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (c *CodeTrieBig) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := c.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// MarshalFieldsSSZ ssz marshals the given fields of the CodeTrieBig object
+func (c *CodeTrieBig) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return c.MarshalFieldsSSZTo(nil, fields...)
+}
+
+// MarshalFieldsSSZTo ssz marshals the given fields of the CodeTrieBig object to a target array
+func (c *CodeTrieBig) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "Metadata":
+			present[0] |= 1 << 0
+		case "Chunks":
+			present[0] |= 1 << 1
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
+
+	// Field (0) 'Metadata'
+	if present[0]&(1<<0) != 0 {
+		if c.Metadata != nil {
+			if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}
+	}
+
+	// Field (1) 'Chunks'
+	if present[0]&(1<<1) != 0 {
+		offset := 0
+		offset += len(c.Chunks) * 33
+		if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+			return
+		}
+		if len(c.Chunks) > 1024 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		for ii := 0; ii < len(c.Chunks); ii++ {
+			if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the CodeTrieBig object.
+// The fields that are not present in the encoding are not modified.
+func (c *CodeTrieBig) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>2 != 0 {
+		return ssz.ErrUnknownField
+	}
+
+	// Field (0) 'Metadata'
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 35 {
+			return ssz.ErrSize
+		}
+		buf := data[:35]
+		data = data[35:]
+		if c.Metadata == nil {
+			c.Metadata = ssz.AllocNew[Metadata](alloc)
+		}
+		if err = c.Metadata.UnmarshalSSZArena(buf, alloc); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Chunks'
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 4 {
+			return ssz.ErrSize
+		}
+		size := ssz.ReadOffset(data)
+		if data = data[4:]; size > uint64(len(data)) {
+			return ssz.ErrOffset
+		}
+		buf := data[:size]
+		data = data[size:]
+		num, err := ssz.DivideInt2(len(buf), 33, 1024)
+		if err != nil {
+			return err
+		}
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
 		for ii := 0; ii < num; ii++ {
 			if c.Chunks[ii] == nil {
-				c.Chunks[ii] = new(Chunk)
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
 			}
-			if err = c.Chunks[ii].UnmarshalSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
 	return err
 }
 
@@ -468,6 +1294,16 @@ func (c *CodeTrieBig) SizeSSZ() (size int) {
 	return
 }
 
+// OffsetsSSZ returns the offsets of the dynamic fields of the CodeTrieBig object
+// written by MarshalSSZTo
+func (c *CodeTrieBig) OffsetsSSZ() []uint32 {
+	offsets := make([]uint32, 0, 1)
+	offset := 39
+	// Offset (1) 'Chunks'
+	offsets = append(offsets, uint32(offset))
+	return offsets
+}
+
 // HashTreeRoot ssz hashes the CodeTrieBig object
 func (c *CodeTrieBig) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -476,10 +1312,13 @@ func (c *CodeTrieBig) HashTreeRoot() ([32]byte, error) {
 // HashTreeRootWith ssz hashes the CodeTrieBig object with a hasher
 func (c *CodeTrieBig) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
+	hh.Reserve(2)
 
 	// Field (0) 'Metadata'
-	if err = c.Metadata.HashTreeRootWith(hh); err != nil {
-		return
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
 	}
 
 	// Field (1) 'Chunks'
@@ -490,8 +1329,8 @@ func (c *CodeTrieBig) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for i := uint64(0); i < num; i++ {
-			if err = c.Chunks[i].HashTreeRootWith(hh); err != nil {
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
 				return
 			}
 		}
@@ -502,41 +1341,1176 @@ func (c *CodeTrieBig) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// GetTree returns tree-backing for the CodeTrieBig object
-func (c *CodeTrieBig) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
-	indx := w.Indx()
+// MerkleProof returns the merkle branch that proves the field against the root of the CodeTrieBig object
+func (c *CodeTrieBig) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Metadata":
+		leaf = 0
+	case "Chunks":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
 
 	// Field (0) 'Metadata'
-	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
-		return err
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
 	}
 
 	// Field (1) 'Chunks'
 	{
-		subIdx := w.Indx()
-		num := len(c.Chunks)
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
 		if num > 1024 {
 			err = ssz.ErrIncorrectListSize
-			return err
+			return
 		}
-		for i := 0; i < num; i++ {
-			n, err := c.Chunks[i].GetTree()
-			if err != nil {
-				return err
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
-			w.AddNode(n)
 		}
-		w.CommitWithMixin(subIdx, num, 1024)
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
 	}
 
-	w.Commit(indx)
-	return nil
+	return hh.Branch(indx, leaf)
+
 }
 
-func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
-	w := &ssz.Wrapper{}
-	if err := c.GetTreeWithWrapper(w); err != nil {
-		return nil, err
+// IsZeroSSZ returns true if all the fields of the CodeTrieBig object are zero
+func (c *CodeTrieBig) IsZeroSSZ() bool {
+	// Field (0) 'Metadata'
+	if c.Metadata != nil && !c.Metadata.IsZeroSSZ() {
+		return false
 	}
-	return w.Node(), nil
+
+	// Field (1) 'Chunks'
+	if len(c.Chunks) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the CodeTrieBig object into dst reusing the memory of dst
+func (c *CodeTrieBig) CopyInto(dst *CodeTrieBig) {
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		dst.Metadata = nil
+	} else {
+		if dst.Metadata == nil {
+			dst.Metadata = new(Metadata)
+		}
+		c.Metadata.CopyInto(dst.Metadata)
+	}
+
+	// Field (1) 'Chunks'
+	if cap(dst.Chunks) < len(c.Chunks) {
+		dst.Chunks = make([]*Chunk, len(c.Chunks))
+	} else {
+		dst.Chunks = dst.Chunks[:len(c.Chunks)]
+	}
+	for ii := range c.Chunks {
+		if c.Chunks[ii] == nil {
+			dst.Chunks[ii] = nil
+		} else {
+			if dst.Chunks[ii] == nil {
+				dst.Chunks[ii] = new(Chunk)
+			}
+			c.Chunks[ii].CopyInto(dst.Chunks[ii])
+		}
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the CodeTrieBig object
+func (c *CodeTrieBig) SSZSchemaString() string {
+	return "Container(Metadata:Metadata,Chunks:List[Chunk,1024])"
+}
+
+// SSZSchema returns the layout of the fields of the CodeTrieBig object
+func (c *CodeTrieBig) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "CodeTrieBig",
+		Fields: []*ssz.SchemaField{
+			{Name: "Metadata", Type: "Metadata", Size: 35, Fixed: true},
+			{Name: "Chunks", Type: "List[Chunk,1024]"},
+		},
+	}
+}
+
+// GetTree returns tree-backing for the CodeTrieBig object
+func (c *CodeTrieBig) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
+	indx := w.Indx()
+
+	// Field (0) 'Metadata'
+	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIdx := w.Indx()
+		num := len(c.Chunks)
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for i := 0; i < num; i++ {
+			n, err := c.Chunks[i].GetTree()
+			if err != nil {
+				return err
+			}
+			w.AddNode(n)
+		}
+		w.CommitWithMixin(subIdx, num, 1024)
+	}
+
+	w.Commit(indx)
+	return nil
+}
+
+func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
+	w := &ssz.Wrapper{}
+	if err := c.GetTreeWithWrapper(w); err != nil {
+		return nil, err
+	}
+	return w.Node(), nil
+}
+
+func init() {
+	ssz.SchemaRegistry.Register("tests.CodeTrieBig", func() ssz.SchemaObject {
+		return new(CodeTrieBig)
+	})
+}
+
+// MarshalSSZ ssz marshals the CachedTrie object to a new buffer owned by the caller
+func (c *CachedTrie) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZPooled ssz marshals the CachedTrie object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (c *CachedTrie) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(c)
+}
+
+// MarshalSSZTo ssz marshals the CachedTrie object to a target array
+func (c *CachedTrie) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata != nil {
+		if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Offset (1) 'Chunks'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(c.Chunks) * 33
+
+	// Field (1) 'Chunks'
+	if len(c.Chunks) > 4 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CachedTrie object
+func (c *CachedTrie) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the CachedTrie object with the memory of the allocator
+func (c *CachedTrie) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = ssz.AllocNew[Metadata](alloc)
+	}
+	if err = c.Metadata.UnmarshalSSZArena(buf[0:35], alloc); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 39 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return err
+		}
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Cached tree
+	c.Tree = nil
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the CachedTrie object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (c *CachedTrie) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := c.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// MarshalFieldsSSZ ssz marshals the given fields of the CachedTrie object
+func (c *CachedTrie) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return c.MarshalFieldsSSZTo(nil, fields...)
+}
+
+// MarshalFieldsSSZTo ssz marshals the given fields of the CachedTrie object to a target array
+func (c *CachedTrie) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "Metadata":
+			present[0] |= 1 << 0
+		case "Chunks":
+			present[0] |= 1 << 1
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
+
+	// Field (0) 'Metadata'
+	if present[0]&(1<<0) != 0 {
+		if c.Metadata != nil {
+			if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}
+	}
+
+	// Field (1) 'Chunks'
+	if present[0]&(1<<1) != 0 {
+		offset := 0
+		offset += len(c.Chunks) * 33
+		if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+			return
+		}
+		if len(c.Chunks) > 4 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		for ii := 0; ii < len(c.Chunks); ii++ {
+			if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the CachedTrie object.
+// The fields that are not present in the encoding are not modified.
+func (c *CachedTrie) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>2 != 0 {
+		return ssz.ErrUnknownField
+	}
+
+	// Field (0) 'Metadata'
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 35 {
+			return ssz.ErrSize
+		}
+		buf := data[:35]
+		data = data[35:]
+		if c.Metadata == nil {
+			c.Metadata = ssz.AllocNew[Metadata](alloc)
+		}
+		if err = c.Metadata.UnmarshalSSZArena(buf, alloc); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Chunks'
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 4 {
+			return ssz.ErrSize
+		}
+		size := ssz.ReadOffset(data)
+		if data = data[4:]; size > uint64(len(data)) {
+			return ssz.ErrOffset
+		}
+		buf := data[:size]
+		data = data[size:]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return err
+		}
+		c.Chunks = ssz.AllocExtend(alloc, c.Chunks, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
+	// Cached tree
+	c.Tree = nil
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CachedTrie object
+func (c *CachedTrie) SizeSSZ() (size int) {
+	size = 39
+
+	// Field (1) 'Chunks'
+	size += len(c.Chunks) * 33
+
+	return
+}
+
+// OffsetsSSZ returns the offsets of the dynamic fields of the CachedTrie object
+// written by MarshalSSZTo
+func (c *CachedTrie) OffsetsSSZ() []uint32 {
+	offsets := make([]uint32, 0, 1)
+	offset := 39
+	// Offset (1) 'Chunks'
+	offsets = append(offsets, uint32(offset))
+	return offsets
+}
+
+// HashTreeRoot ssz hashes the CachedTrie object
+func (c *CachedTrie) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CachedTrie object with a hasher
+func (c *CachedTrie) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	if c.Tree != nil {
+		// the root of the cached tree
+		hh.AppendBytes32(c.Tree.Hash())
+		return
+	}
+	indx := hh.Index()
+	hh.Reserve(2)
+
+	// Field (0) 'Metadata'
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the CachedTrie object
+func (c *CachedTrie) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Metadata":
+		leaf = 0
+	case "Chunks":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if c.Metadata != nil {
+		if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the CachedTrie object are zero
+func (c *CachedTrie) IsZeroSSZ() bool {
+	// Field (0) 'Metadata'
+	if c.Metadata != nil && !c.Metadata.IsZeroSSZ() {
+		return false
+	}
+
+	// Field (1) 'Chunks'
+	if len(c.Chunks) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the CachedTrie object into dst reusing the memory of dst
+func (c *CachedTrie) CopyInto(dst *CachedTrie) {
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		dst.Metadata = nil
+	} else {
+		if dst.Metadata == nil {
+			dst.Metadata = new(Metadata)
+		}
+		c.Metadata.CopyInto(dst.Metadata)
+	}
+
+	// Field (1) 'Chunks'
+	if cap(dst.Chunks) < len(c.Chunks) {
+		dst.Chunks = make([]*Chunk, len(c.Chunks))
+	} else {
+		dst.Chunks = dst.Chunks[:len(c.Chunks)]
+	}
+	for ii := range c.Chunks {
+		if c.Chunks[ii] == nil {
+			dst.Chunks[ii] = nil
+		} else {
+			if dst.Chunks[ii] == nil {
+				dst.Chunks[ii] = new(Chunk)
+			}
+			c.Chunks[ii].CopyInto(dst.Chunks[ii])
+		}
+	}
+
+	// Cached tree
+	dst.Tree = nil
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the CachedTrie object
+func (c *CachedTrie) SSZSchemaString() string {
+	return "Container(Metadata:Metadata,Chunks:List[Chunk,4])"
+}
+
+// SSZSchema returns the layout of the fields of the CachedTrie object
+func (c *CachedTrie) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "CachedTrie",
+		Fields: []*ssz.SchemaField{
+			{Name: "Metadata", Type: "Metadata", Size: 35, Fixed: true},
+			{Name: "Chunks", Type: "List[Chunk,4]"},
+		},
+	}
+}
+
+// GetTree returns tree-backing for the CachedTrie object
+func (c *CachedTrie) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
+	indx := w.Indx()
+
+	// Field (0) 'Metadata'
+	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIdx := w.Indx()
+		num := len(c.Chunks)
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for i := 0; i < num; i++ {
+			n, err := c.Chunks[i].GetTree()
+			if err != nil {
+				return err
+			}
+			w.AddNode(n)
+		}
+		w.CommitWithMixin(subIdx, num, 4)
+	}
+
+	w.Commit(indx)
+	return nil
+}
+
+func (c *CachedTrie) GetTree() (*ssz.Node, error) {
+	w := &ssz.Wrapper{}
+	if err := c.GetTreeWithWrapper(w); err != nil {
+		return nil, err
+	}
+	c.Tree = w.Node()
+	return w.Node(), nil
+}
+
+// UpdateTree recomputes the subtrees of the changed fields in the cached tree of the CachedTrie object
+func (c *CachedTrie) UpdateTree(fields ...string) (err error) {
+	if c.Tree == nil {
+		_, err = c.GetTree()
+		return
+	}
+	for _, field := range fields {
+		w := &ssz.Wrapper{}
+		var indx int
+		switch field {
+		case "Metadata":
+			indx = 2
+			if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
+				return err
+			}
+		case "Chunks":
+			indx = 3
+			{
+				subIdx := w.Indx()
+				num := len(c.Chunks)
+				if num > 4 {
+					err = ssz.ErrIncorrectListSize
+					return err
+				}
+				for i := 0; i < num; i++ {
+					n, err := c.Chunks[i].GetTree()
+					if err != nil {
+						return err
+					}
+					w.AddNode(n)
+				}
+				w.CommitWithMixin(subIdx, num, 4)
+			}
+		default:
+			return ssz.ErrUnknownField
+		}
+		if err = c.Tree.Replace(indx, w.Node()); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func init() {
+	ssz.SchemaRegistry.Register("tests.CachedTrie", func() ssz.SchemaObject {
+		return new(CachedTrie)
+	})
+}
+
+// MarshalSSZ ssz marshals the BlobTrie object to a new buffer owned by the caller
+func (b *BlobTrie) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZPooled ssz marshals the BlobTrie object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (b *BlobTrie) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobTrie object to a target array
+func (b *BlobTrie) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Data'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(b.Data)
+
+	// Offset (1) 'Blobs'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		offset += 4
+		offset += len(b.Blobs[ii])
+	}
+
+	// Field (0) 'Data'
+	if len(b.Data) > 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, b.Data...)
+
+	// Field (1) 'Blobs'
+	if len(b.Blobs) > 4 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(b.Blobs)
+		for ii := 0; ii < len(b.Blobs); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
+			offset += len(b.Blobs[ii])
+		}
+	}
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		if len(b.Blobs[ii]) > 40 {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, b.Blobs[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobTrie object
+func (b *BlobTrie) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the BlobTrie object with the memory of the allocator
+func (b *BlobTrie) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Data'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Blobs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Data'
+	{
+		buf = tail[o0:o1]
+		if uint64(len(buf)) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.Data) == 0 {
+			b.Data = ssz.AllocBytes(alloc, len(buf))[:0]
+		}
+		b.Data = append(b.Data[:0], buf...)
+	}
+
+	// Field (1) 'Blobs'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		b.Blobs = ssz.AllocSlice[[]byte](alloc, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if uint64(len(buf)) > 40 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.Blobs[indx]) == 0 {
+				b.Blobs[indx] = ssz.AllocBytes(alloc, len(buf))[:0]
+			}
+			b.Blobs[indx] = append(b.Blobs[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the BlobTrie object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (b *BlobTrie) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := b.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// MarshalFieldsSSZ ssz marshals the given fields of the BlobTrie object
+func (b *BlobTrie) MarshalFieldsSSZ(fields ...string) ([]byte, error) {
+	return b.MarshalFieldsSSZTo(nil, fields...)
+}
+
+// MarshalFieldsSSZTo ssz marshals the given fields of the BlobTrie object to a target array
+func (b *BlobTrie) MarshalFieldsSSZTo(buf []byte, fields ...string) (dst []byte, err error) {
+	present := make([]byte, 1)
+	for _, field := range fields {
+		switch field {
+		case "Data":
+			present[0] |= 1 << 0
+		case "Blobs":
+			present[0] |= 1 << 1
+		default:
+			err = ssz.ErrUnknownField
+			return
+		}
+	}
+	dst = append(buf, present...)
+
+	// Field (0) 'Data'
+	if present[0]&(1<<0) != 0 {
+		offset := 0
+		offset += len(b.Data)
+		if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+			return
+		}
+		if len(b.Data) > 64 {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, b.Data...)
+	}
+
+	// Field (1) 'Blobs'
+	if present[0]&(1<<1) != 0 {
+		offset := 0
+		for ii := 0; ii < len(b.Blobs); ii++ {
+			offset += 4
+			offset += len(b.Blobs[ii])
+		}
+		if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+			return
+		}
+		if len(b.Blobs) > 4 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		{
+			offset = 4 * len(b.Blobs)
+			for ii := 0; ii < len(b.Blobs); ii++ {
+				if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+					return
+				}
+				offset += len(b.Blobs[ii])
+			}
+		}
+		for ii := 0; ii < len(b.Blobs); ii++ {
+			if len(b.Blobs[ii]) > 40 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			dst = append(dst, b.Blobs[ii]...)
+		}
+	}
+
+	return
+}
+
+// UnmarshalFieldsSSZ ssz unmarshals the fields encoded with MarshalFieldsSSZ into the BlobTrie object.
+// The fields that are not present in the encoding are not modified.
+func (b *BlobTrie) UnmarshalFieldsSSZ(data []byte) error {
+	var err error
+	var alloc ssz.Allocator
+	if len(data) < 1 {
+		return ssz.ErrSize
+	}
+	present := data[:1]
+	data = data[1:]
+
+	if present[0]>>2 != 0 {
+		return ssz.ErrUnknownField
+	}
+
+	// Field (0) 'Data'
+	if present[0]&(1<<0) != 0 {
+		if len(data) < 4 {
+			return ssz.ErrSize
+		}
+		size := ssz.ReadOffset(data)
+		if data = data[4:]; size > uint64(len(data)) {
+			return ssz.ErrOffset
+		}
+		buf := data[:size]
+		data = data[size:]
+		if uint64(len(buf)) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.Data) == 0 {
+			b.Data = ssz.AllocBytes(alloc, len(buf))[:0]
+		}
+		b.Data = append(b.Data[:0], buf...)
+	}
+
+	// Field (1) 'Blobs'
+	if present[0]&(1<<1) != 0 {
+		if len(data) < 4 {
+			return ssz.ErrSize
+		}
+		size := ssz.ReadOffset(data)
+		if data = data[4:]; size > uint64(len(data)) {
+			return ssz.ErrOffset
+		}
+		buf := data[:size]
+		data = data[size:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		b.Blobs = ssz.AllocSlice[[]byte](alloc, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if uint64(len(buf)) > 40 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.Blobs[indx]) == 0 {
+				b.Blobs[indx] = ssz.AllocBytes(alloc, len(buf))[:0]
+			}
+			b.Blobs[indx] = append(b.Blobs[indx][:0], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data) != 0 {
+		return ssz.ErrSize
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobTrie object
+func (b *BlobTrie) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Data'
+	size += len(b.Data)
+
+	// Field (1) 'Blobs'
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		size += 4
+		size += len(b.Blobs[ii])
+	}
+
+	return
+}
+
+// OffsetsSSZ returns the offsets of the dynamic fields of the BlobTrie object
+// written by MarshalSSZTo
+func (b *BlobTrie) OffsetsSSZ() []uint32 {
+	offsets := make([]uint32, 0, 2)
+	offset := 8
+	// Offset (0) 'Data'
+	offsets = append(offsets, uint32(offset))
+	offset += len(b.Data)
+
+	// Offset (1) 'Blobs'
+	offsets = append(offsets, uint32(offset))
+	return offsets
+}
+
+// HashTreeRoot ssz hashes the BlobTrie object
+func (b *BlobTrie) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobTrie object with a hasher
+func (b *BlobTrie) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(2)
+
+	// Field (0) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Data))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	// Field (1) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Blobs))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Blobs {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 40 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (40+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the BlobTrie object
+func (b *BlobTrie) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Data":
+		leaf = 0
+	case "Blobs":
+		leaf = 1
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Data))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(b.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	// Field (1) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Blobs))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Blobs {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 40 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (40+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the BlobTrie object are zero
+func (b *BlobTrie) IsZeroSSZ() bool {
+	// Field (0) 'Data'
+	if len(b.Data) != 0 {
+		return false
+	}
+
+	// Field (1) 'Blobs'
+	if len(b.Blobs) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the BlobTrie object into dst reusing the memory of dst
+func (b *BlobTrie) CopyInto(dst *BlobTrie) {
+	// Field (0) 'Data'
+	dst.Data = append(dst.Data[:0], b.Data...)
+
+	// Field (1) 'Blobs'
+	if cap(dst.Blobs) < len(b.Blobs) {
+		dst.Blobs = make([][]byte, len(b.Blobs))
+	} else {
+		dst.Blobs = dst.Blobs[:len(b.Blobs)]
+	}
+	for ii := range b.Blobs {
+		dst.Blobs[ii] = append(dst.Blobs[ii][:0], b.Blobs[ii]...)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the BlobTrie object
+func (b *BlobTrie) SSZSchemaString() string {
+	return "Container(Data:List[byte,64],Blobs:List[List[byte,40],4])"
+}
+
+// SSZSchema returns the layout of the fields of the BlobTrie object
+func (b *BlobTrie) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "BlobTrie",
+		Fields: []*ssz.SchemaField{
+			{Name: "Data", Type: "List[byte,64]"},
+			{Name: "Blobs", Type: "List[List[byte,40],4]"},
+		},
+	}
+}
+
+// GetTree returns tree-backing for the BlobTrie object
+func (b *BlobTrie) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
+	indx := w.Indx()
+
+	// Field (0) 'Data'
+	{
+		elemIdx := w.Indx()
+		byteLen := len(b.Data)
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for jj := 0; jj < byteLen; jj += 32 {
+			end := jj + 32
+			if end > byteLen {
+				end = byteLen
+			}
+			// the capacity of the chunk is limited since the last one is padded with zeros
+			w.AddBytes(b.Data[jj:end:end])
+		}
+		w.CommitWithMixin(elemIdx, byteLen, 2)
+	}
+
+	// Field (1) 'Blobs'
+	{
+		subIdx := w.Indx()
+		num := len(b.Blobs)
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return err
+		}
+		for _, elem := range b.Blobs {
+			{
+				elemIdx := w.Indx()
+				byteLen := len(elem)
+				if byteLen > 40 {
+					err = ssz.ErrIncorrectListSize
+					return err
+				}
+				for jj := 0; jj < byteLen; jj += 32 {
+					end := jj + 32
+					if end > byteLen {
+						end = byteLen
+					}
+					// the capacity of the chunk is limited since the last one is padded with zeros
+					w.AddBytes(elem[jj:end:end])
+				}
+				w.CommitWithMixin(elemIdx, byteLen, 2)
+			}
+		}
+		w.CommitWithMixin(subIdx, num, 4)
+	}
+
+	w.Commit(indx)
+	return nil
+}
+
+func (b *BlobTrie) GetTree() (*ssz.Node, error) {
+	w := &ssz.Wrapper{}
+	if err := b.GetTreeWithWrapper(w); err != nil {
+		return nil, err
+	}
+	return w.Node(), nil
+}
+
+func init() {
+	ssz.SchemaRegistry.Register("tests.BlobTrie", func() ssz.SchemaObject {
+		return new(BlobTrie)
+	})
 }
@@ -0,0 +1,98 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 7de0a121b641cdf11a8a07f7ff6ce1b566e83a8406b56f4c058a798fb2317229
+package tests
+
+import (
+	"math/rand"
+)
+
+// PopulateSSZ fills the Metadata object with random values, the lists
+// are filled up to their limit
+func (m *Metadata) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'Version'
+	m.Version = uint8(rnd.Uint32())
+
+	// Field (1) 'CodeHash'
+	m.CodeHash = make([]byte, 32)
+	rnd.Read(m.CodeHash)
+
+	// Field (2) 'CodeLength'
+	m.CodeLength = uint16(rnd.Uint32())
+
+}
+
+// PopulateSSZ fills the Chunk object with random values, the lists
+// are filled up to their limit
+func (c *Chunk) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'FIO'
+	c.FIO = uint8(rnd.Uint32())
+
+	// Field (1) 'Code'
+	c.Code = make([]byte, 32)
+	rnd.Read(c.Code)
+
+}
+
+// PopulateSSZ fills the CodeTrieSmall object with random values, the lists
+// are filled up to their limit
+func (c *CodeTrieSmall) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'Metadata'
+	c.Metadata = new(Metadata)
+	c.Metadata.PopulateSSZ(rnd)
+
+	// Field (1) 'Chunks'
+	c.Chunks = make([]*Chunk, 4)
+	for ii := range c.Chunks {
+		c.Chunks[ii] = new(Chunk)
+		c.Chunks[ii].PopulateSSZ(rnd)
+	}
+
+}
+
+// PopulateSSZ fills the CodeTrieBig object with random values, the lists
+// are filled up to their limit
+func (c *CodeTrieBig) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'Metadata'
+	c.Metadata = new(Metadata)
+	c.Metadata.PopulateSSZ(rnd)
+
+	// Field (1) 'Chunks'
+	c.Chunks = make([]*Chunk, 1024)
+	for ii := range c.Chunks {
+		c.Chunks[ii] = new(Chunk)
+		c.Chunks[ii].PopulateSSZ(rnd)
+	}
+
+}
+
+// PopulateSSZ fills the CachedTrie object with random values, the lists
+// are filled up to their limit
+func (c *CachedTrie) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'Metadata'
+	c.Metadata = new(Metadata)
+	c.Metadata.PopulateSSZ(rnd)
+
+	// Field (1) 'Chunks'
+	c.Chunks = make([]*Chunk, 4)
+	for ii := range c.Chunks {
+		c.Chunks[ii] = new(Chunk)
+		c.Chunks[ii].PopulateSSZ(rnd)
+	}
+
+}
+
+// PopulateSSZ fills the BlobTrie object with random values, the lists
+// are filled up to their limit
+func (b *BlobTrie) PopulateSSZ(rnd *rand.Rand) {
+	// Field (0) 'Data'
+	b.Data = make([]byte, 64)
+	rnd.Read(b.Data)
+
+	// Field (1) 'Blobs'
+	b.Blobs = make([][]byte, 4)
+	for ii := range b.Blobs {
+		b.Blobs[ii] = make([]byte, 40)
+		rnd.Read(b.Blobs[ii])
+	}
+
+}
@@ -7,8 +7,8 @@ import (
 	"testing"
 	"time"
 
-	ssz "github.com/ferranbt/fastssz"
 	"github.com/minio/sha256-simd"
+	ssz "github.com/photon-storage/fastssz"
 )
 
 func TestVerifyMetadataProof(t *testing.T) {
@@ -480,3 +480,63 @@ func parseStringSlice(slice []string) ([][]byte, error) {
 	}
 	return res, nil
 }
+
+func TestCachedTrieTree(t *testing.T) {
+	md := &Metadata{Version: 1, CodeLength: 2, CodeHash: make([]byte, 32)}
+	chunks := []*Chunk{{FIO: 0, Code: make([]byte, 32)}, {FIO: 1, Code: make([]byte, 32)}}
+	cached := &CachedTrie{Metadata: md, Chunks: chunks}
+
+	// the same fields as CodeTrieSmall without the cached tree
+	rootOf := func() [32]byte {
+		root, err := (&CodeTrieSmall{Metadata: md, Chunks: cached.Chunks}).HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+
+	tree, err := cached.GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.Tree != tree {
+		t.Fatal("expected the tree to be cached")
+	}
+	root := rootOf()
+	if found, err := cached.HashTreeRoot(); err != nil || found != root {
+		t.Fatalf("bad root of the cached tree %x: %v", found, err)
+	}
+
+	// the cached root does not change until the tree is updated
+	cached.Chunks = append(cached.Chunks, &Chunk{FIO: 2, Code: make([]byte, 32)})
+	if found, _ := cached.HashTreeRoot(); found != root {
+		t.Fatal("expected the root of the cached tree")
+	}
+	if err := cached.UpdateTree("Chunks"); err != nil {
+		t.Fatal(err)
+	}
+	root = rootOf()
+	if found, err := cached.HashTreeRoot(); err != nil || found != root {
+		t.Fatalf("bad root of the updated tree %x: %v", found, err)
+	}
+	if err := cached.UpdateTree("Unknown"); err != ssz.ErrUnknownField {
+		t.Fatalf("expected ErrUnknownField but found %v", err)
+	}
+
+	// the tree is discarded when the fields are decoded or copied
+	buf, err := cached.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cached.UnmarshalSSZ(buf); err != nil || cached.Tree != nil {
+		t.Fatalf("expected the tree to be discarded after decoding: %v", err)
+	}
+	dst := &CachedTrie{Tree: tree}
+	cached.CopyInto(dst)
+	if dst.Tree != nil {
+		t.Fatal("expected the tree of the copy to be discarded")
+	}
+	if found, err := dst.HashTreeRoot(); err != nil || found != root {
+		t.Fatalf("bad root of the copy %x: %v", found, err)
+	}
+}
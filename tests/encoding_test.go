@@ -0,0 +1,2979 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	ssz "github.com/photon-storage/fastssz"
+	"github.com/photon-storage/fastssz/tests/external"
+)
+
+func TestIsZeroSSZ(t *testing.T) {
+	cases := []struct {
+		name string
+		obj  interface{ IsZeroSSZ() bool }
+		zero bool
+	}{
+		{"empty metadata", &Metadata{}, true},
+		{"empty code hash", &Metadata{CodeHash: []byte{}}, true},
+		{"version", &Metadata{Version: 1}, false},
+		{"zero code hash", &Metadata{CodeHash: make([]byte, 32)}, true},
+		{"code hash", &Metadata{CodeHash: append(make([]byte, 31), 1)}, false},
+		{"nil metadata", &CodeTrieSmall{}, true},
+		{"zero metadata", &CodeTrieSmall{Metadata: &Metadata{}}, true},
+		{"empty chunks", &CodeTrieSmall{Chunks: []*Chunk{}}, true},
+		{"metadata", &CodeTrieSmall{Metadata: &Metadata{CodeLength: 1}}, false},
+		{"chunks", &CodeTrieSmall{Chunks: []*Chunk{{}}}, false},
+	}
+	for _, c := range cases {
+		if zero := c.obj.IsZeroSSZ(); zero != c.zero {
+			t.Errorf("%s: expected zero %v but found %v", c.name, c.zero, zero)
+		}
+	}
+}
+
+func TestConcreteInterfaceField(t *testing.T) {
+	msg := &Message{
+		Index: 1,
+		Payload: &Metadata{
+			Version:    1,
+			CodeHash:   make([]byte, 32),
+			CodeLength: 10,
+		},
+		Chunks: []*Chunk{
+			{FIO: 1, Code: make([]byte, 32)},
+		},
+	}
+	buf, err := msg.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != msg.SizeSSZ() {
+		t.Fatalf("expected size %d but found %d", msg.SizeSSZ(), len(buf))
+	}
+
+	msg2 := new(Message)
+	if err := msg2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(msg, msg2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	root1, err := msg.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root2, err := msg2.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root1 != root2 {
+		t.Fatal("bad hash tree root")
+	}
+
+	// the interface does not hold the concrete type
+	if _, err := (&Message{}).MarshalSSZ(); err != ssz.ErrConcreteType {
+		t.Fatalf("expected concrete type error but found %v", err)
+	}
+	if _, err := (&Message{}).HashTreeRoot(); err != ssz.ErrConcreteType {
+		t.Fatalf("expected concrete type error but found %v", err)
+	}
+}
+
+func TestIncrementalListRoot(t *testing.T) {
+	reg := new(Registry)
+	chunks, err := reg.ChunksAccumulator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots, err := reg.RootsAccumulator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		chunksRoot, err := reg.AppendChunksAndRoot(chunks, &Chunk{FIO: uint8(i), Code: make([]byte, 32)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rootsRoot, err := reg.AppendRootsAndRoot(roots, [32]byte{byte(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the root of the container is the hash of the roots of both lists
+		expected, err := reg.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root := sha256.Sum256(append(chunksRoot[:], rootsRoot[:]...)); root != expected {
+			t.Fatalf("bad incremental root at %d", i)
+		}
+	}
+
+	// the accumulator is created from the current elements of the list
+	chunks2, err := reg.ChunksAccumulator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chunks.Root() != chunks2.Root() {
+		t.Fatal("bad accumulator root")
+	}
+
+	if _, err := reg.AppendRootsAndRoot(roots, [32]byte{}); err != ssz.ErrListTooBig {
+		t.Fatalf("expected list too big but found %v", err)
+	}
+	if _, err := reg.AppendRootsAndRoot(ssz.NewListAccumulator(5), [32]byte{}); err != ssz.ErrAccumulatorLength {
+		t.Fatalf("expected accumulator length error but found %v", err)
+	}
+}
+
+func TestWriteTestVectors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ssz-vectors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("SSZ_TEST_VECTORS", dir)
+	defer os.Unsetenv("SSZ_TEST_VECTORS")
+
+	TestSSZTestVectorsMessage(t)
+
+	for i := 0; i < 4; i++ {
+		caseDir := filepath.Join(dir, "Message", "ssz_random", fmt.Sprintf("case_%d", i))
+
+		serialized, err := ioutil.ReadFile(filepath.Join(caseDir, "serialized.ssz_snappy"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := snappy.Decode(nil, serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := new(Message)
+		if err := msg.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if len(msg.Chunks) != 4 {
+			t.Fatalf("expected the chunks to be populated up to the limit")
+		}
+
+		root, err := msg.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		meta, err := ioutil.ReadFile(filepath.Join(caseDir, "meta.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := fmt.Sprintf("root: '0x%x'\n", root); string(meta) != expected {
+			t.Fatalf("expected meta %s but found %s", expected, meta)
+		}
+	}
+}
+
+func TestMarshalFieldsSSZ(t *testing.T) {
+	hash := make([]byte, 32)
+	hash[0] = 1
+
+	src := &Metadata{Version: 2, CodeHash: hash, CodeLength: 3}
+	buf, err := src.MarshalFieldsSSZ("CodeHash", "CodeLength")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// bitvector + code hash + code length
+	if len(buf) != 1+32+2 {
+		t.Fatalf("unexpected size %d", len(buf))
+	}
+
+	dst := &Metadata{Version: 1, CodeHash: make([]byte, 32), CodeLength: 1}
+	if err := dst.UnmarshalFieldsSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := &Metadata{Version: 1, CodeHash: hash, CodeLength: 3}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Fatal("bad unmarshal of the fields")
+	}
+
+	// dynamic fields are prefixed with their length
+	msg := &Message{Index: 1, Chunks: []*Chunk{{FIO: 1, Code: hash}}}
+	if buf, err = msg.MarshalFieldsSSZ("Chunks"); err != nil {
+		t.Fatal(err)
+	}
+	msg2 := &Message{Index: 2}
+	if err := msg2.UnmarshalFieldsSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if msg2.Index != 2 || !reflect.DeepEqual(msg2.Chunks, msg.Chunks) {
+		t.Fatal("bad unmarshal of the dynamic fields")
+	}
+
+	if _, err := msg.MarshalFieldsSSZ("Unknown"); err != ssz.ErrUnknownField {
+		t.Fatalf("expected unknown field error but found %v", err)
+	}
+	// the bit of a field that does not exist is set
+	if err := msg2.UnmarshalFieldsSSZ([]byte{1 << 3}); err != ssz.ErrUnknownField {
+		t.Fatalf("expected unknown field error but found %v", err)
+	}
+	// trailing bytes
+	if err := msg2.UnmarshalFieldsSSZ(append(buf, 0)); err != ssz.ErrSize {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+func TestMerkleProof(t *testing.T) {
+	metadata := &Metadata{Version: 1, CodeHash: make([]byte, 32), CodeLength: 10}
+	msg := &Message{
+		Index:   1,
+		Payload: metadata,
+		Chunks:  []*Chunk{{FIO: 1, Code: make([]byte, 32)}},
+	}
+
+	verify := func(obj interface {
+		HashTreeRoot() ([32]byte, error)
+		MerkleProof(field string) ([][32]byte, error)
+	}, field string, index int, leaf [32]byte) {
+		branch, err := obj.MerkleProof(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof := &ssz.Proof{Index: index, Leaf: leaf[:]}
+		for _, h := range branch {
+			proof.Hashes = append(proof.Hashes, append([]byte{}, h[:]...))
+		}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok, err := ssz.VerifyProof(root[:], proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatalf("failed to verify the proof of %s", field)
+		}
+	}
+
+	// the 3 fields are padded to 4 leaves
+	var codeLength [32]byte
+	codeLength[0] = 10
+	verify(metadata, "CodeLength", 4+2, codeLength)
+
+	payload, err := metadata.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(msg, "Payload", 4+1, payload)
+
+	if _, err := msg.MerkleProof("Unknown"); err != ssz.ErrUnknownField {
+		t.Fatalf("expected unknown field error but found %v", err)
+	}
+}
+
+func TestSSZSchemaString(t *testing.T) {
+	cases := []struct {
+		obj    interface{ SSZSchemaString() string }
+		schema string
+	}{
+		{&Metadata{}, "Container(Version:uint8,CodeHash:Vector[byte,32],CodeLength:uint16)"},
+		{&Message{}, "Container(Index:uint64,Payload:Metadata,Chunks:List[Chunk,4])"},
+		{&Registry{}, "Container(Chunks:List[Chunk,1024],Roots:List[Vector[byte,32],5])"},
+	}
+	for _, c := range cases {
+		if schema := c.obj.SSZSchemaString(); schema != c.schema {
+			t.Fatalf("expected schema %s but found %s", c.schema, schema)
+		}
+	}
+}
+
+func TestUnmarshalFixedByteSlice(t *testing.T) {
+	obj := &Metadata{
+		Version:    1,
+		CodeHash:   make([]byte, 32),
+		CodeLength: 10,
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj2 := new(Metadata)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(obj2.CodeHash) != 32 {
+		t.Fatalf("expected 32 bytes but found %d", len(obj2.CodeHash))
+	}
+
+	// a buffer shorter than the fixed byte slice
+	if err := new(Metadata).UnmarshalSSZ(buf[:20]); err == nil {
+		t.Fatal("expected error decoding a short buffer")
+	}
+	// the fields are encoded with their length, a shorter region is not valid
+	fields, err := obj.MarshalFieldsSSZ("CodeHash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Metadata).UnmarshalFieldsSSZ(fields[:len(fields)-1]); err == nil {
+		t.Fatal("expected error decoding a short fixed byte slice")
+	}
+}
+
+func TestPatch(t *testing.T) {
+	obj := &Message{
+		Index:   1,
+		Payload: &Metadata{CodeHash: make([]byte, 32)},
+		Chunks: []*Chunk{
+			{FIO: 1, Code: make([]byte, 32)},
+		},
+	}
+	schema := obj.SSZSchema()
+	if schema.String() != obj.SSZSchemaString() {
+		t.Fatalf("bad schema %s", schema.String())
+	}
+
+	old, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj.Chunks = append(obj.Chunks, &Chunk{FIO: 2, Code: make([]byte, 32)})
+	updated, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := ssz.MakePatch(schema, old, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patch) >= len(updated) {
+		t.Fatalf("expected a patch smaller than the object but found %d bytes", len(patch))
+	}
+	res, err := ssz.ApplyPatch(schema, old, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj2 := new(Message)
+	if err := obj2.UnmarshalSSZ(res); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad patched object")
+	}
+}
+
+func TestPatchEmptyFields(t *testing.T) {
+	// the empty containers are fixed fields without bytes
+	obj := &EmptyFields{Slot: 1, Empty: new(Empty), Empties: []*Empty{{}, {}}}
+	schema := obj.SSZSchema()
+	if size := schema.FixedSize(); size != 8 {
+		t.Fatalf("expected a fixed part of 8 bytes but found %d", size)
+	}
+
+	old, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj.Slot = 2
+	updated, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := ssz.MakePatch(schema, old, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ssz.ApplyPatch(schema, old, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj2 := new(EmptyFields)
+	if err := obj2.UnmarshalSSZ(res); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad patched object")
+	}
+}
+
+func TestUnmarshalLargeOffset(t *testing.T) {
+	obj := &Message{
+		Payload: &Metadata{CodeHash: make([]byte, 32)},
+		Chunks: []*Chunk{
+			{Code: make([]byte, 32)},
+		},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// offset of the 'Chunks' field (after Index and Payload)
+	offset := 8 + obj.Payload.(*Metadata).SizeSSZ()
+	for _, o := range []uint32{math.MaxInt32, math.MaxInt32 + 1, math.MaxUint32} {
+		crafted := make([]byte, len(buf))
+		copy(crafted, buf)
+		binary.LittleEndian.PutUint32(crafted[offset:], o)
+
+		if err := new(Message).UnmarshalSSZ(crafted); err != ssz.ErrOffset {
+			t.Fatalf("expected offset error for %d but found %v", o, err)
+		}
+	}
+}
+
+func TestTypeAliasRoundTrip(t *testing.T) {
+	obj := &Checkpoint{
+		Epoch: 1,
+		Root:  Root{0x1},
+		Message: &MessageRef{
+			Index:   2,
+			Payload: &Metadata{CodeHash: make([]byte, 32)},
+			Chunks:  []*Chunk{{Code: make([]byte, 32)}},
+		},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(Checkpoint)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+}
+
+func TestCopyInto(t *testing.T) {
+	src := &Registry{
+		Chunks: []*Chunk{
+			{FIO: 1, Code: make([]byte, 32)},
+			{FIO: 2, Code: make([]byte, 32)},
+		},
+		Roots: [][32]byte{{0x1}, {0x2}},
+	}
+	dst := new(Registry)
+	src.CopyInto(dst)
+	if !reflect.DeepEqual(src, dst) {
+		t.Fatal("bad copy")
+	}
+	// the copy does not share memory with the source
+	dst.Chunks[0].Code[0] = 0x1
+	dst.Roots[0][0] = 0x2
+	if src.Chunks[0].Code[0] != 0 || src.Roots[0][0] != 0x1 {
+		t.Fatal("the copy shares memory with the source")
+	}
+
+	// repeated copies reuse the memory of the destination
+	chunksCap, rootsCap := cap(dst.Chunks), cap(dst.Roots)
+	allocs := testing.AllocsPerRun(100, func() {
+		src.CopyInto(dst)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations but found %f", allocs)
+	}
+	if cap(dst.Chunks) != chunksCap || cap(dst.Roots) != rootsCap {
+		t.Fatal("the capacity of the destination grows")
+	}
+	if !reflect.DeepEqual(src, dst) {
+		t.Fatal("bad copy")
+	}
+
+	// a shorter source truncates the destination
+	src.Chunks = src.Chunks[:1]
+	src.CopyInto(dst)
+	if !reflect.DeepEqual(src, dst) {
+		t.Fatal("bad copy of a shorter source")
+	}
+
+	msg := &Message{
+		Index:   1,
+		Payload: &Metadata{Version: 1, CodeHash: make([]byte, 32)},
+		Chunks:  []*Chunk{{Code: make([]byte, 32)}},
+	}
+	msg2 := new(Message)
+	msg.CopyInto(msg2)
+	if !reflect.DeepEqual(msg, msg2) {
+		t.Fatal("bad copy of the message")
+	}
+	if msg.Payload == msg2.Payload {
+		t.Fatal("the copy shares the payload with the source")
+	}
+}
+
+func TestPackedBools(t *testing.T) {
+	obj := &Flags{
+		Slot:      1,
+		Active:    true,
+		Exited:    true,
+		Justified: true,
+		Proposer:  true,
+	}
+	if size := obj.SizeSSZ(); size != 2+8 {
+		t.Fatalf("expected the 10 bools packed in 2 bytes but found size %d", size)
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the bitvector is the leading field
+	if buf[0] != 0b00000101 || buf[1] != 0b00000011 {
+		t.Fatalf("bad packed bools %x", buf[:2])
+	}
+
+	obj2 := new(Flags)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// the packed bools are hashed as a single leaf
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	hh.PutBytes(buf[:2])
+	hh.PutUint64(obj.Slot)
+	hh.Merkleize(indx)
+	expected, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root")
+	}
+
+	// the padding bits of the bitvector must be zero
+	buf[1] |= 1 << 7
+	if err := obj2.UnmarshalSSZ(buf); err != ssz.ErrInvalidBitvector {
+		t.Fatalf("expected invalid bitvector error but found %v", err)
+	}
+}
+
+func TestExtensible(t *testing.T) {
+	obj := &HeartbeatV2{Slot: 1, Peers: 5}
+	obj.Root[0] = 1
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the older version keeps the unknown field in the extension
+	old := new(Heartbeat)
+	if err := old.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if old.Slot != obj.Slot || old.Root != obj.Root {
+		t.Fatal("bad known fields")
+	}
+	if !reflect.DeepEqual(old.Extension, []byte{5, 0, 0, 0}) {
+		t.Fatalf("bad extension %x", old.Extension)
+	}
+
+	// and writes it back
+	buf2, err := old.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(buf, buf2) {
+		t.Fatal("bad encoding of the extension")
+	}
+	obj2 := new(HeartbeatV2)
+	if err := obj2.UnmarshalSSZ(buf2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// the fixed part is still required
+	if err := old.UnmarshalSSZ(buf[:39]); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}
+
+func TestSizeSSZFunction(t *testing.T) {
+	if size := SizeSSZFlags(); size != new(Flags).SizeSSZ() {
+		t.Fatalf("bad size %d", size)
+	}
+	if size := SizeSSZChunk(); size != new(Chunk).SizeSSZ() {
+		t.Fatalf("bad size %d", size)
+	}
+}
+
+func TestUintArrayRoot(t *testing.T) {
+	obj := &Balances{
+		Values: []uint64{1, 2, 3, 4, 5},
+		Scores: []uint32{6, 7, 8, 9},
+		Counts: []uint16{10, 11, 12},
+	}
+
+	// hash the values one by one
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+
+	subIndx := hh.Index()
+	for _, i := range obj.Values {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	hh.MerkleizeWithMixin(subIndx, 5, ssz.CalculateLimit(1024, 5, 8))
+
+	subIndx = hh.Index()
+	for _, i := range obj.Scores {
+		hh.Append(ssz.MarshalUint32(nil, i))
+	}
+	hh.FillUpTo32()
+	hh.Merkleize(subIndx)
+
+	subIndx = hh.Index()
+	for _, i := range obj.Counts {
+		hh.Append(ssz.MarshalUint16(nil, i))
+	}
+	hh.FillUpTo32()
+	hh.MerkleizeWithMixin(subIndx, 3, ssz.CalculateLimit(16, 3, 2))
+
+	hh.Merkleize(indx)
+	expected, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root")
+	}
+}
+
+func TestUnmarshalReusedByteSlice(t *testing.T) {
+	obj := &Metadata{
+		Version:  1,
+		CodeHash: make([]byte, 32),
+	}
+	obj.CodeHash[0] = 1
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the previous value is longer than the fixed size
+	obj2 := &Metadata{
+		CodeHash: make([]byte, 64),
+	}
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatalf("bad code hash %x", obj2.CodeHash)
+	}
+
+	// the previous value is shorter than the fixed size
+	obj2.CodeHash = obj2.CodeHash[:3]
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatalf("bad code hash %x", obj2.CodeHash)
+	}
+
+	// the extension is also resized when reused
+	hb := &Heartbeat{Extension: []byte{1, 2, 3}}
+	if err := hb.UnmarshalSSZ(make([]byte, 41)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hb.Extension, []byte{0}) {
+		t.Fatalf("bad extension %x", hb.Extension)
+	}
+}
+
+func TestMarshalFixedFields(t *testing.T) {
+	obj := &Header{Slot: 1, ProposerIndex: 2}
+	obj.ParentRoot[0] = 3
+	obj.StateRoot[1] = 4
+	obj.BodyRoot[31] = 5
+
+	// the fields written one by one
+	var expected []byte
+	expected = ssz.MarshalUint64(expected, obj.Slot)
+	expected = ssz.MarshalUint64(expected, obj.ProposerIndex)
+	expected = append(expected, obj.ParentRoot[:]...)
+	expected = append(expected, obj.StateRoot[:]...)
+	expected = append(expected, obj.BodyRoot[:]...)
+
+	// with a prefix in the destination
+	buf, err := obj.MarshalSSZTo([]byte{0xff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(buf, append([]byte{0xff}, expected...)) {
+		t.Fatal("bad encoding")
+	}
+}
+
+func BenchmarkMarshalHeader(b *testing.B) {
+	obj := &Header{Slot: 1, ProposerIndex: 2}
+	buf := make([]byte, 0, obj.SizeSSZ())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := obj.MarshalSSZTo(buf[:0]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestUnmarshalArena(t *testing.T) {
+	obj := &Message{
+		Index:   1,
+		Payload: &Metadata{CodeHash: make([]byte, 32)},
+	}
+	for i := 0; i < 4; i++ {
+		obj.Chunks = append(obj.Chunks, &Chunk{FIO: uint8(i), Code: make([]byte, 32)})
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arena := ssz.NewArena(4096)
+	obj2 := new(Message)
+	if err := obj2.UnmarshalSSZArena(buf, arena); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// the arena allocates the objects in blocks
+	heap := testing.AllocsPerRun(10, func() {
+		if err := new(Message).UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+	})
+	allocs := testing.AllocsPerRun(10, func() {
+		if err := new(Message).UnmarshalSSZArena(buf, arena); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs >= heap {
+		t.Fatalf("expected less allocations with the arena %f than without %f", allocs, heap)
+	}
+}
+
+func TestEmptyListsRoundTrip(t *testing.T) {
+	empty := &Lists{
+		Data:   []byte{},
+		Values: []uint64{},
+		Chunks: []*Chunk{},
+		Blobs:  [][]byte{},
+	}
+	for _, obj := range []*Lists{new(Lists), empty} {
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the empty lists are decoded to empty slices
+		obj2 := new(Lists)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj2, empty) {
+			t.Fatal("expected empty slices")
+		}
+	}
+
+	// the empty elements of a list are also decoded to empty slices
+	obj := &Lists{Blobs: [][]byte{nil, {1}}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(Lists)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if obj2.Blobs[0] == nil || len(obj2.Blobs[0]) != 0 {
+		t.Fatal("expected an empty slice")
+	}
+}
+
+func TestNilEmptyListsRoundTrip(t *testing.T) {
+	empty := &NilLists{
+		Data:   []byte{},
+		Values: []uint64{},
+		Chunks: []*Chunk{},
+		Blobs:  [][]byte{{}},
+	}
+	for _, obj := range []*NilLists{new(NilLists), empty} {
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the empty lists are decoded to nil
+		obj2 := &NilLists{Data: []byte{1}, Values: []uint64{1}}
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		expected := new(NilLists)
+		if obj == empty {
+			// the list with an empty element
+			expected.Blobs = [][]byte{nil}
+		}
+		if !reflect.DeepEqual(obj2, expected) {
+			t.Fatal("expected nil slices")
+		}
+	}
+}
+
+func TestMinListLength(t *testing.T) {
+	chunk := &Chunk{Code: make([]byte, 32)}
+	obj := &NonEmptyLists{
+		Data:   []byte{1, 2},
+		Values: []uint64{1},
+		Chunks: []*Chunk{chunk},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(NonEmptyLists)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// the lists below the minimum are not encoded nor decoded
+	short := []*NonEmptyLists{
+		{Data: []byte{1}, Values: []uint64{1}, Chunks: []*Chunk{chunk}},
+		{Data: []byte{1, 2}, Chunks: []*Chunk{chunk}},
+		{Data: []byte{1, 2}, Values: []uint64{1}},
+	}
+	for _, obj := range short {
+		if _, err := obj.MarshalSSZ(); err != ssz.ErrListTooSmall {
+			t.Fatalf("expected ErrListTooSmall but found %v", err)
+		}
+		if _, err := obj.HashTreeRoot(); err != ssz.ErrListTooSmall {
+			t.Fatalf("expected ErrListTooSmall but found %v", err)
+		}
+	}
+
+	// remove the chunk from the encoding
+	if err := obj2.UnmarshalSSZ(buf[:len(buf)-chunk.SizeSSZ()]); err != ssz.ErrListTooSmall {
+		t.Fatalf("expected ErrListTooSmall but found %v", err)
+	}
+}
+
+func TestVarintRoundTrip(t *testing.T) {
+	obj := &Compact{
+		Slot:     1,
+		Index:    300,
+		Active:   true,
+		Root:     [32]byte{1},
+		Data:     []byte{1, 2, 3},
+		Values:   []uint64{1, 2, 1 << 40},
+		Roots:    [][]byte{make([]byte, 32)},
+		Inner:    &CompactInner{Epoch: 5, Votes: []uint32{7}},
+		Items:    []*CompactInner{{Votes: []uint32{}}, {Epoch: 1, Votes: []uint32{2, 3}}},
+		Counters: []uint16{1, 2, 3, 4},
+	}
+	buf, err := obj.MarshalVarint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sszBuf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) >= len(sszBuf) {
+		t.Fatalf("expected the varint encoding (%d bytes) to be smaller than ssz (%d bytes)", len(buf), len(sszBuf))
+	}
+
+	obj2 := new(Compact)
+	if err := obj2.UnmarshalVarint(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad varint round trip")
+	}
+
+	// the ssz encoding is not affected
+	obj3 := new(Compact)
+	if err := obj3.UnmarshalSSZ(sszBuf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj3) {
+		t.Fatal("bad ssz round trip")
+	}
+
+	// truncated and trailing bytes
+	for i := 0; i < len(buf); i++ {
+		if err := new(Compact).UnmarshalVarint(buf[:i]); err == nil {
+			t.Fatalf("expected error decoding %d bytes", i)
+		}
+	}
+	if err := new(Compact).UnmarshalVarint(append(buf, 0)); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}
+
+func TestOffsetsSSZ(t *testing.T) {
+	obj := &Registry{
+		Chunks: []*Chunk{{Code: make([]byte, 32)}, {Code: make([]byte, 32)}},
+		Roots:  [][32]byte{{1}},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the offsets of Chunks and Roots are the first 8 bytes of the encoding
+	expected := []uint32{
+		uint32(ssz.ReadOffset(buf[0:4])),
+		uint32(ssz.ReadOffset(buf[4:8])),
+	}
+	if offsets := obj.OffsetsSSZ(); !reflect.DeepEqual(offsets, expected) {
+		t.Fatalf("expected offsets %v but found %v", expected, offsets)
+	}
+	if expected[1] != 8+2*33 {
+		t.Fatalf("bad offset %d", expected[1])
+	}
+}
+
+// merkleizeChunks is a reference merkleization of the chunks padded with zero chunks up to limit
+func merkleizeChunks(chunks [][32]byte, limit uint64) [32]byte {
+	size := uint64(1)
+	for size < limit {
+		size *= 2
+	}
+	layer := make([][32]byte, size)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+// byteListRoot is a reference root of a byte list: the bytes are packed in ceil(max/32)
+// chunks and the length in bytes is mixed in
+func byteListRoot(b []byte, max uint64) [32]byte {
+	chunks := make([][32]byte, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[i*32:])
+	}
+	root := merkleizeChunks(chunks, (max+31)/32)
+
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(b)))
+	return sha256.Sum256(append(root[:], length[:]...))
+}
+
+func TestByteListRoot(t *testing.T) {
+	bytes := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(i + 1)
+		}
+		return b
+	}
+	cases := []*ByteLists{
+		{},
+		{Pow2: bytes(1), NotPow2: bytes(1), Chunk: bytes(1)},
+		{Pow2: bytes(32), NotPow2: bytes(32), Chunk: bytes(32)},
+		{Pow2: bytes(33), NotPow2: bytes(33), Chunk: bytes(33)},
+		{Pow2: bytes(1023), NotPow2: bytes(999), Chunk: bytes(31)},
+		{Pow2: bytes(1024), NotPow2: bytes(1000)},
+		{Pow2: bytes(992), NotPow2: bytes(993)},
+	}
+	for _, c := range cases {
+		expected := merkleizeChunks([][32]byte{
+			byteListRoot(c.Pow2, 1024),
+			byteListRoot(c.NotPow2, 1000),
+			byteListRoot(c.Chunk, 33),
+		}, 3)
+
+		root, err := c.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("bad root for the lengths %d, %d and %d", len(c.Pow2), len(c.NotPow2), len(c.Chunk))
+		}
+	}
+
+	// the lists above the limit cannot be hashed
+	for _, c := range []*ByteLists{{Pow2: bytes(1025)}, {NotPow2: bytes(1001)}, {Chunk: bytes(34)}} {
+		if _, err := c.HashTreeRoot(); err == nil {
+			t.Fatal("expected an error for a list above the limit")
+		}
+	}
+}
+
+func TestNestedByteListRoot(t *testing.T) {
+	blob := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(i + 1)
+		}
+		return b
+	}
+	// nestedRoot is the root of a list of byte lists: the root of each inner list has its
+	// length mixed in and the outer length is mixed in the merkleization of the roots
+	nestedRoot := func(blobs [][]byte, max, innerMax uint64) [32]byte {
+		roots := make([][32]byte, len(blobs))
+		for i, blob := range blobs {
+			roots[i] = byteListRoot(blob, innerMax)
+		}
+		return mixInLength(merkleizeChunks(roots, max), len(blobs))
+	}
+
+	cases := [][][]byte{
+		{},
+		{{}},
+		{blob(1)},
+		{blob(8), {}, blob(3)},
+		{blob(1), blob(2), blob(7), blob(8)},
+	}
+	for _, blobs := range cases {
+		obj := &Lists{Blobs: blobs}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := merkleizeChunks([][32]byte{
+			byteListRoot(nil, 32),
+			mixInLength(packedUintsRoot(nil, 2), 0),
+			mixInLength(merkleizeChunks(nil, 4), 0),
+			nestedRoot(blobs, 4, 8),
+		}, 4)
+		if root != expected {
+			t.Fatalf("bad root for %d blobs", len(blobs))
+		}
+	}
+
+	// the inner lists span several chunks and the tree has the same root
+	trieCases := [][][]byte{
+		{},
+		{blob(0), blob(31), blob(32), blob(33)},
+		{blob(40), blob(1)},
+	}
+	for _, blobs := range trieCases {
+		obj := &BlobTrie{Data: blob(33), Blobs: blobs}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := merkleizeChunks([][32]byte{byteListRoot(obj.Data, 64), nestedRoot(blobs, 4, 40)}, 2)
+		if root != expected {
+			t.Fatalf("bad root for %d blobs", len(blobs))
+		}
+		tree, err := obj.GetTree()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tree.Hash(), root[:]) {
+			t.Fatalf("bad tree root for %d blobs", len(blobs))
+		}
+	}
+
+	// the inner lists above their limit cannot be hashed
+	if _, err := (&BlobTrie{Blobs: [][]byte{blob(41)}}).HashTreeRoot(); err == nil {
+		t.Fatal("expected an error for an inner list above the limit")
+	}
+	if _, err := (&BlobTrie{Blobs: [][]byte{blob(41)}}).GetTree(); err == nil {
+		t.Fatal("expected an error for an inner list above the limit in the tree")
+	}
+}
+
+func TestExternalValueFields(t *testing.T) {
+	obj := &ExternalValues{
+		Header:    external.Header{Slot: 1},
+		HeaderPtr: &external.Header{Slot: 2},
+		Body:      external.Body{Data: []byte{1, 2, 3}},
+		Manual:    external.Manual{A: 3},
+		ManualPtr: &external.Manual{A: 4},
+		ManualDyn: external.ManualDynamic{B: []byte{4, 5}},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the fixed structs are encoded in place, with or without hand-written methods,
+	// and the Body is its offset and the data
+	if size := 104 + 4 + 3 + 2; len(buf) != size {
+		t.Fatalf("expected size %d but found %d", size, len(buf))
+	}
+	if ssz.UnmarshallUint64(buf[0:8]) != 1 || ssz.UnmarshallUint64(buf[84:92]) != 3 {
+		t.Fatal("fixed structs are not encoded in place")
+	}
+
+	obj2 := new(ExternalValues)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+}
+
+func TestExternalLists(t *testing.T) {
+	signed := func(slot uint64) *external.Signed {
+		s := &external.Signed{Slot: slot}
+		s.Signature[95] = byte(slot)
+		return s
+	}
+	obj := &ExternalLists{
+		Signed:    []*external.Signed{signed(1), signed(2), signed(3)},
+		Manual:    []*external.Manual{{A: 4}, {A: 5}},
+		ManualDyn: []*external.ManualDynamic{{B: []byte{6}}, {B: []byte{7, 8}}},
+		Headers:   []*external.Header{{Slot: 9}},
+		Pair:      []*external.Signed{signed(10), signed(11)},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the size of the elements is the one of their hand-written methods
+	// (104 bytes for Signed) and not the one of a basic type
+	fixed := 4*4 + 2*104
+	if size := fixed + 3*104 + 2*8 + (2*4 + 3) + 40; obj.SizeSSZ() != size || len(buf) != size {
+		t.Fatalf("expected size %d but found %d and %d bytes", size, obj.SizeSSZ(), len(buf))
+	}
+	if ssz.ReadOffset(buf[4:8]) != uint64(fixed+3*104) {
+		t.Fatal("bad offset after the list of Signed")
+	}
+
+	obj2 := new(ExternalLists)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+	// the buffer of the list must be a multiple of the size of the element
+	if err := obj2.UnmarshalSSZ(append(append([]byte{}, buf[:fixed+3*104-1]...), buf[fixed+3*104:]...)); err == nil {
+		t.Fatal("expected an error for a truncated element")
+	}
+
+	// the elements are hashed with their hand-written methods
+	roots := func(objs ...ssz.HashRoot) [][32]byte {
+		res := [][32]byte{}
+		for _, o := range objs {
+			root, err := o.HashTreeRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res = append(res, root)
+		}
+		return res
+	}
+	expected := merkleizeChunks([][32]byte{
+		mixInLength(merkleizeChunks(roots(obj.Signed[0], obj.Signed[1], obj.Signed[2]), 8), 3),
+		mixInLength(merkleizeChunks(roots(obj.Manual[0], obj.Manual[1]), 4), 2),
+		mixInLength(merkleizeChunks(roots(obj.ManualDyn[0], obj.ManualDyn[1]), 4), 2),
+		mixInLength(merkleizeChunks(roots(obj.Headers[0]), 4), 1),
+		merkleizeChunks(roots(obj.Pair[0], obj.Pair[1]), 2),
+	}, 8)
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatalf("expected the root %x but found %x", expected, root)
+	}
+}
+
+func TestContainerPaddedRoot(t *testing.T) {
+	uint64Leaf := func(i uint64) (leaf [32]byte) {
+		binary.LittleEndian.PutUint64(leaf[:], i)
+		return
+	}
+
+	obj3 := &Fields3{A: 1, B: 2, C: 3}
+	obj5 := &Fields5{A: 1, B: 2, C: 3, D: 4, E: [32]byte{5}}
+	obj9 := &Fields9{A: 1, B: 2, C: 3, D: 4, E: 5, F: 6, G: 7, H: 8, I: obj3}
+
+	// the field roots are merkleized with the zero leaves up to the next power of two
+	root3 := merkleizeChunks([][32]byte{uint64Leaf(1), uint64Leaf(2), uint64Leaf(3)}, 4)
+	root5 := merkleizeChunks([][32]byte{uint64Leaf(1), uint64Leaf(2), uint64Leaf(3), uint64Leaf(4), {5}}, 8)
+	leaves9 := [][32]byte{}
+	for i := uint64(1); i <= 8; i++ {
+		leaves9 = append(leaves9, uint64Leaf(i))
+	}
+	root9 := merkleizeChunks(append(leaves9, root3), 16)
+
+	cases := []struct {
+		obj      ssz.HashRoot
+		expected [32]byte
+	}{
+		{obj3, root3},
+		{obj5, root5},
+		{obj9, root9},
+	}
+	for _, c := range cases {
+		root, err := c.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != c.expected {
+			t.Fatalf("bad root for %T: expected %x but found %x", c.obj, c.expected, root)
+		}
+	}
+}
+
+func newTestMessage(chunks int) *Message {
+	obj := &Message{
+		Index:   1,
+		Payload: &Metadata{Version: 2, CodeHash: make([]byte, 32)},
+	}
+	for i := 0; i < chunks; i++ {
+		obj.Chunks = append(obj.Chunks, &Chunk{FIO: uint8(i), Code: make([]byte, 32)})
+	}
+	return obj
+}
+
+func TestUnmarshalReusedGraph(t *testing.T) {
+	buf, err := newTestMessage(2).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the nested objects of a previous decoding are reused
+	obj := newTestMessage(3)
+	obj.Chunks[0].FIO = 10
+	payload, chunks := obj.Payload, obj.Chunks
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, newTestMessage(2)) {
+		t.Fatal("bad decoding")
+	}
+	if obj.Payload != payload || obj.Chunks[0] != chunks[0] || &obj.Chunks[0] != &chunks[0] {
+		t.Fatal("expected the nested objects to be reused")
+	}
+
+	// the list grows past its capacity
+	buf, err = newTestMessage(4).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, newTestMessage(4)) {
+		t.Fatal("bad decoding")
+	}
+}
+
+func benchmarkUnmarshalMessage(b *testing.B, reuse bool) {
+	buf, err := newTestMessage(4).MarshalSSZ()
+	if err != nil {
+		b.Fatal(err)
+	}
+	obj := new(Message)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !reuse {
+			obj = new(Message)
+		}
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalMessageFresh(b *testing.B) {
+	benchmarkUnmarshalMessage(b, false)
+}
+
+func BenchmarkUnmarshalMessageReused(b *testing.B) {
+	benchmarkUnmarshalMessage(b, true)
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	names := ssz.SchemaRegistry.Names()
+	for _, name := range []string{"tests.Metadata", "tests.Chunk", "tests.CodeTrieSmall"} {
+		found := false
+		for _, n := range names {
+			found = found || n == name
+		}
+		if !found {
+			t.Fatalf("%s is not registered in %v", name, names)
+		}
+	}
+
+	schema, ok := ssz.SchemaRegistry.Schema("tests.Metadata")
+	if !ok {
+		t.Fatal("expected the schema of Metadata")
+	}
+	if !reflect.DeepEqual(schema, new(Metadata).SSZSchema()) {
+		t.Fatalf("bad schema %s", schema)
+	}
+
+	// decode a registered type by name
+	obj := &Metadata{Version: 1, CodeHash: make([]byte, 32)}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2, ok := ssz.SchemaRegistry.New("tests.Metadata")
+	if !ok {
+		t.Fatal("expected to create a Metadata")
+	}
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad decoding")
+	}
+
+	if _, ok := ssz.SchemaRegistry.New("tests.Unknown"); ok {
+		t.Fatal("expected an unknown type")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic registering a type twice")
+		}
+	}()
+	ssz.SchemaRegistry.Register("tests.Metadata", func() ssz.SchemaObject {
+		return new(Metadata)
+	})
+}
+
+func TestCompareReference(t *testing.T) {
+	// a reference implementation of the Metadata encoding
+	marshal := func(obj interface{}) ([]byte, error) {
+		m := obj.(*Metadata)
+		buf := append([]byte{m.Version}, m.CodeHash...)
+		return ssz.MarshalUint16(buf, m.CodeLength), nil
+	}
+	hashTreeRoot := func(obj interface{}) ([32]byte, error) {
+		m := obj.(*Metadata)
+		var version, codeHash, codeLength [32]byte
+		version[0] = m.Version
+		copy(codeHash[:], m.CodeHash)
+		binary.LittleEndian.PutUint16(codeLength[:], m.CodeLength)
+		return merkleizeChunks([][32]byte{version, codeHash, codeLength}, 4), nil
+	}
+
+	obj := &Metadata{Version: 1, CodeHash: make([]byte, 32), CodeLength: 2}
+	obj.CodeHash[0] = 3
+	if err := ssz.CompareReference(obj, marshal, hashTreeRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// the reference does not match
+	badMarshal := func(obj interface{}) ([]byte, error) {
+		buf, err := marshal(obj)
+		return buf[1:], err
+	}
+	if err := ssz.CompareReference(obj, badMarshal, hashTreeRoot); err == nil {
+		t.Fatal("expected an encoding mismatch")
+	}
+	badRoot := func(obj interface{}) ([32]byte, error) {
+		return [32]byte{}, nil
+	}
+	if err := ssz.CompareReference(obj, marshal, badRoot); err == nil {
+		t.Fatal("expected a root mismatch")
+	}
+}
+
+func TestMarshalSSZAppend(t *testing.T) {
+	obj := newTestMessage(4)
+	expected, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ssz.MarshalSSZAppend([]byte{0xff}, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(buf, append([]byte{0xff}, expected...)) {
+		t.Fatal("bad encoding")
+	}
+
+	// the buffer is allocated once for the nested objects
+	prefix := []byte{0xff}
+	allocs := testing.AllocsPerRun(10, func() {
+		ssz.MarshalSSZAppend(prefix, obj)
+	})
+	if allocs != 1 {
+		t.Fatalf("expected 1 allocation but found %v", allocs)
+	}
+}
+
+func BenchmarkMarshalMessageTo(b *testing.B) {
+	obj := newTestMessage(4)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := obj.MarshalSSZTo(nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalMessageAppend(b *testing.B) {
+	obj := newTestMessage(4)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ssz.MarshalSSZAppend(nil, obj); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// gcmCipher encrypts the fields with AES-GCM and a nonce prefixed to the ciphertext
+type gcmCipher struct {
+	aead  cipher.AEAD
+	nonce byte
+}
+
+func newGCMCipher(t *testing.T) *gcmCipher {
+	block, err := aes.NewCipher(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gcmCipher{aead: aead}
+}
+
+func (c *gcmCipher) Encrypt(plaintext []byte) ([]byte, error) {
+	c.nonce++
+	nonce := make([]byte, c.aead.NonceSize())
+	nonce[0] = c.nonce
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (c *gcmCipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	size := c.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, fmt.Errorf("short ciphertext")
+	}
+	return c.aead.Open(nil, ciphertext[:size], ciphertext[size:], nil)
+}
+
+func TestMarshalSSZEncrypted(t *testing.T) {
+	obj := &Vault{
+		Slot:   1,
+		Secret: [32]byte{0xaa, 0xbb},
+		Notes:  []byte("top secret notes"),
+		Chunks: []*Chunk{{FIO: 1, Code: make([]byte, 32)}},
+		Public: []byte{1, 2, 3},
+	}
+	c := newGCMCipher(t)
+
+	buf, err := obj.MarshalSSZEncrypted(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf, obj.Notes) || bytes.Contains(buf, []byte{0xaa, 0xbb}) {
+		t.Fatal("expected the tagged fields to be encrypted")
+	}
+	if !bytes.Contains(buf, obj.Public) {
+		t.Fatal("expected the other fields to be plain")
+	}
+
+	obj2 := new(Vault)
+	if err := obj2.UnmarshalSSZEncrypted(buf, c); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// the root is computed over the plaintext
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root2, err := obj2.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != root2 {
+		t.Fatal("bad root")
+	}
+
+	// tampered ciphertext and truncated buffers
+	buf[8+4+c.aead.NonceSize()] ^= 1
+	if err := new(Vault).UnmarshalSSZEncrypted(buf, c); err == nil {
+		t.Fatal("expected an authentication error")
+	}
+	buf[8+4+c.aead.NonceSize()] ^= 1
+	for i := 0; i < len(buf); i++ {
+		if err := new(Vault).UnmarshalSSZEncrypted(buf[:i], c); err == nil {
+			t.Fatalf("expected error decoding %d bytes", i)
+		}
+	}
+}
+
+func TestTransientField(t *testing.T) {
+	obj := &Block{Slot: 1, Body: []byte{1, 2, 3}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the transient field is not encoded
+	if len(buf) != 8+4+3 {
+		t.Fatalf("bad size %d", len(buf))
+	}
+
+	expected := sha256.Sum256(obj.Body)
+	obj2 := new(Block)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if obj2.bodyHash != expected {
+		t.Fatal("expected the hash of the body to be populated after decoding")
+	}
+
+	// the copy is populated as well
+	obj3 := new(Block)
+	obj2.CopyInto(obj3)
+	if obj3.bodyHash != expected {
+		t.Fatal("expected the hash of the body to be populated after copying")
+	}
+
+	// the hook is not called if the decoding fails
+	obj4 := new(Block)
+	if err := obj4.UnmarshalSSZ(buf[:len(buf)-4]); err == nil {
+		t.Fatal("expected error")
+	}
+	if obj4.bodyHash != [32]byte{} {
+		t.Fatal("expected the hook to not be called")
+	}
+}
+
+// packedUintsRoot is a reference root of the little endian bytes of the uints packed in
+// chunks up to limit chunks
+func packedUintsRoot(buf []byte, limit uint64) [32]byte {
+	chunks := make([][32]byte, (len(buf)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], buf[i*32:])
+	}
+	return merkleizeChunks(chunks, limit)
+}
+
+func mixInLength(root [32]byte, length int) [32]byte {
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:], uint64(length))
+	return sha256.Sum256(append(root[:], leaf[:]...))
+}
+
+func TestPackedUintsRoot(t *testing.T) {
+	for _, num := range []int{0, 1, 7, 8, 9, 15, 16, 17, 20} {
+		obj := &PackedUints{
+			U32Vector: make([]uint32, 9),
+			U16Vector: make([]uint16, 17),
+		}
+		var u32List, u16List, u32Vector, u16Vector []byte
+		for i := 0; i < num; i++ {
+			obj.U32List = append(obj.U32List, uint32(0x01020304+i))
+			u32List = ssz.MarshalUint32(u32List, uint32(0x01020304+i))
+		}
+		for i := 0; i < 2*num; i++ {
+			obj.U16List = append(obj.U16List, uint16(0x0102+i))
+			u16List = ssz.MarshalUint16(u16List, uint16(0x0102+i))
+		}
+		for i := range obj.U32Vector {
+			obj.U32Vector[i] = uint32(num + i + 1)
+			u32Vector = ssz.MarshalUint32(u32Vector, obj.U32Vector[i])
+		}
+		for i := range obj.U16Vector {
+			obj.U16Vector[i] = uint16(num + i + 1)
+			u16Vector = ssz.MarshalUint16(u16Vector, obj.U16Vector[i])
+		}
+
+		// the limits of the lists are ceil(20*4/32) and ceil(40*2/32) chunks
+		expected := merkleizeChunks([][32]byte{
+			mixInLength(packedUintsRoot(u32List, 3), num),
+			mixInLength(packedUintsRoot(u16List, 3), 2*num),
+			packedUintsRoot(u32Vector, 2),
+			packedUintsRoot(u16Vector, 2),
+		}, 4)
+
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("bad root with %d elements", num)
+		}
+	}
+}
+
+func TestVectorOfContainers(t *testing.T) {
+	obj := &Committee{Slot: 10}
+	for i := 0; i < 4; i++ {
+		obj.Validators = append(obj.Validators, &Validator{Pubkey: [48]byte{byte(i)}, Balance: uint64(i), Slashed: i%2 == 0})
+	}
+
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 8+4*57 {
+		t.Fatalf("bad size %d", len(data))
+	}
+	obj2 := new(Committee)
+	if err := obj2.UnmarshalSSZ(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// the roots of the validators are merkleized without a length mixed in
+	roots := [][32]byte{}
+	for _, v := range obj.Validators {
+		root, err := v.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+	}
+	var slot [32]byte
+	slot[0] = 10
+	expected := merkleizeChunks([][32]byte{slot, merkleizeChunks(roots, 4)}, 2)
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatalf("bad root: expected %x but found %x", expected, root)
+	}
+
+	// the vector must have exactly 4 elements
+	for _, num := range []int{0, 3, 5} {
+		obj3 := &Committee{Validators: make([]*Validator, num)}
+		for i := range obj3.Validators {
+			obj3.Validators[i] = new(Validator)
+		}
+		if _, err := obj3.MarshalSSZ(); err != ssz.ErrVectorLength {
+			t.Fatalf("expected ErrVectorLength marshaling %d elements but found %v", num, err)
+		}
+		if _, err := obj3.HashTreeRoot(); err != ssz.ErrVectorLength {
+			t.Fatalf("expected ErrVectorLength hashing %d elements but found %v", num, err)
+		}
+	}
+	for _, size := range []int{8 + 3*57, 8 + 5*57} {
+		if err := new(Committee).UnmarshalSSZ(make([]byte, size)); err != ssz.ErrSize {
+			t.Fatalf("expected ErrSize decoding %d bytes but found %v", size, err)
+		}
+	}
+}
+
+func TestPopulateSSZ(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	obj := new(Lists)
+	obj.PopulateSSZ(rnd)
+
+	// the lists are filled up to their limit
+	if len(obj.Data) != 32 || len(obj.Values) != 8 || len(obj.Chunks) != 4 || len(obj.Blobs) != 4 {
+		t.Fatal("expected the lists to be filled")
+	}
+	for _, blob := range obj.Blobs {
+		if len(blob) != 8 {
+			t.Fatal("expected the nested lists to be filled")
+		}
+	}
+	if bytes.Equal(obj.Chunks[0].Code, make([]byte, 32)) {
+		t.Fatal("expected the nested containers to be populated")
+	}
+
+	objs := []interface {
+		ssz.Marshaler
+		ssz.Unmarshaler
+		ssz.HashRoot
+		PopulateSSZ(rnd *rand.Rand)
+	}{
+		obj,
+		new(Message),
+		new(Registry),
+		new(Committee),
+		new(PackedUints),
+	}
+	for _, obj := range objs {
+		obj.PopulateSSZ(rnd)
+		data, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatalf("%T: %v", obj, err)
+		}
+		obj2 := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(ssz.Unmarshaler)
+		if err := obj2.UnmarshalSSZ(data); err != nil {
+			t.Fatalf("%T: %v", obj, err)
+		}
+		if !reflect.DeepEqual(obj, obj2) {
+			t.Fatalf("%T: bad round trip", obj)
+		}
+		if _, err := obj.HashTreeRoot(); err != nil {
+			t.Fatalf("%T: %v", obj, err)
+		}
+	}
+}
+
+func TestBitvectorTrailingBits(t *testing.T) {
+	obj := &Participation{Slot: 1, Bits: []byte{0xff, 0x0f}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj2 := new(Participation)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// any of the 4 bits beyond the 12 bits of the bitvector is invalid
+	for i := 4; i < 8; i++ {
+		buf[len(buf)-1] = 0x0f | 1<<i
+		if err := new(Participation).UnmarshalSSZ(buf); err != ssz.ErrInvalidBitvector {
+			t.Fatalf("expected ErrInvalidBitvector with the bit %d but found %v", 8+i, err)
+		}
+	}
+}
+
+func TestOptionalFields(t *testing.T) {
+	header := &Header{Slot: 1, ParentRoot: [32]byte{0x1}}
+	lists := &ByteLists{Pow2: []byte{0x1, 0x2}, NotPow2: []byte{}, Chunk: []byte{}}
+
+	cases := []struct {
+		name string
+		obj  *OptionalChain
+		size int
+	}{
+		{"absent", &OptionalChain{Epoch: 1}, 8 + 4},
+		{"present empty", &OptionalChain{Optionals: &Optionals{}}, 8 + 4 + 1 + 16},
+		{"present fixed", &OptionalChain{Optionals: &Optionals{Header: header}}, 8 + 4 + 1 + 16 + 1 + 112},
+		{"present empty dynamic", &OptionalChain{Optionals: &Optionals{Lists: &ByteLists{Pow2: []byte{}, NotPow2: []byte{}, Chunk: []byte{}}}}, 8 + 4 + 1 + 16 + 1 + 12},
+		{"present both", &OptionalChain{Optionals: &Optionals{Slot: 2, Header: header, Lists: lists}}, 8 + 4 + 1 + 16 + 1 + 112 + 1 + 12 + 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if size := c.obj.SizeSSZ(); size != c.size {
+				t.Fatalf("expected size %d but found %d", c.size, size)
+			}
+			buf, err := c.obj.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(buf) != c.size {
+				t.Fatalf("expected %d bytes but found %d", c.size, len(buf))
+			}
+			obj2 := new(OptionalChain)
+			if err := obj2.UnmarshalSSZ(buf); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c.obj, obj2) {
+				t.Fatal("bad round trip")
+			}
+		})
+	}
+
+	// the absent value is hashed as an empty list and the present one as a list of one element
+	epoch := [32]byte{0x1}
+	absent := mixInLength([32]byte{}, 0)
+	root, err := (&OptionalChain{Epoch: 1}).HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := merkleizeChunks([][32]byte{epoch, absent}, 2); root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+
+	inner := &Optionals{Header: header}
+	innerRoot, err := inner.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, err = (&OptionalChain{Epoch: 1, Optionals: inner}).HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := merkleizeChunks([][32]byte{epoch, mixInLength(innerRoot, 1)}, 2); root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+
+	// the present value must start with the presence byte
+	buf, err := (&OptionalChain{Optionals: &Optionals{}}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf[12] = 0x2
+	if err := new(OptionalChain).UnmarshalSSZ(buf); err != ssz.ErrInvalidOptional {
+		t.Fatalf("expected ErrInvalidOptional but found %v", err)
+	}
+}
+
+func TestOptionalSize(t *testing.T) {
+	header := &Header{Slot: 1}
+	lists := &ByteLists{Pow2: []byte{0x1}, NotPow2: []byte{0x2, 0x3}, Chunk: []byte{}}
+
+	// the fixed part has the slot and the offsets of both optionals, an absent value has
+	// no bytes and a present one has the presence byte before its encoding
+	cases := []struct {
+		name    string
+		obj     *Optionals
+		size    int
+		offsets []uint32
+	}{
+		{"both absent", &Optionals{}, 16, []uint32{16, 16}},
+		{"fixed present", &Optionals{Header: header}, 16 + 1 + 112, []uint32{16, 16 + 1 + 112}},
+		{"dynamic present", &Optionals{Lists: lists}, 16 + 1 + 12 + 3, []uint32{16, 16}},
+		{"both present", &Optionals{Header: header, Lists: lists}, 16 + 1 + 112 + 1 + 12 + 3, []uint32{16, 16 + 1 + 112}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if size := c.obj.SizeSSZ(); size != c.size {
+				t.Fatalf("expected size %d but found %d", c.size, size)
+			}
+			buf, err := c.obj.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the buffer is allocated once with the exact size
+			if len(buf) != c.size || cap(buf) != c.size {
+				t.Fatalf("expected %d bytes with the same capacity but found %d bytes with capacity %d", c.size, len(buf), cap(buf))
+			}
+			if offsets := c.obj.OffsetsSSZ(); !reflect.DeepEqual(offsets, c.offsets) {
+				t.Fatalf("expected the offsets %v but found %v", c.offsets, offsets)
+			}
+			for i, offset := range c.offsets {
+				if found := binary.LittleEndian.Uint32(buf[8+4*i:]); found != offset {
+					t.Fatalf("expected the offset %d of the field %d but found %d", offset, i+1, found)
+				}
+			}
+		})
+	}
+}
+
+func TestListHelpers(t *testing.T) {
+	validators := []*Validator{{Balance: 1}, {Pubkey: [48]byte{0x1}, Slashed: true}}
+	buf, err := MarshalValidatorList(validators, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fixed items are concatenated
+	var expected []byte
+	for _, v := range validators {
+		if expected, err = v.MarshalSSZTo(expected); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatal("bad encoding of the fixed items")
+	}
+	validators2, err := UnmarshalValidatorList(buf, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(validators, validators2) {
+		t.Fatal("bad round trip of the fixed items")
+	}
+	if _, err := MarshalValidatorList(validators, 1); err != ssz.ErrListTooBig {
+		t.Fatalf("expected ErrListTooBig but found %v", err)
+	}
+	if _, err := UnmarshalValidatorList(buf, 1); err == nil {
+		t.Fatal("expected an error decoding more items than the limit")
+	}
+	if _, err := UnmarshalValidatorList(buf[1:], 2); err == nil {
+		t.Fatal("expected an error decoding a partial item")
+	}
+
+	// the dynamic items are preceded by their offsets
+	optionals := []*Optionals{{Slot: 1}, {Header: &Header{Slot: 2}}, {Slot: 3}}
+	if buf, err = MarshalOptionalsList(optionals, 4); err != nil {
+		t.Fatal(err)
+	}
+	offset := 4 * len(optionals)
+	for indx, o := range optionals {
+		if found := ssz.ReadOffset(buf[indx*4:]); found != uint64(offset) {
+			t.Fatalf("expected offset %d for item %d but found %d", offset, indx, found)
+		}
+		offset += o.SizeSSZ()
+	}
+	if offset != len(buf) {
+		t.Fatalf("expected %d bytes but found %d", offset, len(buf))
+	}
+	optionals2, err := UnmarshalOptionalsList(buf, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(optionals, optionals2) {
+		t.Fatal("bad round trip of the dynamic items")
+	}
+	if _, err := UnmarshalOptionalsList(buf, 2); err == nil {
+		t.Fatal("expected an error decoding more items than the limit")
+	}
+
+	// the empty list has no bytes
+	if buf, err = MarshalOptionalsList(nil, 4); err != nil || len(buf) != 0 {
+		t.Fatalf("bad encoding of the empty list: %v", err)
+	}
+	if optionals2, err = UnmarshalOptionalsList(nil, 4); err != nil || len(optionals2) != 0 {
+		t.Fatalf("bad decoding of the empty list: %v", err)
+	}
+}
+
+func TestEmptyContainer(t *testing.T) {
+	empty := new(Empty)
+	if size := empty.SizeSSZ(); size != 0 {
+		t.Fatalf("expected size 0 but found %d", size)
+	}
+	buf, err := empty.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 0 {
+		t.Fatalf("expected no bytes but found %d", len(buf))
+	}
+	if err := empty.UnmarshalSSZ(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := empty.UnmarshalSSZ([]byte{0x1}); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+	// the root of a container without leaves is the zero chunk
+	root, err := empty.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != [32]byte{} {
+		t.Fatalf("expected the zero root but found %x", root)
+	}
+
+	obj := &EmptyFields{Slot: 1, Empty: new(Empty), Empties: []*Empty{{}, {}}}
+	if buf, err = obj.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 8 || obj.SizeSSZ() != 8 {
+		t.Fatalf("expected only the 8 bytes of the slot but found %d", len(buf))
+	}
+	obj2 := new(EmptyFields)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+	if root, err = obj.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+	slot := [32]byte{0x1}
+	empties := merkleizeChunks([][32]byte{{}, {}}, 2)
+	if expected := merkleizeChunks([][32]byte{slot, {}, empties}, 4); root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+}
+
+func TestCustomFields(t *testing.T) {
+	obj := &Reading{Slot: 3, Value: 1.5, Unit: "celsius"}
+
+	expected := ssz.MarshalUint64(nil, 3)
+	expected = ssz.MarshalUint64(expected, math.Float64bits(1.5))
+	expected = ssz.WriteOffset(expected, 20)
+	expected = append(expected, "celsius"...)
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) || obj.SizeSSZ() != len(expected) {
+		t.Fatalf("expected %x but found %x", expected, buf)
+	}
+	obj2 := new(Reading)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad round trip")
+	}
+
+	// the errors of the methods are returned
+	long := append(buf[:20:20], "degrees fahrenheit"...)
+	if err := obj2.UnmarshalSSZ(long); err != ssz.ErrBytesLength {
+		t.Fatalf("expected ErrBytesLength but found %v", err)
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var slot, value [32]byte
+	binary.LittleEndian.PutUint64(slot[:], 3)
+	binary.LittleEndian.PutUint64(value[:], math.Float64bits(1.5))
+	if expected := merkleizeChunks([][32]byte{slot, value, byteListRoot([]byte("celsius"), 16)}, 4); root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+
+	copied := new(Reading)
+	obj.CopyInto(copied)
+	if !reflect.DeepEqual(obj, copied) {
+		t.Fatal("bad copy")
+	}
+	if obj.IsZeroSSZ() || !new(Reading).IsZeroSSZ() {
+		t.Fatal("expected only the empty reading to be zero")
+	}
+}
+
+func TestVectorExactLength(t *testing.T) {
+	obj := &Balances{Values: []uint64{1}, Scores: []uint32{1, 2, 3, 4}, Counts: []uint16{5}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields, err := obj.MarshalFieldsSSZ("Scores")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the vector has 4 uints of 4 bytes, a short input cannot be decoded into fewer
+	// elements and a long one into more
+	cases := map[string]func() error{
+		"short": func() error {
+			return new(Balances).UnmarshalSSZ(buf[:20])
+		},
+		"long fixed": func() error {
+			// the extra uint moves the offsets of the lists
+			long := append(append(append([]byte{}, buf[:20]...), 0, 0, 0, 0), buf[20:]...)
+			return new(Balances).UnmarshalSSZ(long)
+		},
+		"short fields": func() error {
+			return new(Balances).UnmarshalFieldsSSZ(fields[:len(fields)-1])
+		},
+		"long fields": func() error {
+			return new(Balances).UnmarshalFieldsSSZ(append(append([]byte{}, fields...), 0, 0, 0, 0))
+		},
+	}
+	for name, fn := range cases {
+		if err := fn(); err == nil {
+			t.Fatalf("expected an error for the %s input", name)
+		}
+	}
+
+	header := &Header{Slot: 1}
+	if buf, err = header.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Header).UnmarshalSSZ(buf[:len(buf)-1]); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize for the short header but found %v", err)
+	}
+	if err := new(Header).UnmarshalSSZ(append(buf, 0)); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize for the long header but found %v", err)
+	}
+}
+
+func TestUnmarshalSSZWithOffset(t *testing.T) {
+	validators := []*Validator{
+		{Pubkey: [48]byte{1}, Balance: 32},
+		{Pubkey: [48]byte{2}, Balance: 16, Slashed: true},
+		{Pubkey: [48]byte{3}, Balance: 8},
+	}
+	header := &Header{Slot: 5}
+
+	// the fixed objects are followed by a dynamic object that takes the rest of the buffer
+	var buf []byte
+	var err error
+	for _, v := range validators {
+		if buf, err = v.MarshalSSZTo(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lists := &ByteLists{Pow2: []byte{1, 2, 3}, Chunk: []byte{4}}
+	if buf, err = header.MarshalSSZTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf, err = lists.MarshalSSZTo(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	offset := 0
+	for _, expected := range validators {
+		obj := new(Validator)
+		n, err := obj.UnmarshalSSZWithOffset(buf[offset:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != obj.SizeSSZ() {
+			t.Fatalf("expected %d bytes consumed but found %d", obj.SizeSSZ(), n)
+		}
+		if !reflect.DeepEqual(obj, expected) {
+			t.Fatalf("bad validator at offset %d", offset)
+		}
+		offset += n
+	}
+	objHeader := new(Header)
+	n, err := objHeader.UnmarshalSSZWithOffset(buf[offset:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != header.SizeSSZ() || !reflect.DeepEqual(objHeader, header) {
+		t.Fatalf("bad header with %d bytes consumed", n)
+	}
+	offset += n
+
+	objLists := new(ByteLists)
+	if n, err = objLists.UnmarshalSSZWithOffset(buf[offset:]); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(buf)-offset || n != lists.SizeSSZ() {
+		t.Fatalf("expected the dynamic object to consume %d bytes but found %d", len(buf)-offset, n)
+	}
+	if !bytes.Equal(objLists.Pow2, lists.Pow2) || !bytes.Equal(objLists.Chunk, lists.Chunk) {
+		t.Fatal("bad dynamic object")
+	}
+
+	// a short buffer cannot hold the fixed object
+	if _, err := new(Validator).UnmarshalSSZWithOffset(buf[:10]); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}
+
+func TestInlinedFields(t *testing.T) {
+	inlined := &Inlined{Timing: Timing{Slot: 10, Epoch: 2}, Root: [32]byte{1}, Data: []byte{1, 2, 3}}
+	flat := &Flat{Slot: 10, Epoch: 2, Root: [32]byte{1}, Data: []byte{1, 2, 3}}
+
+	// the inlined fields are siblings of the other fields in the encoding and the tree
+	buf, err := inlined.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := flat.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatal("the inlined fields are not encoded as the fields of the parent")
+	}
+	if inlined.SizeSSZ() != flat.SizeSSZ() {
+		t.Fatalf("expected size %d but found %d", flat.SizeSSZ(), inlined.SizeSSZ())
+	}
+	root, err := inlined.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := flat.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatal("the inlined fields are not leaves of the parent")
+	}
+
+	obj := new(Inlined)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, inlined) {
+		t.Fatal("bad decoded object")
+	}
+	if schema := obj.SSZSchema().String(); schema != "Container(Timing.Slot:uint64,Timing.Epoch:uint64,Root:Vector[byte,32],Data:List[byte,64])" {
+		t.Fatalf("bad schema %s", schema)
+	}
+}
+
+func TestUnmarshalOffsetBoundaries(t *testing.T) {
+	obj := &ByteLists{Pow2: []byte{1, 2}, NotPow2: []byte{3}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the offset of the empty 'Chunk' field is the size of the buffer
+	if offset := binary.LittleEndian.Uint32(buf[8:]); int(offset) != len(buf) {
+		t.Fatalf("expected the offset %d of the empty field but found %d", len(buf), offset)
+	}
+	decoded := new(ByteLists)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Chunk) != 0 || !bytes.Equal(decoded.Pow2, obj.Pow2) || !bytes.Equal(decoded.NotPow2, obj.NotPow2) {
+		t.Fatal("bad decoded object")
+	}
+
+	// the offsets of the fields at and beyond the end of the buffer
+	for _, o := range []uint32{uint32(len(buf)) + 1, math.MaxUint32} {
+		for _, pos := range []int{0, 4, 8} {
+			crafted := append([]byte{}, buf...)
+			binary.LittleEndian.PutUint32(crafted[pos:], o)
+			if err := new(ByteLists).UnmarshalSSZ(crafted); err != ssz.ErrOffset {
+				t.Fatalf("expected ErrOffset for the offset %d at %d but found %v", o, pos, err)
+			}
+		}
+	}
+
+	// the offsets of the items of a list of byte lists
+	blobs := &BlobTrie{Data: []byte{1}, Blobs: [][]byte{{2}, {}}}
+	if buf, err = blobs.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	decodedBlobs := new(BlobTrie)
+	if err := decodedBlobs.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(decodedBlobs.Blobs) != 2 || !bytes.Equal(decodedBlobs.Blobs[0], []byte{2}) || len(decodedBlobs.Blobs[1]) != 0 {
+		t.Fatalf("bad decoded blobs %v", decodedBlobs.Blobs)
+	}
+	// the list starts after the offset of 'Blobs' and the byte of 'Data'
+	list := int(binary.LittleEndian.Uint32(buf[4:]))
+	for _, o := range []uint32{uint32(len(buf)-list) + 1, math.MaxUint32} {
+		crafted := append([]byte{}, buf...)
+		binary.LittleEndian.PutUint32(crafted[list+4:], o)
+		if err := new(BlobTrie).UnmarshalSSZ(crafted); err != ssz.ErrOffset {
+			t.Fatalf("expected ErrOffset for the item offset %d but found %v", o, err)
+		}
+	}
+}
+
+func TestUnmarshalGarbage(t *testing.T) {
+	// insert inserts the bytes at pos and shifts the offsets that point beyond it
+	insert := func(buf []byte, pos int, garbage []byte, offsets ...int) []byte {
+		crafted := append(append(append([]byte{}, buf[:pos]...), garbage...), buf[pos:]...)
+		for _, o := range offsets {
+			binary.LittleEndian.PutUint32(crafted[o:], binary.LittleEndian.Uint32(crafted[o:])+uint32(len(garbage)))
+		}
+		return crafted
+	}
+	garbage := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	// the bytes between the fixed part and the first dynamic field
+	obj := &ByteLists{Pow2: []byte{1, 2}, NotPow2: []byte{3}, Chunk: []byte{4}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	crafted := insert(buf, 12, garbage, 0, 4, 8)
+	if err := new(ByteLists).UnmarshalSSZ(crafted); err != ssz.ErrInvalidVariableOffset {
+		t.Fatalf("expected ErrInvalidVariableOffset but found %v", err)
+	}
+	// the same bytes after the last field are part of it
+	decoded := new(ByteLists)
+	if err := decoded.UnmarshalSSZ(append(buf, garbage...)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded.Chunk, append([]byte{4}, garbage...)) {
+		t.Fatalf("bad last field %x", decoded.Chunk)
+	}
+
+	// the bytes inside the section of a nested container, the option of the union starts
+	// after the fixed part of the envelope (44 bytes) and the selector
+	env := &Envelope{Payload: &Envelope_Ping{Ping: &Ping{Nonce: 1, Peers: [][]byte{{1}}}}, Root: make([]byte, 32)}
+	if buf, err = env.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	crafted = insert(buf, 45+12, garbage, 45+8)
+	if err := new(Envelope).UnmarshalSSZ(crafted); err != ssz.ErrInvalidVariableOffset {
+		t.Fatalf("expected ErrInvalidVariableOffset but found %v", err)
+	}
+
+	// the bytes after a fixed container or a fixed option
+	if err := new(Timing).UnmarshalSSZ(make([]byte, 16+len(garbage))); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+	env.Payload = &Envelope_Number{Number: 1}
+	if buf, err = env.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Envelope).UnmarshalSSZ(append(buf, garbage...)); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}
+
+func TestNamedByteSlice(t *testing.T) {
+	obj := &Blobs{
+		Data:  Blob{1, 2},
+		Other: make(Blob, 100),
+		Fixed: Blob{1, 2, 3, 4, 5, 6, 7, 8},
+		List:  []Blob{{1}, {}, make(Blob, 16)},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Blobs)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, obj) {
+		t.Fatal("bad decoded object")
+	}
+
+	// each field has the limit of its own tags
+	var fixed [32]byte
+	copy(fixed[:], obj.Fixed)
+	items := make([][32]byte, len(obj.List))
+	for i, item := range obj.List {
+		items[i] = byteListRoot(item, 16)
+	}
+	expected := merkleizeChunks([][32]byte{
+		byteListRoot(obj.Data, 64),
+		byteListRoot(obj.Other, 128),
+		fixed,
+		mixInLength(merkleizeChunks(items, 4), len(items)),
+	}, 4)
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatal("bad root of the named byte slices")
+	}
+
+	cases := map[string]*Blobs{
+		"Data":  {Data: make(Blob, 65), Fixed: make(Blob, 8)},
+		"Other": {Other: make(Blob, 129), Fixed: make(Blob, 8)},
+		"Fixed": {Fixed: make(Blob, 7)},
+		"List":  {Fixed: make(Blob, 8), List: []Blob{make(Blob, 17)}},
+	}
+	for name, obj := range cases {
+		if _, err := obj.MarshalSSZ(); err == nil {
+			t.Fatalf("expected an error for the length of %s", name)
+		}
+	}
+}
+
+func TestReservedBytes(t *testing.T) {
+	reserved := &Reserved{Version: 3, Root: [32]byte{1}, Data: []byte{1, 2, 3}}
+	padded := &Padded{Version: 3, Root: [32]byte{1}, Data: []byte{1, 2, 3}}
+
+	// the reserved bytes are encoded and hashed as zero padding
+	buf, err := reserved.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := padded.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatal("the reserved bytes are not encoded as zeros")
+	}
+	root, err := reserved.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := padded.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatal("the reserved bytes are not hashed as zeros")
+	}
+
+	obj := new(Reserved)
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, reserved) {
+		t.Fatal("bad decoded object")
+	}
+	if schema := obj.SSZSchema().String(); schema != "Container(Version:uint32,_:Vector[byte,4],Root:Vector[byte,32],_:Vector[byte,40],Data:List[byte,64])" {
+		t.Fatalf("bad schema %s", schema)
+	}
+
+	// the reserved bytes cannot be selected as a field
+	if _, err := reserved.MarshalFieldsSSZ("_"); err != ssz.ErrUnknownField {
+		t.Fatalf("expected ErrUnknownField but found %v", err)
+	}
+
+	// the reserved bytes must be zero
+	for _, pad := range []func(p *Padded){
+		func(p *Padded) { p.Pad1[3] = 1 },
+		func(p *Padded) { p.Pad2[0] = 1 },
+	} {
+		p := *padded
+		pad(&p)
+		buf, err := p.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := new(Reserved).UnmarshalSSZ(buf); err != ssz.ErrReservedBytes {
+			t.Fatalf("expected ErrReservedBytes but found %v", err)
+		}
+	}
+}
+
+func BenchmarkHashTreeRootWide(b *testing.B) {
+	obj := &Wide{A0: 1, B0: [32]byte{1}, E0: make([]byte, 100), E1: make([]uint64, 10)}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// a new hasher grows its buffer while the fields are hashed
+		if err := obj.HashTreeRootWith(ssz.NewHasher()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalSigned(b *testing.B) {
+	obj := &Signed{Slot: 1, PubKey: make([]byte, 48), Signature: make([]byte, 96), Proof: make([]byte, 96)}
+	buf := make([]byte, 0, obj.SizeSSZ())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := obj.MarshalSSZTo(buf[:0]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalSigned(b *testing.B) {
+	buf, err := (&Signed{Slot: 1, PubKey: make([]byte, 48), Signature: make([]byte, 96), Proof: make([]byte, 96)}).MarshalSSZ()
+	if err != nil {
+		b.Fatal(err)
+	}
+	obj := new(Signed)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSingleElementRoots(t *testing.T) {
+	uintChunk := func(v uint64) [32]byte {
+		var chunk [32]byte
+		binary.LittleEndian.PutUint64(chunk[:], v)
+		return chunk
+	}
+	timingRoot := func(t *Timing) [32]byte {
+		return merkleizeChunks([][32]byte{uintChunk(t.Slot), uintChunk(t.Epoch)}, 2)
+	}
+
+	root := [32]byte{1, 2, 3}
+	item := &Timing{Slot: 5, Epoch: 6}
+	obj := &SingleElements{
+		Uints:      []uint64{7},
+		OneUint:    []uint64{8},
+		Bytes:      []byte{9},
+		Roots:      [][32]byte{root},
+		OneRoot:    [][32]byte{root},
+		Blobs:      [][]byte{{1, 2}},
+		Items:      []*Timing{item},
+		OneItem:    []*Timing{item},
+		UintVector: []uint64{10},
+		RootVector: [1][32]byte{root},
+		ItemVector: []*Timing{item},
+	}
+
+	// the single element is padded to the limit of the list before its length is mixed in
+	fields := map[string][32]byte{
+		"Uints":      mixInLength(merkleizeChunks([][32]byte{uintChunk(7)}, 4), 1),
+		"OneUint":    mixInLength(uintChunk(8), 1),
+		"Bytes":      byteListRoot([]byte{9}, 64),
+		"Roots":      mixInLength(merkleizeChunks([][32]byte{root}, 8), 1),
+		"OneRoot":    mixInLength(root, 1),
+		"Blobs":      mixInLength(merkleizeChunks([][32]byte{byteListRoot([]byte{1, 2}, 16)}, 4), 1),
+		"Items":      mixInLength(merkleizeChunks([][32]byte{timingRoot(item)}, 4), 1),
+		"OneItem":    mixInLength(timingRoot(item), 1),
+		"UintVector": uintChunk(10),
+		"RootVector": root,
+		"ItemVector": timingRoot(item),
+	}
+	names := []string{"Uints", "OneUint", "Bytes", "Roots", "OneRoot", "Blobs", "Items", "OneItem", "UintVector", "RootVector", "ItemVector"}
+	leaves := [][32]byte{}
+	for _, name := range names {
+		leaves = append(leaves, fields[name])
+	}
+
+	found, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each field is checked with its proof against the root of the container
+	// (the 11 fields are padded to 16 leaves)
+	for indx, name := range names {
+		branch, err := obj.MerkleProof(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof := &ssz.Proof{Index: 16 + indx, Leaf: append([]byte{}, leaves[indx][:]...)}
+		for _, h := range branch {
+			proof.Hashes = append(proof.Hashes, append([]byte{}, h[:]...))
+		}
+		if ok, err := ssz.VerifyProof(found[:], proof); err != nil || !ok {
+			t.Fatalf("bad root of the single element of %s", name)
+		}
+	}
+	if expected := merkleizeChunks(leaves, uint64(len(leaves))); found != expected {
+		t.Fatalf("expected the root %x but found %x", expected, found)
+	}
+}
+
+func TestMarshalSSZPooled(t *testing.T) {
+	obj := &Timing{Slot: 1, Epoch: 2}
+	owned, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := obj.MarshalSSZPooled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), owned) {
+		t.Fatalf("expected %x but found %x", owned, buf.Bytes())
+	}
+	kept := buf.Owned()
+	buf.Release()
+
+	// the next encodings may reuse the released buffer but not the owned ones
+	other := &Timing{Slot: 3, Epoch: 4}
+	for i := 0; i < 4; i++ {
+		next, err := other.MarshalSSZPooled()
+		if err != nil {
+			t.Fatal(err)
+		}
+		next.Release()
+		if _, err := other.MarshalSSZ(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(kept, owned) {
+		t.Fatalf("the owned copy changed to %x", kept)
+	}
+	if again, _ := obj.MarshalSSZ(); !bytes.Equal(again, owned) || &again[0] == &owned[0] {
+		t.Fatal("the owned buffers are not new buffers")
+	}
+
+	expectPanic := func(name string, f func()) {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected %s to panic", name)
+			}
+		}()
+		f()
+	}
+	expectPanic("Bytes after Release", func() { buf.Bytes() })
+	expectPanic("Release twice", buf.Release)
+}
+
+func TestOneofUnion(t *testing.T) {
+	ping := &Ping{Nonce: 7, Peers: [][]byte{{1, 2}}}
+	pingRoot, err := ping.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uint64Leaf := func(i uint64) (leaf [32]byte) {
+		binary.LittleEndian.PutUint64(leaf[:], i)
+		return
+	}
+
+	cases := []struct {
+		payload  isEnvelope_Payload
+		selector byte
+		value    []byte
+		root     [32]byte
+	}{
+		{nil, 0, nil, [32]byte{}},
+		{&Envelope_Number{Number: 5}, 1, ssz.MarshalUint64(nil, 5), uint64Leaf(5)},
+		{&Envelope_Data{Data: []byte{1, 2, 3}}, 2, []byte{1, 2, 3}, byteListRoot([]byte{1, 2, 3}, 64)},
+		{&Envelope_Ping{Ping: ping}, 3, nil, pingRoot},
+	}
+	for _, c := range cases {
+		obj := &Envelope{Slot: 1, Payload: c.payload, Root: make([]byte, 32)}
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the union is the selector followed by the value of the option (in declaration order)
+		union := buf[44:]
+		if ssz.ReadOffset(buf[8:12]) != 44 || union[0] != c.selector {
+			t.Fatalf("expected the selector %d at the offset of the union", c.selector)
+		}
+		if c.value != nil && !bytes.Equal(union[1:], c.value) {
+			t.Fatalf("expected the value %x but found %x", c.value, union[1:])
+		}
+
+		obj2 := new(Envelope)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj.Payload, obj2.Payload) {
+			t.Fatalf("bad round trip of the selector %d", c.selector)
+		}
+
+		// the root of the union is the root of the option mixed in with the selector
+		unionRoot := mixInLength(c.root, int(c.selector))
+		expected := merkleizeChunks([][32]byte{uint64Leaf(1), unionRoot, {}}, 4)
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("expected the root %x but found %x for the selector %d", expected, root, c.selector)
+		}
+		tree, err := obj.GetTree()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tree.Hash(), root[:]) {
+			t.Fatalf("bad tree root for the selector %d", c.selector)
+		}
+
+		dst := &Envelope{Payload: &Envelope_Number{Number: 9}}
+		obj.CopyInto(dst)
+		if !reflect.DeepEqual(obj.Payload, dst.Payload) {
+			t.Fatalf("bad copy of the selector %d", c.selector)
+		}
+		if c.payload != nil && dst.Payload == obj.Payload {
+			t.Fatal("the copy shares the option")
+		}
+		if (&Envelope{Payload: c.payload}).IsZeroSSZ() != (c.payload == nil) {
+			t.Fatalf("bad zero check of the selector %d", c.selector)
+		}
+
+		varint, err := obj.MarshalVarint()
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj3 := new(Envelope)
+		if err := obj3.UnmarshalVarint(varint); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj.Payload, obj3.Payload) {
+			t.Fatalf("bad varint round trip of the selector %d", c.selector)
+		}
+	}
+
+	buf, err := (&Envelope{Root: make([]byte, 32)}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Envelope).UnmarshalSSZ(append(buf[:44:44], 4)); err != ssz.ErrUnionSelector {
+		t.Fatalf("expected an error for an unknown selector but found %v", err)
+	}
+	if err := new(Envelope).UnmarshalSSZ(append(buf[:44:44], 1, 2)); err != ssz.ErrSize {
+		t.Fatalf("expected an error for a short fixed option but found %v", err)
+	}
+	if err := new(Envelope).UnmarshalSSZ(append(buf[:44:44], 0, 0)); err != ssz.ErrSize {
+		t.Fatalf("expected an error for the bytes after the nil selector but found %v", err)
+	}
+	if _, err := (&Envelope{Payload: (*Envelope_Data)(nil), Root: make([]byte, 32)}).MarshalSSZ(); err != ssz.ErrUnionNil {
+		t.Fatalf("expected an error for a nil option but found %v", err)
+	}
+	if schema := new(Envelope).SSZSchemaString(); !strings.Contains(schema, "Payload:Union[None,uint64,List[byte,64],Ping]") {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+}
+
+func TestFramedField(t *testing.T) {
+	obj := &Frame{Version: 1, PayloadLen: 3, Payload: []byte{1, 2, 3}, Checksum: [4]byte{4, 5, 6, 7}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the payload is written in place, sized by the length field instead of an offset
+	expected := []byte{1, 0, 3, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("bad encoding %x", buf)
+	}
+	if size := obj.SizeSSZ(); size != len(expected) {
+		t.Fatalf("bad size %d", size)
+	}
+	decoded := new(Frame)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, decoded) {
+		t.Fatal("bad round trip")
+	}
+
+	// the root is the one of the fields, the payload is a byte list
+	var version, length, checksum [32]byte
+	binary.LittleEndian.PutUint16(version[:], 1)
+	binary.LittleEndian.PutUint32(length[:], 3)
+	copy(checksum[:], obj.Checksum[:])
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := merkleizeChunks([][32]byte{version, length, byteListRoot(obj.Payload, 256), checksum}, 4); root != expected {
+		t.Fatalf("bad root %x", root)
+	}
+
+	// the length field must match the payload
+	obj.PayloadLen = 4
+	if _, err := obj.MarshalSSZ(); err != ssz.ErrLengthField {
+		t.Fatalf("expected ErrLengthField but found %v", err)
+	}
+	for _, size := range []int{len(buf) - 1, len(buf) + 1} {
+		crafted := make([]byte, size)
+		copy(crafted, buf)
+		if err := new(Frame).UnmarshalSSZ(crafted); err != ssz.ErrLengthField {
+			t.Fatalf("expected ErrLengthField for %d bytes but found %v", size, err)
+		}
+	}
+	crafted := append([]byte{}, buf...)
+	binary.LittleEndian.PutUint32(crafted[2:], math.MaxUint32)
+	if err := new(Frame).UnmarshalSSZ(crafted); err != ssz.ErrLengthField {
+		t.Fatalf("expected ErrLengthField but found %v", err)
+	}
+	if err := new(Frame).UnmarshalSSZ(buf[:9]); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+
+	// the framed containers are dynamic elements of a list
+	frames := &Frames{Slot: 1, Frames: []*Frame{
+		{Version: 1, PayloadLen: 2, Payload: []byte{1, 2}},
+		{Version: 2, Payload: []byte{}},
+	}}
+	if buf, err = frames.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	decodedFrames := new(Frames)
+	if err := decodedFrames.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(frames, decodedFrames) {
+		t.Fatal("bad round trip of the list")
+	}
+
+	// the schema splits the fields at the length of the payload
+	obj.PayloadLen = 3
+	if buf, err = obj.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	fields, err := obj.SSZSchema().Split(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fields[2], obj.Payload) || !bytes.Equal(fields[3], obj.Checksum[:]) {
+		t.Fatalf("bad fields %x", fields)
+	}
+}
+
+func TestInterleavedFields(t *testing.T) {
+	obj := &Interleaved{A: []byte{1, 2, 3}, B: 4, C: []byte{5, 6}, D: 7}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fixed fields and the offsets are written in declaration order
+	// followed by the dynamic fields
+	expected := []byte{
+		24, 0, 0, 0, // offset of A
+		4, 0, 0, 0, 0, 0, 0, 0, // B
+		27, 0, 0, 0, // offset of C
+		7, 0, 0, 0, 0, 0, 0, 0, // D
+		1, 2, 3, // A
+		5, 6, // C
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("bad encoding %x", buf)
+	}
+	if size := obj.SizeSSZ(); size != len(expected) {
+		t.Fatalf("bad size %d", size)
+	}
+	if offsets := obj.OffsetsSSZ(); !reflect.DeepEqual(offsets, []uint32{24, 27}) {
+		t.Fatalf("bad offsets %v", offsets)
+	}
+	// the offsets are relative to the start of the object
+	prefixed, err := obj.MarshalSSZTo([]byte{0xff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(prefixed[1:], expected) {
+		t.Fatalf("bad encoding after a prefix %x", prefixed)
+	}
+
+	decoded := new(Interleaved)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, decoded) {
+		t.Fatal("bad round trip")
+	}
+
+	// the root is the one of the fields in declaration order
+	var b, d [32]byte
+	binary.LittleEndian.PutUint64(b[:], 4)
+	binary.LittleEndian.PutUint64(d[:], 7)
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := merkleizeChunks([][32]byte{byteListRoot(obj.A, 32), b, byteListRoot(obj.C, 32), d}, 4); root != expected {
+		t.Fatalf("bad root %x", root)
+	}
+
+	// the empty dynamic fields point to the end of the previous ones
+	empty := &Interleaved{B: 4, D: 7}
+	if buf, err = empty.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 24 || binary.LittleEndian.Uint32(buf[0:]) != 24 || binary.LittleEndian.Uint32(buf[12:]) != 24 {
+		t.Fatalf("bad encoding of the empty fields %x", buf)
+	}
+	decoded = new(Interleaved)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.A) != 0 || decoded.B != 4 || len(decoded.C) != 0 || decoded.D != 7 {
+		t.Fatal("bad round trip of the empty fields")
+	}
+}
+
+func TestBitfieldTypes(t *testing.T) {
+	bits := NewVotesBits(10)
+	if bits.Len() != 10 || bits.Count() != 0 || !bytes.Equal(bits, []byte{0, 0x4}) {
+		t.Fatalf("bad new bitlist %x", []byte(bits))
+	}
+	for _, indx := range []uint64{0, 3, 9} {
+		bits.Set(indx, true)
+	}
+	bits.Set(3, false)
+	// the bits beyond the length are not set
+	bits.Set(10, true)
+	bits.Set(100, true)
+	if bits.Count() != 2 || !bits.Get(0) || bits.Get(3) || !bits.Get(9) || bits.Get(10) || bits.Get(100) {
+		t.Fatalf("bad bits %x", []byte(bits))
+	}
+	if !bytes.Equal(bits, []byte{0x1, 0x6}) {
+		t.Fatalf("bad bitlist %x", []byte(bits))
+	}
+	// a bitlist without the length bit has no bits
+	if empty := (VotesBits{0x1, 0x0}); empty.Len() != 0 || empty.Count() != 0 || empty.Get(0) {
+		t.Fatal("expected no bits without the length bit")
+	}
+
+	mask := NewVotesMask()
+	if mask.Len() != 12 || mask.Count() != 0 || len(mask) != 2 {
+		t.Fatalf("bad new bitvector %x", []byte(mask))
+	}
+	mask.Set(1, true)
+	mask.Set(11, true)
+	mask.Set(12, true)
+	if mask.Count() != 2 || !mask.Get(11) || mask.Get(12) || !bytes.Equal(mask, []byte{0x2, 0x8}) {
+		t.Fatalf("bad bitvector %x", []byte(mask))
+	}
+	// a short bitvector has no bits beyond its bytes
+	if short := (VotesMask{0xff}); short.Get(8) || short.Count() != 8 {
+		t.Fatal("bad bits of a short bitvector")
+	}
+
+	// the types encode and hash as the fields of the container
+	obj := &Votes{Slot: 1, Bits: bits, Mask: mask}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bitsBuf, err := bits.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	maskBuf, err := mask.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf[12:14], maskBuf) || !bytes.Equal(buf[14:], bitsBuf) {
+		t.Fatalf("bad encoding %x", buf)
+	}
+	decoded := new(Votes)
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if VotesBits(decoded.Bits).Count() != 2 || !VotesMask(decoded.Mask).Get(11) {
+		t.Fatal("bad decoded bits")
+	}
+
+	var slot, bitsChunk [32]byte
+	slot[0] = 1
+	bitsChunk[0], bitsChunk[1] = 0x1, 0x2
+	bitsRoot, err := bits.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := mixInLength(merkleizeChunks([][32]byte{bitsChunk}, 8), 10); bitsRoot != expected {
+		t.Fatalf("bad bitlist root %x", bitsRoot)
+	}
+	maskRoot, err := mask.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := merkleizeChunks([][32]byte{slot, bitsRoot, maskRoot}, 4); root != expected {
+		t.Fatalf("bad root %x", root)
+	}
+
+	decodedBits := new(VotesBits)
+	if err := decodedBits.UnmarshalSSZ(bitsBuf); err != nil || !bytes.Equal(*decodedBits, bits) {
+		t.Fatalf("bad bitlist round trip: %v", err)
+	}
+	decodedMask := new(VotesMask)
+	if err := decodedMask.UnmarshalSSZ(maskBuf); err != nil || !bytes.Equal(*decodedMask, mask) {
+		t.Fatalf("bad bitvector round trip: %v", err)
+	}
+
+	// invalid bitlists and bitvectors
+	if err := new(VotesBits).UnmarshalSSZ([]byte{0x1, 0x0}); err == nil {
+		t.Fatal("expected error for a bitlist without the length bit")
+	}
+	if err := new(VotesBits).UnmarshalSSZ(NewVotesBits(2049)); err == nil {
+		t.Fatal("expected error for a bitlist over its maximum length")
+	}
+	if _, err := (VotesBits{}).HashTreeRoot(); err != ssz.ErrEmptyBitlist {
+		t.Fatalf("expected ErrEmptyBitlist but found %v", err)
+	}
+	if err := new(VotesMask).UnmarshalSSZ([]byte{0x0, 0x10}); err != ssz.ErrInvalidBitvector {
+		t.Fatalf("expected ErrInvalidBitvector but found %v", err)
+	}
+	if err := new(VotesMask).UnmarshalSSZ([]byte{0x0}); err != ssz.ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+	if _, err := (VotesMask{0x0, 0x10}).MarshalSSZ(); err != ssz.ErrInvalidBitvector {
+		t.Fatalf("expected ErrInvalidBitvector but found %v", err)
+	}
+	if _, err := (VotesMask{0x0}).MarshalSSZ(); err != ssz.ErrBytesLength {
+		t.Fatalf("expected ErrBytesLength but found %v", err)
+	}
+}
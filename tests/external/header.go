@@ -0,0 +1,12 @@
+package external
+
+// Header is a fixed struct of another package
+type Header struct {
+	Slot uint64
+	Root [32]byte
+}
+
+// Body is a dynamic struct of another package
+type Body struct {
+	Data []byte `ssz-max:"64"`
+}
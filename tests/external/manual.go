@@ -0,0 +1,126 @@
+package external
+
+import ssz "github.com/photon-storage/fastssz"
+
+// Manual is a fixed struct with hand-written methods
+type Manual struct {
+	A uint64
+}
+
+// SizeSSZ implements the fastssz Marshaler interface
+func (m *Manual) SizeSSZ() int {
+	return 8
+}
+
+// MarshalSSZ implements the fastssz Marshaler interface
+func (m *Manual) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(m)
+}
+
+// MarshalSSZTo implements the fastssz Marshaler interface
+func (m *Manual) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return ssz.MarshalUint64(buf, m.A), nil
+}
+
+// UnmarshalSSZ implements the fastssz Unmarshaler interface
+func (m *Manual) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 8 {
+		return ssz.ErrSize
+	}
+	m.A = ssz.UnmarshallUint64(buf)
+	return nil
+}
+
+// HashTreeRoot implements the fastssz HashRoot interface
+func (m *Manual) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(m)
+}
+
+// HashTreeRootWith implements the fastssz HashRoot interface
+func (m *Manual) HashTreeRootWith(hh *ssz.Hasher) error {
+	hh.PutUint64(m.A)
+	return nil
+}
+
+// ManualDynamic is a dynamic struct with hand-written methods
+type ManualDynamic struct {
+	B []byte
+}
+
+// SizeSSZ implements the fastssz Marshaler interface
+func (m *ManualDynamic) SizeSSZ() int {
+	return len(m.B)
+}
+
+// MarshalSSZ implements the fastssz Marshaler interface
+func (m *ManualDynamic) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(m)
+}
+
+// MarshalSSZTo implements the fastssz Marshaler interface
+func (m *ManualDynamic) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return append(buf, m.B...), nil
+}
+
+// UnmarshalSSZ implements the fastssz Unmarshaler interface
+func (m *ManualDynamic) UnmarshalSSZ(buf []byte) error {
+	m.B = append(m.B[:0], buf...)
+	return nil
+}
+
+// HashTreeRoot implements the fastssz HashRoot interface
+func (m *ManualDynamic) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(m)
+}
+
+// HashTreeRootWith implements the fastssz HashRoot interface
+func (m *ManualDynamic) HashTreeRootWith(hh *ssz.Hasher) error {
+	hh.PutBytes(m.B)
+	return nil
+}
+
+// Signed is a fixed struct with hand-written methods that is larger than a uint
+type Signed struct {
+	Slot      uint64
+	Signature [96]byte
+}
+
+// SizeSSZ implements the fastssz Marshaler interface
+func (s *Signed) SizeSSZ() int {
+	return 104
+}
+
+// MarshalSSZ implements the fastssz Marshaler interface
+func (s *Signed) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo implements the fastssz Marshaler interface
+func (s *Signed) MarshalSSZTo(buf []byte) ([]byte, error) {
+	buf = ssz.MarshalUint64(buf, s.Slot)
+	return append(buf, s.Signature[:]...), nil
+}
+
+// UnmarshalSSZ implements the fastssz Unmarshaler interface
+func (s *Signed) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 104 {
+		return ssz.ErrSize
+	}
+	s.Slot = ssz.UnmarshallUint64(buf[0:8])
+	copy(s.Signature[:], buf[8:104])
+	return nil
+}
+
+// HashTreeRoot implements the fastssz HashRoot interface
+func (s *Signed) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith implements the fastssz HashRoot interface
+func (s *Signed) HashTreeRootWith(hh *ssz.Hasher) error {
+	indx := hh.Index()
+	hh.PutUint64(s.Slot)
+	hh.PutBytes(s.Signature[:])
+	hh.Merkleize(indx)
+	return nil
+}
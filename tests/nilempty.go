@@ -0,0 +1,10 @@
+package tests
+
+// NilLists has lists of every kind that are decoded to nil
+// since its encoding is generated with the nil-empty-lists flag
+type NilLists struct {
+	Data   []byte   `ssz-max:"32"`
+	Values []uint64 `ssz-max:"8"`
+	Chunks []*Chunk `ssz-max:"4"`
+	Blobs  [][]byte `ssz-max:"4,8"`
+}
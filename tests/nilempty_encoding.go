@@ -0,0 +1,505 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 706d2f4126375004aea5dac90490d9d58b3bbd1f34767c02957de884c3cec549
+package tests
+
+import (
+	ssz "github.com/photon-storage/fastssz"
+)
+
+// MarshalSSZ ssz marshals the NilLists object to a new buffer owned by the caller
+func (x *NilLists) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(x)
+}
+
+// MarshalSSZPooled ssz marshals the NilLists object to a borrowed buffer of ssz.DefaultBufferPool,
+// its bytes must not be retained after it is released
+func (x *NilLists) MarshalSSZPooled() (*ssz.Buffer, error) {
+	return ssz.MarshalSSZPooled(x)
+}
+
+// MarshalSSZTo ssz marshals the NilLists object to a target array
+func (x *NilLists) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(16)
+
+	// Offset (0) 'Data'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(x.Data)
+
+	// Offset (1) 'Values'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(x.Values) * 8
+
+	// Offset (2) 'Chunks'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	offset += len(x.Chunks) * 33
+
+	// Offset (3) 'Blobs'
+	if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+		return
+	}
+	for ii := 0; ii < len(x.Blobs); ii++ {
+		offset += 4
+		offset += len(x.Blobs[ii])
+	}
+
+	// Field (0) 'Data'
+	if len(x.Data) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, x.Data...)
+
+	// Field (1) 'Values'
+	if len(x.Values) > 8 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(x.Values); ii++ {
+		dst = ssz.MarshalUint64(dst, x.Values[ii])
+	}
+
+	// Field (2) 'Chunks'
+	if len(x.Chunks) > 4 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(x.Chunks); ii++ {
+		if dst, err = x.Chunks[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Blobs'
+	if len(x.Blobs) > 4 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(x.Blobs)
+		for ii := 0; ii < len(x.Blobs); ii++ {
+			if dst, err = ssz.SafeWriteOffset(dst, offset); err != nil {
+				return
+			}
+			offset += len(x.Blobs[ii])
+		}
+	}
+	for ii := 0; ii < len(x.Blobs); ii++ {
+		if len(x.Blobs[ii]) > 8 {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, x.Blobs[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the NilLists object
+func (x *NilLists) UnmarshalSSZ(buf []byte) error {
+	return x.UnmarshalSSZArena(buf, nil)
+}
+
+// UnmarshalSSZArena ssz unmarshals the NilLists object with the memory of the allocator
+func (x *NilLists) UnmarshalSSZArena(buf []byte, alloc ssz.Allocator) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 16 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3 uint64
+
+	// Offset (0) 'Data'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 16 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Values'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'Chunks'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'Blobs'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Data'
+	{
+		buf = tail[o0:o1]
+		if uint64(len(buf)) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(x.Data) == 0 {
+			x.Data = ssz.AllocBytes(alloc, len(buf))[:0]
+		}
+		x.Data = append(x.Data[:0], buf...)
+		if len(x.Data) == 0 {
+			x.Data = nil
+		}
+	}
+
+	// Field (1) 'Values'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DivideInt2(len(buf), 8, 8)
+		if err != nil {
+			return err
+		}
+		x.Values = ssz.AllocExtend(alloc, x.Values, num)
+		for ii := 0; ii < num; ii++ {
+			x.Values[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+		if len(x.Values) == 0 {
+			x.Values = nil
+		}
+	}
+
+	// Field (2) 'Chunks'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return err
+		}
+		x.Chunks = ssz.AllocExtend(alloc, x.Chunks, num)
+		for ii := 0; ii < num; ii++ {
+			if x.Chunks[ii] == nil {
+				x.Chunks[ii] = ssz.AllocNew[Chunk](alloc)
+			}
+			if err = x.Chunks[ii].UnmarshalSSZArena(buf[ii*33:(ii+1)*33], alloc); err != nil {
+				return err
+			}
+		}
+		if len(x.Chunks) == 0 {
+			x.Chunks = nil
+		}
+	}
+
+	// Field (3) 'Blobs'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		x.Blobs = ssz.AllocSlice[[]byte](alloc, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if uint64(len(buf)) > 8 {
+				return ssz.ErrBytesLength
+			}
+			if cap(x.Blobs[indx]) == 0 {
+				x.Blobs[indx] = ssz.AllocBytes(alloc, len(buf))[:0]
+			}
+			x.Blobs[indx] = append(x.Blobs[indx][:0], buf...)
+			if len(x.Blobs[indx]) == 0 {
+				x.Blobs[indx] = nil
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(x.Blobs) == 0 {
+			x.Blobs = nil
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZWithOffset ssz unmarshals the NilLists object at the start of the buffer and
+// returns the number of bytes consumed. The end of a dynamic object is not
+// encoded, it consumes the whole buffer
+func (x *NilLists) UnmarshalSSZWithOffset(buf []byte) (int, error) {
+	if err := x.UnmarshalSSZ(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the NilLists object
+func (x *NilLists) SizeSSZ() (size int) {
+	size = 16
+
+	// Field (0) 'Data'
+	size += len(x.Data)
+
+	// Field (1) 'Values'
+	size += len(x.Values) * 8
+
+	// Field (2) 'Chunks'
+	size += len(x.Chunks) * 33
+
+	// Field (3) 'Blobs'
+	for ii := 0; ii < len(x.Blobs); ii++ {
+		size += 4
+		size += len(x.Blobs[ii])
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the NilLists object
+func (x *NilLists) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(x)
+}
+
+// HashTreeRootWith ssz hashes the NilLists object with a hasher
+func (x *NilLists) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	hh.Reserve(4)
+
+	// Field (0) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(x.Data))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(x.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (1) 'Values'
+	{
+		if len(x.Values) > 8 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(x.Values)
+		hh.FillUpTo32()
+		numItems := uint64(len(x.Values))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(8, numItems, 8))
+	}
+
+	// Field (2) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(x.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range x.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	// Field (3) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(x.Blobs))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range x.Blobs {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 8 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MerkleProof returns the merkle branch that proves the field against the root of the NilLists object
+func (x *NilLists) MerkleProof(field string) (proof [][32]byte, err error) {
+
+	var leaf int
+	switch field {
+	case "Data":
+		leaf = 0
+	case "Values":
+		leaf = 1
+	case "Chunks":
+		leaf = 2
+	case "Blobs":
+		leaf = 3
+	default:
+		err = ssz.ErrUnknownField
+		return
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+
+	// Field (0) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(x.Data))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.AppendBytes32(x.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (1) 'Values'
+	{
+		if len(x.Values) > 8 {
+			err = ssz.ErrListTooBig
+			return
+		}
+		subIndx := hh.Index()
+		hh.AppendUint64Array(x.Values)
+		hh.FillUpTo32()
+		numItems := uint64(len(x.Values))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(8, numItems, 8))
+	}
+
+	// Field (2) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(x.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range x.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	// Field (3) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(x.Blobs))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range x.Blobs {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 8 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	return hh.Branch(indx, leaf)
+
+}
+
+// IsZeroSSZ returns true if all the fields of the NilLists object are zero
+func (x *NilLists) IsZeroSSZ() bool {
+	// Field (0) 'Data'
+	if len(x.Data) != 0 {
+		return false
+	}
+
+	// Field (1) 'Values'
+	if len(x.Values) != 0 {
+		return false
+	}
+
+	// Field (2) 'Chunks'
+	if len(x.Chunks) != 0 {
+		return false
+	}
+
+	// Field (3) 'Blobs'
+	if len(x.Blobs) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// CopyInto copies the NilLists object into dst reusing the memory of dst
+func (x *NilLists) CopyInto(dst *NilLists) {
+	// Field (0) 'Data'
+	dst.Data = append(dst.Data[:0], x.Data...)
+
+	// Field (1) 'Values'
+	dst.Values = append(dst.Values[:0], x.Values...)
+
+	// Field (2) 'Chunks'
+	if cap(dst.Chunks) < len(x.Chunks) {
+		dst.Chunks = make([]*Chunk, len(x.Chunks))
+	} else {
+		dst.Chunks = dst.Chunks[:len(x.Chunks)]
+	}
+	for ii := range x.Chunks {
+		if x.Chunks[ii] == nil {
+			dst.Chunks[ii] = nil
+		} else {
+			if dst.Chunks[ii] == nil {
+				dst.Chunks[ii] = new(Chunk)
+			}
+			x.Chunks[ii].CopyInto(dst.Chunks[ii])
+		}
+	}
+
+	// Field (3) 'Blobs'
+	if cap(dst.Blobs) < len(x.Blobs) {
+		dst.Blobs = make([][]byte, len(x.Blobs))
+	} else {
+		dst.Blobs = dst.Blobs[:len(x.Blobs)]
+	}
+	for ii := range x.Blobs {
+		dst.Blobs[ii] = append(dst.Blobs[ii][:0], x.Blobs[ii]...)
+	}
+}
+
+// SSZSchemaString returns the canonical ssz type signature of the NilLists object
+func (x *NilLists) SSZSchemaString() string {
+	return "Container(Data:List[byte,32],Values:List[uint64,8],Chunks:List[Chunk,4],Blobs:List[List[byte,8],4])"
+}
+
+// SSZSchema returns the layout of the fields of the NilLists object
+func (x *NilLists) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Name: "NilLists",
+		Fields: []*ssz.SchemaField{
+			{Name: "Data", Type: "List[byte,32]"},
+			{Name: "Values", Type: "List[uint64,8]"},
+			{Name: "Chunks", Type: "List[Chunk,4]"},
+			{Name: "Blobs", Type: "List[List[byte,8],4]"},
+		},
+	}
+}
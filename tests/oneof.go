@@ -0,0 +1,39 @@
+package tests
+
+// Envelope has the shape of the structs generated by protoc-gen-go for a message
+// with a oneof field, the Payload is encoded as a union of the options of the oneof
+type Envelope struct {
+	sizeCache int32
+
+	Slot    uint64             `protobuf:"varint,1,opt,name=slot,proto3"`
+	Payload isEnvelope_Payload `protobuf_oneof:"payload"`
+	Root    []byte             `protobuf:"bytes,5,opt,name=root,proto3" ssz-size:"32"`
+}
+
+type isEnvelope_Payload interface {
+	isEnvelope_Payload()
+}
+
+type Envelope_Number struct {
+	Number uint64 `protobuf:"varint,2,opt,name=number,proto3,oneof"`
+}
+
+type Envelope_Data struct {
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3,oneof" ssz-max:"64"`
+}
+
+type Envelope_Ping struct {
+	Ping *Ping `protobuf:"bytes,4,opt,name=ping,proto3,oneof"`
+}
+
+func (*Envelope_Number) isEnvelope_Payload() {}
+
+func (*Envelope_Data) isEnvelope_Payload() {}
+
+func (*Envelope_Ping) isEnvelope_Payload() {}
+
+// Ping is a message held by an option of the oneof
+type Ping struct {
+	Nonce uint64   `protobuf:"varint,1,opt,name=nonce,proto3"`
+	Peers [][]byte `protobuf:"bytes,2,rep,name=peers,proto3" ssz-max:"4,32"`
+}
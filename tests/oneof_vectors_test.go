@@ -0,0 +1,89 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 8db0cc97738c6bc2df7e0182dc4ab2b3a28b4b3e8a11987a8ee5ae461a008c8b
+package tests
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ssz "github.com/photon-storage/fastssz"
+)
+
+// TestSSZTestVectorsEnvelope writes random test vectors of the Envelope object
+// to the folder in the SSZ_TEST_VECTORS environment variable
+func TestSSZTestVectorsEnvelope(t *testing.T) {
+	dir := os.Getenv("SSZ_TEST_VECTORS")
+	if dir == "" {
+		t.Skip("SSZ_TEST_VECTORS is not set")
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 4; i++ {
+		obj := new(Envelope)
+		fillEnvelopeSSZ(obj, rnd)
+		if err := ssz.WriteTestVector(filepath.Join(dir, "Envelope", "ssz_random", fmt.Sprintf("case_%d", i)), obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// fillEnvelopeSSZ populates the Envelope object with random values
+func fillEnvelopeSSZ(e *Envelope, rnd *rand.Rand) {
+	// Field (0) 'Slot'
+	e.Slot = uint64(rnd.Uint64())
+
+	// Field (1) 'Payload'
+	switch rnd.Intn(4) {
+	case 0:
+		e.Payload = nil
+	case 1:
+		e.Payload = new(Envelope_Number)
+		e.Payload.(*Envelope_Number).Number = uint64(rnd.Uint64())
+	case 2:
+		e.Payload = new(Envelope_Data)
+		e.Payload.(*Envelope_Data).Data = make([]byte, 16)
+		rnd.Read(e.Payload.(*Envelope_Data).Data)
+	case 3:
+		e.Payload = new(Envelope_Ping)
+		e.Payload.(*Envelope_Ping).Ping = new(Ping)
+		fillPingSSZ(e.Payload.(*Envelope_Ping).Ping, rnd)
+	}
+
+	// Field (2) 'Root'
+	e.Root = make([]byte, 32)
+	rnd.Read(e.Root)
+
+}
+
+// TestSSZTestVectorsPing writes random test vectors of the Ping object
+// to the folder in the SSZ_TEST_VECTORS environment variable
+func TestSSZTestVectorsPing(t *testing.T) {
+	dir := os.Getenv("SSZ_TEST_VECTORS")
+	if dir == "" {
+		t.Skip("SSZ_TEST_VECTORS is not set")
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 4; i++ {
+		obj := new(Ping)
+		fillPingSSZ(obj, rnd)
+		if err := ssz.WriteTestVector(filepath.Join(dir, "Ping", "ssz_random", fmt.Sprintf("case_%d", i)), obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// fillPingSSZ populates the Ping object with random values
+func fillPingSSZ(p *Ping, rnd *rand.Rand) {
+	// Field (0) 'Nonce'
+	p.Nonce = uint64(rnd.Uint64())
+
+	// Field (1) 'Peers'
+	p.Peers = make([][]byte, 4)
+	for ii := range p.Peers {
+		p.Peers[ii] = make([]byte, 16)
+		rnd.Read(p.Peers[ii])
+	}
+
+}
@@ -0,0 +1,23 @@
+package tests
+
+import "github.com/photon-storage/fastssz/tests/external"
+
+// ExternalValues has structs of another package by value and by pointer
+type ExternalValues struct {
+	Header    external.Header
+	HeaderPtr *external.Header
+	Body      external.Body
+	Manual    external.Manual
+	ManualPtr *external.Manual
+	ManualDyn external.ManualDynamic
+}
+
+// ExternalLists has lists of pointers to the structs of another package
+// with hand-written methods
+type ExternalLists struct {
+	Signed    []*external.Signed        `ssz-max:"8"`
+	Manual    []*external.Manual        `ssz-max:"4"`
+	ManualDyn []*external.ManualDynamic `ssz-max:"4"`
+	Headers   []*external.Header        `ssz-max:"4"`
+	Pair      []*external.Signed        `ssz-size:"2"`
+}
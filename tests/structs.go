@@ -0,0 +1,402 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"math"
+
+	ssz "github.com/photon-storage/fastssz"
+)
+
+// Payload is an interface implemented by the payloads of a message
+type Payload interface {
+	SizeSSZ() int
+}
+
+type Message struct {
+	Index   uint64
+	Payload Payload  `ssz-concrete:"Metadata"`
+	Chunks  []*Chunk `ssz-max:"4"`
+}
+
+// Registry is an append-only registry of chunks
+type Registry struct {
+	Chunks []*Chunk   `ssz-max:"1024" ssz-incremental:"true"`
+	Roots  [][32]byte `ssz-size:"?,32" ssz-max:"5" ssz-incremental:"true"`
+}
+
+// Root is an alias of a fixed byte array
+type Root = [32]byte
+
+// MessageRef is an alias of Message with the same ssz methods
+type MessageRef = Message
+
+// Checkpoint references a message by its root
+type Checkpoint struct {
+	Epoch   uint64
+	Root    Root
+	Message *MessageRef
+}
+
+// Flags packs its bool fields in a leading bitvector
+type Flags struct {
+	_         struct{} `ssz-pack-bools:"true"`
+	Slot      uint64
+	Active    bool
+	Slashed   bool
+	Exited    bool
+	Withdrawn bool
+	Pending   bool
+	Eligible  bool
+	Synced    bool
+	Finalized bool
+	Justified bool
+	Proposer  bool
+}
+
+// Balances has collections of uints
+type Balances struct {
+	Values []uint64 `ssz-max:"1024"`
+	Scores []uint32 `ssz-size:"4"`
+	Counts []uint16 `ssz-max:"16"`
+}
+
+// Header is a block header with only fixed fields
+type Header struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    [32]byte
+	StateRoot     [32]byte
+	BodyRoot      [32]byte
+}
+
+// Lists has lists of every kind that are decoded to empty slices
+type Lists struct {
+	Data   []byte   `ssz-max:"32"`
+	Values []uint64 `ssz-max:"8"`
+	Chunks []*Chunk `ssz-max:"4"`
+	Blobs  [][]byte `ssz-max:"4,8"`
+}
+
+// ByteLists has byte lists with limits around the 32 bytes chunks
+type ByteLists struct {
+	Pow2    []byte `ssz-max:"1024"`
+	NotPow2 []byte `ssz-max:"1000"`
+	Chunk   []byte `ssz-max:"33"`
+}
+
+// NonEmptyLists has lists that require a minimum number of elements
+type NonEmptyLists struct {
+	Data   []byte   `ssz-max:"32" ssz-min:"2"`
+	Values []uint64 `ssz-max:"8" ssz-min:"1"`
+	Chunks []*Chunk `ssz-max:"4" ssz-min:"1"`
+}
+
+// Heartbeat keeps the bytes of the fields added in newer versions in its extension
+type Heartbeat struct {
+	Slot      uint64
+	Root      [32]byte
+	Extension []byte `ssz-extensible:"true"`
+}
+
+// HeartbeatV2 is a newer version of Heartbeat with an additional field
+type HeartbeatV2 struct {
+	Slot      uint64
+	Root      [32]byte
+	Peers     uint32
+	Extension []byte `ssz-extensible:"true"`
+}
+
+// Fields3 is a container padded to 4 leaves
+type Fields3 struct {
+	A uint64
+	B uint64
+	C uint64
+}
+
+// Fields5 is a container padded to 8 leaves
+type Fields5 struct {
+	A uint64
+	B uint64
+	C uint64
+	D uint64
+	E [32]byte
+}
+
+// Fields9 is a container padded to 16 leaves
+type Fields9 struct {
+	A uint64
+	B uint64
+	C uint64
+	D uint64
+	E uint64
+	F uint64
+	G uint64
+	H uint64
+	I *Fields3
+}
+
+// Vault has fields that are encrypted at rest
+type Vault struct {
+	Slot   uint64
+	Secret [32]byte `ssz-encrypt:"true"`
+	Notes  []byte   `ssz-max:"256" ssz-encrypt:"true"`
+	Chunks []*Chunk `ssz-max:"4" ssz-encrypt:"true"`
+	Public []byte   `ssz-max:"32"`
+}
+
+// Block caches the hash of its body, which is not encoded
+type Block struct {
+	Slot     uint64
+	Body     []byte   `ssz-max:"64"`
+	bodyHash [32]byte `ssz-transient:"computeBodyHash"`
+}
+
+// computeBodyHash populates the hash of the body after decoding the block
+func (b *Block) computeBodyHash() {
+	b.bodyHash = sha256.Sum256(b.Body)
+}
+
+// PackedUints has lists and vectors of uints narrower than 8 bytes that do not fill
+// their last chunk
+type PackedUints struct {
+	U32List   []uint32 `ssz-max:"20"`
+	U16List   []uint16 `ssz-max:"40"`
+	U32Vector []uint32 `ssz-size:"9"`
+	U16Vector []uint16 `ssz-size:"17"`
+}
+
+// Validator is a fixed size container
+type Validator struct {
+	Pubkey  [48]byte
+	Balance uint64
+	Slashed bool
+}
+
+// Committee has a slice of validators that is always a vector of 4 elements
+type Committee struct {
+	Slot       uint64
+	Validators []*Validator `ssz-size:"4"`
+}
+
+// Participation has a bitvector of 12 bits, the 4 high bits of its last byte are zero
+type Participation struct {
+	Slot uint64
+	Bits []byte `ssz:"bitvector" ssz-size:"12"`
+}
+
+// Optionals has a fixed and a dynamic optional field, a nil field is absent
+type Optionals struct {
+	Slot   uint64
+	Header *Header    `ssz-optional:"true"`
+	Lists  *ByteLists `ssz-optional:"true"`
+}
+
+// OptionalChain has an optional field that has its own optional fields
+type OptionalChain struct {
+	Epoch     uint64
+	Optionals *Optionals `ssz-optional:"true"`
+}
+
+// Empty is a container without fields, its encoding has no bytes
+type Empty struct{}
+
+// EmptyFields has empty containers as fields
+type EmptyFields struct {
+	Slot    uint64
+	Empty   *Empty
+	Empties []*Empty `ssz-size:"2"`
+}
+
+// Reading has fields encoded by its own methods since floats and strings are not ssz types,
+// the value is fixed with 8 bytes and the unit is a list of at most 16 bytes
+type Reading struct {
+	Slot  uint64
+	Value float64 `ssz-size:"8"`
+	Unit  string
+}
+
+// marshalFieldValue encodes the value as the uint64 of its IEEE 754 bits
+func (r *Reading) marshalFieldValue(dst []byte) []byte {
+	return ssz.MarshalUint64(dst, math.Float64bits(r.Value))
+}
+
+func (r *Reading) unmarshalFieldValue(buf []byte) error {
+	if len(buf) != 8 {
+		return ssz.ErrSize
+	}
+	r.Value = math.Float64frombits(ssz.UnmarshallUint64(buf))
+	return nil
+}
+
+func (r *Reading) sizeFieldValue() int {
+	return 8
+}
+
+func (r *Reading) hashTreeRootFieldValue(hh *ssz.Hasher) error {
+	hh.PutUint64(math.Float64bits(r.Value))
+	return nil
+}
+
+// marshalFieldUnit encodes the unit as a List[byte,16]
+func (r *Reading) marshalFieldUnit(dst []byte) []byte {
+	return append(dst, r.Unit...)
+}
+
+func (r *Reading) unmarshalFieldUnit(buf []byte) error {
+	if len(buf) > 16 {
+		return ssz.ErrBytesLength
+	}
+	r.Unit = string(buf)
+	return nil
+}
+
+func (r *Reading) sizeFieldUnit() int {
+	return len(r.Unit)
+}
+
+func (r *Reading) hashTreeRootFieldUnit(hh *ssz.Hasher) error {
+	if len(r.Unit) > 16 {
+		return ssz.ErrBytesLength
+	}
+	indx := hh.Index()
+	hh.AppendBytes32([]byte(r.Unit))
+	hh.MerkleizeWithMixin(indx, uint64(len(r.Unit)), 1)
+	return nil
+}
+
+// Timing groups the time fields of Inlined
+type Timing struct {
+	Slot  uint64
+	Epoch uint64
+}
+
+// Inlined has the fields of its timing encoded as its own fields, it has the same
+// encoding and root as Flat
+type Inlined struct {
+	Timing Timing `ssz-inline:"true"`
+	Root   [32]byte
+	Data   []byte `ssz-max:"64"`
+}
+
+// Flat has the fields of Inlined without grouping them
+type Flat struct {
+	Slot  uint64
+	Epoch uint64
+	Root  [32]byte
+	Data  []byte `ssz-max:"64"`
+}
+
+// Blob is a named byte slice, its length is given by the tags of each field of its type
+type Blob []byte
+
+// Blobs has named byte slices with different lengths
+type Blobs struct {
+	Data  Blob   `ssz-max:"64"`
+	Other Blob   `ssz-max:"128"`
+	Fixed Blob   `ssz-size:"8"`
+	List  []Blob `ssz-max:"4,16"`
+}
+
+// Reserved has reserved bytes between its fields, it has the same encoding
+// and root as Padded with zero padding
+type Reserved struct {
+	Version uint32
+	_       [4]byte `ssz-reserved:"4"`
+	Root    [32]byte
+	_       [40]byte `ssz-reserved:"40"`
+	Data    []byte   `ssz-max:"64"`
+}
+
+// Padded has the reserved bytes of Reserved as regular fields
+type Padded struct {
+	Version uint32
+	Pad1    [4]byte
+	Root    [32]byte
+	Pad2    [40]byte
+	Data    []byte `ssz-max:"64"`
+}
+
+// Wide is a container with many fields to benchmark the hashing of wide containers
+type Wide struct {
+	A0 uint64
+	A1 uint64
+	A2 uint64
+	A3 uint64
+	A4 uint64
+	A5 uint64
+	A6 uint64
+	A7 uint64
+	B0 [32]byte
+	B1 [32]byte
+	B2 [32]byte
+	B3 [32]byte
+	B4 [32]byte
+	B5 [32]byte
+	B6 [32]byte
+	B7 [32]byte
+	C0 uint32
+	C1 uint32
+	C2 uint32
+	C3 uint32
+	D0 bool
+	D1 bool
+	E0 []byte   `ssz-max:"256"`
+	E1 []uint64 `ssz-max:"32"`
+}
+
+// Signed has several large fixed byte fields to benchmark their encoding
+type Signed struct {
+	Slot      uint64
+	PubKey    []byte `ssz-size:"48"`
+	Signature []byte `ssz-size:"96"`
+	Aggregate [96]byte
+	Root      [32]byte
+	Proof     []byte `ssz-size:"96"`
+}
+
+// SingleElements has lists and vectors of each kind of element to check their roots
+// with a single element
+type SingleElements struct {
+	Uints      []uint64   `ssz-max:"16"`
+	OneUint    []uint64   `ssz-max:"1"`
+	Bytes      []byte     `ssz-max:"64"`
+	Roots      [][32]byte `ssz-size:"?,32" ssz-max:"8"`
+	OneRoot    [][32]byte `ssz-size:"?,32" ssz-max:"1"`
+	Blobs      [][]byte   `ssz-max:"4,16"`
+	Items      []*Timing  `ssz-max:"4"`
+	OneItem    []*Timing  `ssz-max:"1"`
+	UintVector []uint64   `ssz-size:"1"`
+	RootVector [1][32]byte
+	ItemVector []*Timing `ssz-size:"1"`
+}
+
+// Frame is a frame of a legacy wire format whose payload is sized by the length field
+// that precedes it instead of an offset
+type Frame struct {
+	Version    uint16
+	PayloadLen uint32 `ssz-length-of:"Payload"`
+	Payload    []byte `ssz-length-from:"PayloadLen" ssz-max:"256"`
+	Checksum   [4]byte
+}
+
+// Frames has a list of framed containers
+type Frames struct {
+	Slot   uint64
+	Frames []*Frame `ssz-max:"4"`
+}
+
+// Interleaved declares its fixed fields after the dynamic ones
+type Interleaved struct {
+	A []byte `ssz-max:"32"`
+	B uint64
+	C []byte `ssz-max:"32"`
+	D uint64
+}
+
+// Votes has a bitlist and a bitvector with the generated types VotesBits and VotesMask
+// to access their bits
+type Votes struct {
+	Slot uint64
+	Bits []byte `ssz-bitlist:"2048"`
+	Mask []byte `ssz-bitvector:"12"`
+}
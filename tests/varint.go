@@ -0,0 +1,21 @@
+package tests
+
+// Compact is also encoded in the varint format
+type Compact struct {
+	Slot     uint64
+	Index    uint32
+	Active   bool
+	Root     [32]byte
+	Data     []byte   `ssz-max:"64"`
+	Values   []uint64 `ssz-max:"8"`
+	Roots    [][]byte `ssz-max:"4" ssz-size:"?,32"`
+	Inner    *CompactInner
+	Items    []*CompactInner `ssz-max:"4"`
+	Counters []uint16        `ssz-size:"4"`
+}
+
+// CompactInner is a struct nested in Compact
+type CompactInner struct {
+	Epoch uint16
+	Votes []uint32 `ssz-max:"16"`
+}
@@ -0,0 +1,89 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/snappy"
+)
+
+const (
+	testVectorSerializedFile = "serialized.ssz_snappy"
+	testVectorMetaFile       = "meta.yaml"
+)
+
+// WriteTestVector writes the object as a test vector in the format of the consensus
+// spec tests. The dir folder contains the snappy compressed ssz encoding of the
+// object (serialized.ssz_snappy) and its hash tree root (meta.yaml).
+func WriteTestVector(dir string, obj interface {
+	Marshaler
+	HashRoot
+}) error {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, testVectorSerializedFile), snappy.Encode(nil, buf), 0644); err != nil {
+		return err
+	}
+	meta := fmt.Sprintf("root: '0x%s'\n", hex.EncodeToString(root[:]))
+	if err := ioutil.WriteFile(filepath.Join(dir, testVectorMetaFile), []byte(meta), 0644); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RandomBitlist returns a bitlist of num random bits
+func RandomBitlist(r *rand.Rand, num uint64) []byte {
+	buf := make([]byte, num/8+1)
+	r.Read(buf)
+	// clear the bits after the length bit and set the length bit
+	buf[len(buf)-1] &= byte(1)<<(num%8) - 1
+	buf[len(buf)-1] |= byte(1) << (num % 8)
+	return buf
+}
+
+// CompareReference compares the ssz encoding and the hash tree root of the object
+// with the ones of a reference library (i.e. the Marshal and HashTreeRoot functions of go-ssz)
+func CompareReference(obj interface {
+	Marshaler
+	HashRoot
+}, marshal func(interface{}) ([]byte, error), hashTreeRoot func(interface{}) ([32]byte, error)) error {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	expected, err := marshal(obj)
+	if err != nil {
+		return fmt.Errorf("reference marshal failed: %v", err)
+	}
+	if !bytes.Equal(buf, expected) {
+		return fmt.Errorf("bad encoding 0x%s, the reference is 0x%s", hex.EncodeToString(buf), hex.EncodeToString(expected))
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	expectedRoot, err := hashTreeRoot(obj)
+	if err != nil {
+		return fmt.Errorf("reference hash tree root failed: %v", err)
+	}
+	if root != expectedRoot {
+		return fmt.Errorf("bad root 0x%s, the reference is 0x%s", hex.EncodeToString(root[:]), hex.EncodeToString(expectedRoot[:]))
+	}
+	return nil
+}
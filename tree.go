@@ -80,6 +80,10 @@ type Node struct {
 	right *Node
 
 	value []byte
+
+	// hash is the cached hash of a branch node, it is cleared
+	// when a node of its subtree is replaced
+	hash []byte
 }
 
 // NewNodeWithValue initializes a leaf node.
@@ -199,7 +203,42 @@ func hashNode(n *Node) []byte {
 	if n.left == nil || n.right == nil {
 		panic("Tree incomplete")
 	}
-	return hashFn(append(hashNode(n.left), hashNode(n.right)...))
+	if n.hash == nil {
+		n.hash = hashFn(append(hashNode(n.left), hashNode(n.right)...))
+	}
+	return n.hash
+}
+
+// Replace replaces the node at the given general index with the node. Only the
+// hashes of the nodes in its path are computed again when the tree is hashed.
+func (n *Node) Replace(index int, node *Node) error {
+	pathLen := getPathLength(index)
+	if pathLen == 0 {
+		return errors.New("The root cannot be replaced")
+	}
+	cur := n
+	for i := pathLen - 1; i > 0; i-- {
+		cur.hash = nil
+		if isRight := getPosAtLevel(index, i); isRight {
+			cur = cur.right
+		} else {
+			cur = cur.left
+		}
+		if cur == nil {
+			return errors.New("Node not found in tree")
+		}
+	}
+	if cur.left == nil || cur.right == nil {
+		// the parent is a leaf
+		return errors.New("Node not found in tree")
+	}
+	cur.hash = nil
+	if isRight := getPosAtLevel(index, 0); isRight {
+		cur.right = node
+	} else {
+		cur.left = node
+	}
+	return nil
 }
 
 // Prove returns a list of sibling values and hashes needed
@@ -308,7 +347,7 @@ func LeavesFromUint64(items []uint64) []*Node {
 		return []*Node{}
 	}
 
-	numLeaves := (len(items)*8 + 31) / 32
+	numLeaves := ChunkCount(len(items) * 8)
 	buf := make([]byte, numLeaves*32)
 	for i, v := range items {
 		binary.LittleEndian.PutUint64(buf[i*8:(i+1)*8], v)
@@ -3,6 +3,7 @@ package ssz
 import (
 	"bytes"
 	"encoding/hex"
+	"strings"
 	"testing"
 )
 
@@ -29,6 +30,29 @@ func TestTreeFromChunks(t *testing.T) {
 	}
 }
 
+func TestTreeSingleLeafWithMixin(t *testing.T) {
+	leaf := make([]byte, 32)
+	leaf[0] = 1
+
+	// the single leaf is padded to the limit as in the hasher
+	for _, limit := range []int{1, 2, 4, 16} {
+		tree, err := TreeFromNodesWithMixin([]*Node{LeafFromBytes(leaf)}, 1, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hh := NewHasher()
+		hh.Append(leaf)
+		hh.MerkleizeWithMixin(0, 1, uint64(limit))
+		expected, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tree.Hash(), expected[:]) {
+			t.Fatalf("bad root of a single leaf with limit %d", limit)
+		}
+	}
+}
+
 func TestHashTree(t *testing.T) {
 	expectedRootHex := "6621edd5d039d27d1ced186d57691a04903ac79b389187c2d453b5d3cd65180e"
 	expectedRoot, err := hex.DecodeString(expectedRootHex)
@@ -133,3 +157,72 @@ func TestGetRequiredIndices(t *testing.T) {
 		}
 	}
 }
+
+func TestTreeToDOT(t *testing.T) {
+	chunks := [][]byte{
+		{0x01, 0x01},
+		{0x02, 0x02},
+		{0x03, 0x03},
+		{0x00, 0x00},
+	}
+	r, err := TreeFromChunks(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dot := TreeToDOT(r)
+	if !strings.HasPrefix(dot, "digraph tree {") || !strings.HasSuffix(dot, "}\n") {
+		t.Fatalf("bad graph %s", dot)
+	}
+	expected := []string{
+		// the root is truncated
+		`n1 [label="1\n6621edd5d039d27d..."];`,
+		`n1 -> n2;`,
+		`n3 -> n7;`,
+		`n4 [label="4\n0101", shape=ellipse];`,
+		`n7 [label="7\n0000", shape=ellipse];`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(dot, e) {
+			t.Fatalf("expected %s in %s", e, dot)
+		}
+	}
+	if strings.Count(dot, "->") != 6 {
+		t.Fatal("expected 6 edges")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	chunks := [][]byte{
+		{0x01, 0x01},
+		{0x02, 0x02},
+		{0x03, 0x03},
+		{0x00, 0x00},
+	}
+	r, err := TreeFromChunks(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// hash the tree before the replace to cache the hashes of the branches
+	r.Hash()
+
+	// the second chunk is at the general index 5
+	if err := r.Replace(5, NewNodeWithValue([]byte{0x04, 0x04})); err != nil {
+		t.Fatal(err)
+	}
+	chunks[1] = []byte{0x04, 0x04}
+	expected, err := TreeFromChunks(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(r.Hash(), expected.Hash()) {
+		t.Fatal("the hash of the replaced tree does not match")
+	}
+
+	// the root and the nodes below the leaves cannot be replaced
+	for _, index := range []int{1, 8} {
+		if err := r.Replace(index, NewNodeWithValue(nil)); err == nil {
+			t.Fatalf("expected an error replacing the index %d", index)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The varint format is a compact encoding (not SSZ) generated with '-format varint'.
+// The uints are encoded as LEB128 varints and the fields are written in order,
+// the lists and the dynamic bytes are prefixed with their length.
+
+// ErrVarint is returned when a varint is truncated, not minimal or overflows its type
+var ErrVarint = fmt.Errorf("invalid varint")
+
+// AppendUvarint appends the LEB128 varint encoding of i to dst
+func AppendUvarint(dst []byte, i uint64) []byte {
+	for i >= 0x80 {
+		dst = append(dst, byte(i)|0x80)
+		i >>= 7
+	}
+	return append(dst, byte(i))
+}
+
+// ReadUvarint reads a LEB128 varint of a uint of the given size in bytes
+// from the start of buf and advances buf past it
+func ReadUvarint(buf *[]byte, size int) (uint64, error) {
+	i, n := binary.Uvarint(*buf)
+	if n <= 0 {
+		return 0, ErrVarint
+	}
+	if n > 1 && (*buf)[n-1] == 0 {
+		// the encoding is not minimal
+		return 0, ErrVarint
+	}
+	if size < 8 && i>>(8*size) != 0 {
+		return 0, ErrVarint
+	}
+	*buf = (*buf)[n:]
+	return i, nil
+}
+
+// ReadBytes returns the first n bytes of buf and advances buf past them
+func ReadBytes(buf *[]byte, n uint64) ([]byte, error) {
+	if n > uint64(len(*buf)) {
+		return nil, ErrSize
+	}
+	res := (*buf)[:n]
+	*buf = (*buf)[n:]
+	return res, nil
+}
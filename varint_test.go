@@ -0,0 +1,76 @@
+package ssz
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestUvarint(t *testing.T) {
+	cases := []struct {
+		val uint64
+		buf []byte
+	}{
+		{0, []byte{0x00}},
+		{1, []byte{0x01}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{300, []byte{0xac, 0x02}},
+		{math.MaxUint64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, c := range cases {
+		buf := AppendUvarint(nil, c.val)
+		if !bytes.Equal(buf, c.buf) {
+			t.Fatalf("bad encoding of %d: %x", c.val, buf)
+		}
+		buf = append(buf, 0xaa)
+		val, err := ReadUvarint(&buf, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val != c.val {
+			t.Fatalf("expected %d but found %d", c.val, val)
+		}
+		if !bytes.Equal(buf, []byte{0xaa}) {
+			t.Fatal("bad remaining bytes")
+		}
+	}
+}
+
+func TestReadUvarintErrors(t *testing.T) {
+	cases := []struct {
+		buf  []byte
+		size int
+	}{
+		// empty
+		{[]byte{}, 8},
+		// truncated
+		{[]byte{0x80}, 8},
+		// not minimal
+		{[]byte{0x80, 0x00}, 8},
+		// overflows an uint16
+		{AppendUvarint(nil, math.MaxUint16+1), 2},
+		// overflows an uint64
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02}, 8},
+	}
+	for _, c := range cases {
+		buf := c.buf
+		if _, err := ReadUvarint(&buf, c.size); err != ErrVarint {
+			t.Fatalf("expected ErrVarint for %x but found %v", c.buf, err)
+		}
+	}
+}
+
+func TestReadBytes(t *testing.T) {
+	buf := []byte{1, 2, 3}
+	res, err := ReadBytes(&buf, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res, []byte{1, 2}) || !bytes.Equal(buf, []byte{3}) {
+		t.Fatal("bad split")
+	}
+	if _, err := ReadBytes(&buf, 2); err != ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}